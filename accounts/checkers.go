@@ -11,6 +11,7 @@ import (
 	"github.com/lightningnetwork/lnd/lnrpc/routerrpc"
 	"github.com/lightningnetwork/lnd/lntypes"
 	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/record"
 	"github.com/lightningnetwork/lnd/zpay32"
 	"google.golang.org/protobuf/proto"
 )
@@ -65,6 +66,25 @@ var (
 	]()
 )
 
+var (
+	// sendURIs is the set of gRPC request URIs that send a payment and
+	// are therefore not allowed for receive-only accounts.
+	sendURIs = map[string]struct{}{
+		"/lnrpc.Lightning/SendPayment":     {},
+		"/lnrpc.Lightning/SendPaymentSync": {},
+		"/routerrpc.Router/SendPaymentV2":  {},
+		"/lnrpc.Lightning/SendToRoute":     {},
+		"/lnrpc.Lightning/SendToRouteSync": {},
+		"/routerrpc.Router/SendToRouteV2":  {},
+	}
+
+	// receiveURIs is the set of gRPC request URIs that create an invoice
+	// and are therefore not allowed for spend-only accounts.
+	receiveURIs = map[string]struct{}{
+		"/lnrpc.Lightning/AddInvoice": {},
+	}
+)
+
 // CheckerMap is a type alias that maps gRPC request URIs to their
 // rpcmiddleware.RoundTripChecker types.
 type CheckerMap map[string]mid.RoundTripChecker
@@ -182,6 +202,7 @@ func NewAccountChecker(service Service,
 				return checkSend(
 					ctx, chainParams, service, r.Amt,
 					r.AmtMsat, r.PaymentRequest, r.FeeLimit,
+					r.DestCustomRecords, false,
 				)
 			}, sendResponseHandler, mid.PassThroughErrorHandler,
 		),
@@ -192,6 +213,7 @@ func NewAccountChecker(service Service,
 				return checkSend(
 					ctx, chainParams, service, r.Amt,
 					r.AmtMsat, r.PaymentRequest, r.FeeLimit,
+					r.DestCustomRecords, false,
 				)
 			}, sendResponseHandler, mid.PassThroughErrorHandler,
 		),
@@ -214,7 +236,7 @@ func NewAccountChecker(service Service,
 						Limit: &lnrpc.FeeLimit_FixedMsat{
 							FixedMsat: feeLimitMsat,
 						},
-					},
+					}, r.DestCustomRecords, r.Amp,
 				)
 			},
 			func(ctx context.Context,
@@ -409,6 +431,12 @@ func (a *AccountChecker) checkIncomingRequest(ctx context.Context,
 			req.ProtoReflect().Type())
 	}
 
+	// Make sure the account is allowed to perform the type of action the
+	// request represents.
+	if err := checkCapabilities(ctx, fullUri); err != nil {
+		return err
+	}
+
 	req, err := checker.HandleRequest(ctx, req)
 	if err != nil {
 		return err
@@ -422,6 +450,25 @@ func (a *AccountChecker) checkIncomingRequest(ctx context.Context,
 	return nil
 }
 
+// checkCapabilities makes sure the account in the context is allowed to call
+// the given URI, based on the account's capability flags.
+func checkCapabilities(ctx context.Context, fullUri string) error {
+	acct, err := AccountFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	if _, ok := sendURIs[fullUri]; ok && !acct.CanSend() {
+		return ErrAccSendDisabled
+	}
+
+	if _, ok := receiveURIs[fullUri]; ok && !acct.CanReceive() {
+		return ErrAccReceiveDisabled
+	}
+
+	return nil
+}
+
 // replaceOutgoingResponse inspects the responses before sending them out to the
 // lightningClient and replaces them if needed.
 func (a *AccountChecker) replaceOutgoingResponse(ctx context.Context,
@@ -506,10 +553,12 @@ func filterPayments(ctx context.Context,
 }
 
 // checkSend checks if a payment can be initiated by making sure the account in
-// the context has enough balance to pay for it.
+// the context has enough balance to pay for it. Spontaneous keysend and AMP
+// payments don't have an invoice, so their amount must be set explicitly.
 func checkSend(ctx context.Context, chainParams *chaincfg.Params,
 	service Service, amt, amtMsat int64, invoice string,
-	feeLimit *lnrpc.FeeLimit) error {
+	feeLimit *lnrpc.FeeLimit, destCustomRecords map[uint64][]byte,
+	amp bool) error {
 
 	acct, err := AccountFromContext(ctx)
 	if err != nil {
@@ -522,8 +571,9 @@ func checkSend(ctx context.Context, chainParams *chaincfg.Params,
 	}
 
 	// The invoice is optional.
+	var payReq *zpay32.Invoice
 	if len(invoice) > 0 {
-		payReq, err := zpay32.Decode(invoice, chainParams)
+		payReq, err = zpay32.Decode(invoice, chainParams)
 		if err != nil {
 			return fmt.Errorf("error decoding pay req: %v", err)
 		}
@@ -531,6 +581,23 @@ func checkSend(ctx context.Context, chainParams *chaincfg.Params,
 		if payReq.MilliSat != nil && *payReq.MilliSat > sendAmt {
 			sendAmt = *payReq.MilliSat
 		}
+
+		// Paying an AMP invoice results in an AMP payment even if the
+		// caller didn't explicitly ask for it.
+		if payReq.Features != nil &&
+			payReq.Features.HasFeature(lnwire.AMPOptional) {
+
+			amp = true
+		}
+	}
+
+	// Without an invoice, the amount of a keysend or AMP payment is only
+	// defined by the request. We can't check the balance of a payment we
+	// don't know the amount of.
+	_, keysend := destCustomRecords[record.KeySendType]
+	if payReq == nil && (keysend || amp) && sendAmt == 0 {
+		return fmt.Errorf("amount must be specified for spontaneous " +
+			"payments")
 	}
 
 	// We also add the max fee to the amount to check. This might mean that
@@ -542,6 +609,7 @@ func checkSend(ctx context.Context, chainParams *chaincfg.Params,
 		limit = &lnrpc.FeeLimit{}
 	}
 	fee := lnrpc.CalculateFeeLimit(limit, sendAmt)
+	paymentAmt := sendAmt
 	sendAmt += fee
 
 	err = service.CheckBalance(acct.ID, sendAmt)
@@ -549,6 +617,31 @@ func checkSend(ctx context.Context, chainParams *chaincfg.Params,
 		return fmt.Errorf("error validating account balance: %v", err)
 	}
 
+	err = service.CheckSpendLimits(acct.ID, paymentAmt, sendAmt)
+	if err != nil {
+		return fmt.Errorf("error validating account spend limits: %w",
+			err)
+	}
+
+	// If the invoice belongs to another account on this node, we don't
+	// need to send a payment through the network at all. Instead, we move
+	// the amount between the two accounts and stop the request from
+	// reaching lnd. AMP invoices can be paid multiple times and are never
+	// settled, so we always let lnd handle payments to them.
+	if payReq == nil || payReq.PaymentHash == nil || amp {
+		return nil
+	}
+
+	settled, err := service.SettleInvoiceInternally(
+		acct.ID, *payReq.PaymentHash, paymentAmt,
+	)
+	if err != nil {
+		return fmt.Errorf("error settling invoice internally: %w", err)
+	}
+	if settled {
+		return ErrSettledInternally
+	}
+
 	return nil
 }
 
@@ -605,6 +698,13 @@ func checkSendToRoute(ctx context.Context, service Service,
 	if lnwire.MilliSatoshi(route.TotalFeesMsat) > fee {
 		fee = lnwire.MilliSatoshi(route.TotalFeesMsat)
 	}
+
+	// The total amount of the route includes the fees, but the payment
+	// limits only apply to the amount that is delivered to the receiver.
+	if fee > sendAmt {
+		return fmt.Errorf("invalid route, fees exceed total amount")
+	}
+	paymentAmt := sendAmt - fee
 	sendAmt += fee
 
 	err = service.CheckBalance(acct.ID, sendAmt)
@@ -612,5 +712,11 @@ func checkSendToRoute(ctx context.Context, service Service,
 		return fmt.Errorf("error validating account balance: %v", err)
 	}
 
+	err = service.CheckSpendLimits(acct.ID, paymentAmt, sendAmt)
+	if err != nil {
+		return fmt.Errorf("error validating account spend limits: %w",
+			err)
+	}
+
 	return nil
 }
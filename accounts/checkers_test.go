@@ -11,6 +11,7 @@ import (
 	"github.com/lightningnetwork/lnd/lnrpc/routerrpc"
 	"github.com/lightningnetwork/lnd/lntypes"
 	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/record"
 	"github.com/stretchr/testify/require"
 	"google.golang.org/protobuf/encoding/protojson"
 	"google.golang.org/protobuf/proto"
@@ -39,6 +40,7 @@ var (
 
 type mockService struct {
 	acctBalanceMsat lnwire.MilliSatoshi
+	spendLimits     SpendLimits
 
 	trackedInvoices map[lntypes.Hash]AccountID
 	trackedPayments map[lntypes.Hash]*PaymentEntry
@@ -62,6 +64,37 @@ func (m *mockService) CheckBalance(_ AccountID,
 	return nil
 }
 
+func (m *mockService) CheckSpendLimits(_ AccountID, paymentAmt,
+	fullAmt lnwire.MilliSatoshi) error {
+
+	if m.spendLimits.MaxPaymentAmount > 0 &&
+		paymentAmt > m.spendLimits.MaxPaymentAmount {
+
+		return ErrAccPaymentLimitExceeded
+	}
+
+	if m.spendLimits.WindowLimit > 0 &&
+		fullAmt > m.spendLimits.WindowLimit {
+
+		return ErrAccSpendLimitExceeded
+	}
+
+	return nil
+}
+
+func (m *mockService) SettleInvoiceInternally(id AccountID, hash lntypes.Hash,
+	_ lnwire.MilliSatoshi) (bool, error) {
+
+	toID, ok := m.trackedInvoices[hash]
+	if !ok || toID == id {
+		return false, nil
+	}
+
+	delete(m.trackedInvoices, hash)
+
+	return true, nil
+}
+
 func (m *mockService) AssociateInvoice(id AccountID, hash lntypes.Hash) error {
 	m.trackedInvoices[hash] = id
 
@@ -140,6 +173,14 @@ func TestAccountCheckers(t *testing.T) {
 
 			require.Contains(t, s.trackedInvoices, testHash)
 		},
+	}, {
+		name:    "add invoice, send-only account",
+		fullURI: "/lnrpc.Lightning/AddInvoice",
+		setup: func(s *mockService, acct *OffChainBalanceAccount) {
+			acct.Flags = FlagNoReceive
+		},
+		originalRequest: &lnrpc.Invoice{},
+		requestErr:      ErrAccReceiveDisabled.Error(),
 	}, {
 		name:            "list invoices, not mapped to account",
 		fullURI:         "/lnrpc.Lightning/ListInvoices",
@@ -244,6 +285,150 @@ func TestAccountCheckers(t *testing.T) {
 				t, lnrpc.Payment_UNKNOWN, payment.Status,
 			)
 		},
+	}, {
+		name:    "send payment, receive-only account",
+		fullURI: "/routerrpc.Router/SendPaymentV2",
+		setup: func(s *mockService, acct *OffChainBalanceAccount) {
+			s.acctBalanceMsat = 5000
+			acct.Flags = FlagNoSend
+		},
+		originalRequest: &routerrpc.SendPaymentRequest{
+			AmtMsat: 1000,
+		},
+		requestErr: ErrAccSendDisabled.Error(),
+	}, {
+		name:    "send to route, receive-only account",
+		fullURI: "/routerrpc.Router/SendToRouteV2",
+		setup: func(s *mockService, acct *OffChainBalanceAccount) {
+			s.acctBalanceMsat = 5000
+			acct.Flags = FlagNoSend
+		},
+		originalRequest: &routerrpc.SendToRouteRequest{
+			Route: &lnrpc.Route{
+				TotalAmtMsat: 1000,
+			},
+		},
+		requestErr: ErrAccSendDisabled.Error(),
+	}, {
+		name:    "send payment, above max payment amount",
+		fullURI: "/routerrpc.Router/SendPaymentV2",
+		setup: func(s *mockService, acct *OffChainBalanceAccount) {
+			s.acctBalanceMsat = 50000
+			s.spendLimits.MaxPaymentAmount = 4999
+		},
+		originalRequest: &routerrpc.SendPaymentRequest{
+			AmtMsat:      5000,
+			FeeLimitMsat: 100,
+		},
+		requestErr: ErrAccPaymentLimitExceeded.Error(),
+	}, {
+		name:    "send payment, max payment amount excludes fee",
+		fullURI: "/routerrpc.Router/SendPaymentV2",
+		setup: func(s *mockService, acct *OffChainBalanceAccount) {
+			s.acctBalanceMsat = 50000
+			s.spendLimits.MaxPaymentAmount = 5000
+		},
+		originalRequest: &routerrpc.SendPaymentRequest{
+			AmtMsat:      5000,
+			FeeLimitMsat: 100,
+		},
+		originalResponse: &lnrpc.Payment{
+			PaymentHash: hex.EncodeToString(testHash[:]),
+			ValueMsat:   5000,
+			FeeMsat:     50,
+			Status:      lnrpc.Payment_IN_FLIGHT,
+		},
+	}, {
+		name:    "send payment, keysend",
+		fullURI: "/routerrpc.Router/SendPaymentV2",
+		setup: func(s *mockService, acct *OffChainBalanceAccount) {
+			s.acctBalanceMsat = 5100
+		},
+		originalRequest: &routerrpc.SendPaymentRequest{
+			AmtMsat:      5000,
+			FeeLimitMsat: 100,
+			DestCustomRecords: map[uint64][]byte{
+				record.KeySendType: testHash[:],
+			},
+		},
+		originalResponse: &lnrpc.Payment{
+			PaymentHash: hex.EncodeToString(testHash[:]),
+			ValueMsat:   5000,
+			FeeMsat:     50,
+			Status:      lnrpc.Payment_IN_FLIGHT,
+		},
+		validate: func(t *testing.T, s *mockService,
+			acct *OffChainBalanceAccount) {
+
+			require.Contains(t, s.trackedPayments, testHash)
+			payment := s.trackedPayments[testHash]
+			require.EqualValues(t, 5050, payment.FullAmount)
+		},
+	}, {
+		name:    "send payment, keysend without amount",
+		fullURI: "/lnrpc.Lightning/SendPaymentSync",
+		setup: func(s *mockService, acct *OffChainBalanceAccount) {
+			s.acctBalanceMsat = 5000
+		},
+		originalRequest: &lnrpc.SendRequest{
+			DestCustomRecords: map[uint64][]byte{
+				record.KeySendType: testHash[:],
+			},
+		},
+		requestErr: "amount must be specified for spontaneous payments",
+	}, {
+		name:    "send payment, AMP without amount",
+		fullURI: "/routerrpc.Router/SendPaymentV2",
+		setup: func(s *mockService, acct *OffChainBalanceAccount) {
+			s.acctBalanceMsat = 5000
+		},
+		originalRequest: &routerrpc.SendPaymentRequest{
+			Amp: true,
+		},
+		requestErr: "amount must be specified for spontaneous payments",
+	}, {
+		name:    "send payment, above rolling spend limit",
+		fullURI: "/lnrpc.Lightning/SendPaymentSync",
+		setup: func(s *mockService, acct *OffChainBalanceAccount) {
+			s.acctBalanceMsat = 50000
+			s.spendLimits.WindowLimit = 5050
+		},
+		originalRequest: &lnrpc.SendRequest{
+			AmtMsat: 5000,
+			FeeLimit: &lnrpc.FeeLimit{
+				Limit: &lnrpc.FeeLimit_FixedMsat{
+					FixedMsat: 100,
+				},
+			},
+		},
+		requestErr: ErrAccSpendLimitExceeded.Error(),
+	}, {
+		name:    "send to route, above max payment amount",
+		fullURI: "/lnrpc.Lightning/SendToRouteSync",
+		setup: func(s *mockService, acct *OffChainBalanceAccount) {
+			s.acctBalanceMsat = 50000
+			s.spendLimits.MaxPaymentAmount = 4000
+		},
+		originalRequest: &lnrpc.SendToRouteRequest{
+			Route: &lnrpc.Route{
+				TotalAmtMsat:  5000,
+				TotalFeesMsat: 100,
+			},
+		},
+		requestErr: ErrAccPaymentLimitExceeded.Error(),
+	}, {
+		name:    "send to route, fees exceed total amount",
+		fullURI: "/lnrpc.Lightning/SendToRouteSync",
+		setup: func(s *mockService, acct *OffChainBalanceAccount) {
+			s.acctBalanceMsat = 50000
+		},
+		originalRequest: &lnrpc.SendToRouteRequest{
+			Route: &lnrpc.Route{
+				TotalAmtMsat:  100,
+				TotalFeesMsat: 200,
+			},
+		},
+		requestErr: "invalid route, fees exceed total amount",
 	}, {
 		name:            "list payments, not mapped to account",
 		fullURI:         "/lnrpc.Lightning/ListPayments",
@@ -0,0 +1,98 @@
+package accounts
+
+import (
+	"fmt"
+	"time"
+)
+
+const (
+	// DefaultExpiryWarning is the default duration before the expiration
+	// of an account at which subscribers are notified about the upcoming
+	// expiry.
+	DefaultExpiryWarning = 24 * time.Hour
+
+	// DefaultRemoveAfterDays is the default number of days after which an
+	// expired account is removed if the expiry action is "remove".
+	DefaultRemoveAfterDays = 30
+)
+
+// Config holds all config options for the accounts service.
+type Config struct {
+	ExpiryWarning   time.Duration `long:"expiry-warning" description:"The duration before the expiration of an account at which subscribers of account updates are notified about the upcoming expiry. Set to 0 to disable the notification."`
+	ExpiryAction    string        `long:"expiry-action" description:"The action taken on an account once it has expired. 'keep' leaves the account untouched, 'remove' removes the account after --accounts.remove-after-days and 'sweep' moves its remaining balance to the --accounts.treasury-account." choice:"keep" choice:"remove" choice:"sweep"`
+	RemoveAfterDays uint32        `long:"remove-after-days" description:"The number of days after its expiration at which an account is removed if the expiry action is 'remove'."`
+	TreasuryAccount string        `long:"treasury-account" description:"The ID of the account the remaining balance of expired accounts is swept to if the expiry action is 'sweep'."`
+	PriceOracleFile string        `long:"price-oracle-file" description:"A JSON file that maps fiat currency codes to the price of one minor unit (e.g. one cent) of the currency in millisatoshis. Used to convert payments of accounts with a fiat budget. The file is read on every payment, so it can be updated at any time."`
+	PriceOracleURL  string        `long:"price-oracle-url" description:"The URL of a local HTTP service that returns prices in the same format as --accounts.price-oracle-file. Cannot be used together with --accounts.price-oracle-file."`
+
+	// action is the parsed expiry action.
+	action ExpiryActionType
+
+	// treasuryID is the parsed ID of the treasury account.
+	treasuryID AccountID
+
+	// priceOracle is the price oracle used to convert payments of accounts
+	// with a fiat budget. It is nil if no price oracle is configured.
+	priceOracle PriceOracle
+}
+
+// DefaultConfig returns the default accounts Config struct.
+func DefaultConfig() *Config {
+	return &Config{
+		ExpiryWarning:   DefaultExpiryWarning,
+		ExpiryAction:    ExpiryActionKeep.String(),
+		RemoveAfterDays: DefaultRemoveAfterDays,
+	}
+}
+
+// Validate checks that the config options are consistent and parses the
+// expiry action, treasury account and price oracle.
+func (c *Config) Validate() error {
+	if c.ExpiryWarning < 0 {
+		return fmt.Errorf("accounts.expiry-warning cannot be negative")
+	}
+
+	switch c.ExpiryAction {
+	case ExpiryActionKeep.String():
+		c.action = ExpiryActionKeep
+
+	case ExpiryActionRemove.String():
+		c.action = ExpiryActionRemove
+
+	case ExpiryActionSweep.String():
+		c.action = ExpiryActionSweep
+
+	default:
+		return fmt.Errorf("unknown accounts.expiry-action %q",
+			c.ExpiryAction)
+	}
+
+	switch {
+	case c.PriceOracleFile != "" && c.PriceOracleURL != "":
+		return fmt.Errorf("accounts.price-oracle-file and " +
+			"accounts.price-oracle-url cannot be used together")
+
+	case c.PriceOracleFile != "":
+		c.priceOracle = NewFilePriceOracle(c.PriceOracleFile)
+
+	case c.PriceOracleURL != "":
+		c.priceOracle = NewHTTPPriceOracle(c.PriceOracleURL)
+	}
+
+	if c.action != ExpiryActionSweep {
+		return nil
+	}
+
+	if c.TreasuryAccount == "" {
+		return fmt.Errorf("accounts.treasury-account must be set " +
+			"when using the sweep expiry action")
+	}
+
+	treasuryID, err := ParseAccountID(c.TreasuryAccount)
+	if err != nil {
+		return fmt.Errorf("invalid accounts.treasury-account: %w", err)
+	}
+	c.treasuryID = *treasuryID
+
+	return nil
+}
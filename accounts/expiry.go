@@ -0,0 +1,155 @@
+package accounts
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// checkExpiries notifies subscribers about all accounts that reached the start
+// of their expiry warning period or their expiration date after the last check
+// and not after the current time. The configured expiry action is then taken on
+// all accounts that have expired.
+func (s *InterceptorService) checkExpiries(lastCheck, now time.Time) error {
+	s.Lock()
+	defer s.Unlock()
+
+	accounts, err := s.store.Accounts()
+	if err != nil {
+		return fmt.Errorf("error querying accounts: %v", err)
+	}
+
+	for _, account := range accounts {
+		expiry := account.ExpirationDate
+		if expiry.IsZero() {
+			continue
+		}
+
+		if s.cfg.ExpiryWarning > 0 {
+			warnAt := expiry.Add(-s.cfg.ExpiryWarning)
+			if inCheckWindow(warnAt, lastCheck, now) {
+				s.notifyUpdate(&AccountUpdate{
+					Type:    UpdateTypeExpiringSoon,
+					Account: account,
+				})
+			}
+		}
+
+		if inCheckWindow(expiry, lastCheck, now) {
+			s.notifyUpdate(&AccountUpdate{
+				Type:    UpdateTypeExpired,
+				Account: account,
+			})
+		}
+
+		if expiry.After(now) {
+			continue
+		}
+
+		// A failing action shouldn't prevent us from handling the
+		// other expired accounts, we'll retry on the next check.
+		err := s.applyExpiryAction(account, now)
+		if err != nil {
+			log.Errorf("Error applying %v expiry action to "+
+				"account %x: %v", s.cfg.action, account.ID[:],
+				err)
+		}
+	}
+
+	return nil
+}
+
+// applyExpiryAction takes the configured expiry action on the given expired
+// account and records the action in the audit log in the same database
+// transaction. Accounts with payments that are still in flight are skipped
+// until the payments are resolved.
+//
+// NOTE: The store lock MUST be held when calling this method.
+func (s *InterceptorService) applyExpiryAction(account *OffChainBalanceAccount,
+	now time.Time) error {
+
+	if s.cfg.action == ExpiryActionKeep {
+		return nil
+	}
+
+	for _, payment := range s.pendingPayments {
+		if payment.accountID == account.ID {
+			return nil
+		}
+	}
+
+	switch s.cfg.action {
+	case ExpiryActionRemove:
+		removeAt := account.ExpirationDate.AddDate(
+			0, 0, int(s.cfg.RemoveAfterDays),
+		)
+		if removeAt.After(now) {
+			return nil
+		}
+
+		err := s.store.ApplyExpiryAction(&ExpiryActionEntry{
+			AccountID: account.ID,
+			Action:    ExpiryActionRemove,
+			Amount:    account.CurrentBalance,
+		})
+		if err != nil {
+			return fmt.Errorf("error removing account: %w", err)
+		}
+		s.forgetInvoices(account)
+
+		log.Infof("Removed account %x that expired at %v with a "+
+			"remaining balance of %d msat", account.ID[:],
+			account.ExpirationDate, account.CurrentBalance)
+
+		s.notifyUpdate(&AccountUpdate{
+			Type:    UpdateTypeRemoved,
+			Account: account,
+		})
+
+	case ExpiryActionSweep:
+		// The balance of a child account was carved out of its parent,
+		// so it is returned to the parent if the parent still exists.
+		treasuryID := s.cfg.treasuryID
+		if account.HasParent() {
+			_, err := s.store.Account(account.ParentID)
+			switch {
+			case err == nil:
+				treasuryID = account.ParentID
+
+			case !errors.Is(err, ErrAccNotFound):
+				return fmt.Errorf("error fetching parent "+
+					"account: %w", err)
+			}
+		}
+
+		if account.ID == treasuryID || account.CurrentBalance <= 0 {
+			return nil
+		}
+
+		amount := account.CurrentBalance
+		err := s.store.ApplyExpiryAction(&ExpiryActionEntry{
+			AccountID: account.ID,
+			Action:    ExpiryActionSweep,
+			Amount:    amount,
+			Treasury:  treasuryID,
+		})
+		if err != nil {
+			return fmt.Errorf("error sweeping balance to account "+
+				"%x: %w", treasuryID[:], err)
+		}
+
+		log.Infof("Swept remaining balance of %d msat of expired "+
+			"account %x to account %x", amount, account.ID[:],
+			treasuryID[:])
+
+		return s.notifyBalanceUpdates(account.ID, treasuryID)
+	}
+
+	return nil
+}
+
+// inCheckWindow returns true if the given time is after the start and not
+// after the end of a check window.
+func inCheckWindow(t, start, end time.Time) bool {
+	return t.After(start) && !t.After(end)
+}
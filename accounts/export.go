@@ -0,0 +1,380 @@
+package accounts
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/lightningnetwork/lnd/tlv"
+)
+
+const (
+	// exportVersionNoLedger is the version of the account export format
+	// that doesn't contain the ledgers of the accounts.
+	exportVersionNoLedger uint16 = 1
+
+	// exportVersion is the current version of the account export format.
+	exportVersion uint16 = 2
+
+	// maxExportedAccountSize is the maximum size of a single serialized
+	// account we accept when reading an export.
+	maxExportedAccountSize = 10 * 1024 * 1024
+
+	// maxExportedEntrySize is the maximum size of a single serialized
+	// ledger entry we accept when reading an export.
+	maxExportedEntrySize = 1024
+)
+
+var (
+	// exportMagic is the prefix of every account export.
+	exportMagic = []byte("litacct")
+
+	// ErrExportChecksumMismatch is returned if the checksum of an account
+	// export doesn't match its content, meaning the export was corrupted.
+	// The checksum is not keyed, so it doesn't detect an export that was
+	// deliberately modified and given a new checksum.
+	ErrExportChecksumMismatch = errors.New("account export checksum " +
+		"mismatch")
+
+	// ErrAccountIDCollision is returned when importing an account with an
+	// ID that is already used by an account of this node and the import
+	// policy is ImportFailOnCollision.
+	ErrAccountIDCollision = errors.New("account ID already exists")
+)
+
+// ImportPolicy defines how an imported account is handled if an account with
+// the same ID already exists.
+type ImportPolicy uint8
+
+const (
+	// ImportFailOnCollision aborts the whole import if any of the imported
+	// accounts has the ID of an existing account.
+	ImportFailOnCollision ImportPolicy = 0
+
+	// ImportSkipExisting skips imported accounts that have the ID of an
+	// existing account and imports all others.
+	ImportSkipExisting ImportPolicy = 1
+
+	// ImportNewID assigns a new random ID to imported accounts that have
+	// the ID of an existing account.
+	ImportNewID ImportPolicy = 2
+)
+
+// ExportedAccount is an account together with its transaction ledger as it is
+// contained in an account export.
+type ExportedAccount struct {
+	// Account is the exported account.
+	Account *OffChainBalanceAccount
+
+	// Ledger is the transaction ledger of the account. It is empty for
+	// accounts of exports that were created before ledgers were exported.
+	Ledger []*LedgerEntry
+}
+
+// ImportedAccount is the result of importing a single account.
+type ImportedAccount struct {
+	// Account is the imported account. Its ID differs from the original
+	// ID if a new ID had to be assigned because of a collision.
+	Account *OffChainBalanceAccount
+
+	// OriginalID is the ID of the account in the export.
+	OriginalID AccountID
+
+	// Skipped is true if the account wasn't imported because an account
+	// with the same ID already exists.
+	Skipped bool
+}
+
+// ExportAccounts exports the accounts with the given IDs, or all accounts if no
+// IDs are given, together with their ledgers into the versioned and
+// checksummed export format. The export and the number of accounts it contains
+// are returned. The export fails if any of the accounts still has payments in
+// flight.
+func (s *InterceptorService) ExportAccounts(ids []AccountID) ([]byte, int,
+	error) {
+
+	s.RLock()
+	defer s.RUnlock()
+
+	var accounts []*OffChainBalanceAccount
+	if len(ids) == 0 {
+		allAccounts, err := s.store.Accounts()
+		if err != nil {
+			return nil, 0, fmt.Errorf("error querying accounts: %w",
+				err)
+		}
+		accounts = allAccounts
+	}
+
+	for _, id := range ids {
+		account, err := s.store.Account(id)
+		if err != nil {
+			return nil, 0, fmt.Errorf("error fetching account %x: "+
+				"%w", id[:], err)
+		}
+		accounts = append(accounts, account)
+	}
+
+	exported := make([]*ExportedAccount, 0, len(accounts))
+	for _, account := range accounts {
+		if err := ensureNoPaymentsInFlight(account); err != nil {
+			return nil, 0, err
+		}
+
+		ledger, err := s.store.LedgerEntries(account.ID, 0, 0)
+		if err != nil {
+			return nil, 0, fmt.Errorf("error fetching ledger of "+
+				"account %x: %w", account.ID[:], err)
+		}
+
+		exported = append(exported, &ExportedAccount{
+			Account: account,
+			Ledger:  ledger,
+		})
+	}
+
+	export, err := encodeAccountExport(exported)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return export, len(accounts), nil
+}
+
+// ImportAccounts imports all accounts of the given account export together with
+// their ledgers. Accounts that have the ID of an existing account are handled
+// according to the given policy. The invoices of the imported accounts are
+// mapped to them so that they are credited if the invoices are paid to this
+// node.
+func (s *InterceptorService) ImportAccounts(data []byte,
+	policy ImportPolicy) ([]*ImportedAccount, error) {
+
+	accounts, err := decodeAccountExport(data)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, account := range accounts {
+		err := ensureNoPaymentsInFlight(account.Account)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	s.Lock()
+	defer s.Unlock()
+
+	results, err := s.store.ImportAccounts(accounts, policy)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, result := range results {
+		if result.Skipped {
+			continue
+		}
+
+		for hash := range result.Account.Invoices {
+			s.invoiceToAccount[hash] = result.Account.ID
+		}
+	}
+
+	return results, nil
+}
+
+// encodeAccountExport encodes the given accounts into the versioned export
+// format. The export consists of a magic prefix, the format version, the
+// number of accounts and for each account its length prefixed TLV
+// serialization, the number of its ledger entries and their length prefixed
+// TLV serializations, followed by the SHA256 checksum of everything before it.
+// The checksum only detects accidental corruption, as anyone modifying the
+// export can also compute a new one.
+func encodeAccountExport(accounts []*ExportedAccount) ([]byte, error) {
+	var (
+		buf     bytes.Buffer
+		scratch [8]byte
+	)
+
+	buf.Write(exportMagic)
+
+	byteOrder.PutUint16(scratch[:2], exportVersion)
+	buf.Write(scratch[:2])
+
+	err := tlv.WriteVarInt(&buf, uint64(len(accounts)), &scratch)
+	if err != nil {
+		return nil, err
+	}
+
+	writeRecord := func(record []byte) error {
+		err := tlv.WriteVarInt(&buf, uint64(len(record)), &scratch)
+		if err != nil {
+			return err
+		}
+
+		_, err = buf.Write(record)
+		return err
+	}
+
+	for _, exported := range accounts {
+		account := exported.Account
+		accountBinary, err := serializeAccount(account)
+		if err != nil {
+			return nil, fmt.Errorf("error serializing account "+
+				"%x: %w", account.ID[:], err)
+		}
+		if err := writeRecord(accountBinary); err != nil {
+			return nil, err
+		}
+
+		err = tlv.WriteVarInt(
+			&buf, uint64(len(exported.Ledger)), &scratch,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, entry := range exported.Ledger {
+			entryBinary, err := serializeLedgerEntry(entry)
+			if err != nil {
+				return nil, fmt.Errorf("error serializing "+
+					"ledger entry %d of account %x: %w",
+					entry.Index, account.ID[:], err)
+			}
+			if err := writeRecord(entryBinary); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	checksum := sha256.Sum256(buf.Bytes())
+	buf.Write(checksum[:])
+
+	return buf.Bytes(), nil
+}
+
+// decodeAccountExport verifies the checksum and version of the given account
+// export and decodes the accounts and ledgers contained in it.
+func decodeAccountExport(data []byte) ([]*ExportedAccount, error) {
+	if len(data) < len(exportMagic)+2+sha256.Size {
+		return nil, fmt.Errorf("account export too short")
+	}
+
+	if !bytes.HasPrefix(data, exportMagic) {
+		return nil, fmt.Errorf("not an account export")
+	}
+
+	content := data[:len(data)-sha256.Size]
+	checksum := sha256.Sum256(content)
+	if !bytes.Equal(checksum[:], data[len(content):]) {
+		return nil, ErrExportChecksumMismatch
+	}
+
+	var (
+		r       = bytes.NewReader(content[len(exportMagic):])
+		scratch [8]byte
+	)
+
+	if _, err := io.ReadFull(r, scratch[:2]); err != nil {
+		return nil, err
+	}
+	version := byteOrder.Uint16(scratch[:2])
+	if version != exportVersion && version != exportVersionNoLedger {
+		return nil, fmt.Errorf("unsupported account export version %d",
+			version)
+	}
+
+	numAccounts, err := tlv.ReadVarInt(r, &scratch)
+	if err != nil {
+		return nil, err
+	}
+
+	readRecord := func(maxSize uint64) ([]byte, error) {
+		size, err := tlv.ReadVarInt(r, &scratch)
+		if err != nil {
+			return nil, err
+		}
+
+		if size > maxSize || size > uint64(r.Len()) {
+			return nil, fmt.Errorf("invalid record size %d", size)
+		}
+
+		record := make([]byte, size)
+		if _, err := io.ReadFull(r, record); err != nil {
+			return nil, err
+		}
+
+		return record, nil
+	}
+
+	var accounts []*ExportedAccount
+	for i := uint64(0); i < numAccounts; i++ {
+		accountBinary, err := readRecord(maxExportedAccountSize)
+		if err != nil {
+			return nil, fmt.Errorf("error reading account: %w", err)
+		}
+
+		account, err := deserializeAccount(accountBinary)
+		if err != nil {
+			return nil, fmt.Errorf("error deserializing account: "+
+				"%w", err)
+		}
+
+		exported := &ExportedAccount{
+			Account: account,
+		}
+		accounts = append(accounts, exported)
+
+		if version == exportVersionNoLedger {
+			continue
+		}
+
+		numEntries, err := tlv.ReadVarInt(r, &scratch)
+		if err != nil {
+			return nil, err
+		}
+
+		for j := uint64(0); j < numEntries; j++ {
+			entryBinary, err := readRecord(maxExportedEntrySize)
+			if err != nil {
+				return nil, fmt.Errorf("error reading ledger "+
+					"entry: %w", err)
+			}
+
+			entry, err := deserializeLedgerEntry(entryBinary)
+			if err != nil {
+				return nil, fmt.Errorf("error deserializing "+
+					"ledger entry: %w", err)
+			}
+
+			exported.Ledger = append(exported.Ledger, entry)
+		}
+	}
+
+	if r.Len() != 0 {
+		return nil, fmt.Errorf("unexpected data after accounts")
+	}
+
+	return accounts, nil
+}
+
+// ensureNoPaymentsInFlight returns an error if the given account has payments
+// that are not yet in a final state. The balance of such an account could
+// still change after it was exported, and the node it is imported into can't
+// track payments that were sent by another node.
+func ensureNoPaymentsInFlight(account *OffChainBalanceAccount) error {
+	for hash, entry := range account.Payments {
+		if entry.Status == lnrpc.Payment_SUCCEEDED ||
+			entry.Status == lnrpc.Payment_FAILED {
+
+			continue
+		}
+
+		return fmt.Errorf("account %x has payment %v in flight",
+			account.ID[:], hash)
+	}
+
+	return nil
+}
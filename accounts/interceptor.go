@@ -5,6 +5,7 @@ import (
 	"encoding/hex"
 	"errors"
 	"fmt"
+	"strings"
 
 	mid "github.com/lightninglabs/lightning-terminal/rpcmiddleware"
 	"github.com/lightningnetwork/lnd/lnrpc"
@@ -18,6 +19,10 @@ const (
 	// certain account.
 	CondAccount = "account"
 
+	// createAccountURI is the full URI of the only LiT RPC a macaroon that
+	// is locked to an account can be used for.
+	createAccountURI = "/litrpc.Accounts/CreateAccount"
+
 	// accountMiddlewareName is the name that is used for the account system
 	// when registering it to lnd as an RPC middleware.
 	accountMiddlewareName = "lit-account"
@@ -160,25 +165,74 @@ func parseRPCMessage(msg *lnrpc.RPCMessage) (proto.Message, error) {
 	return parsedMsg, nil
 }
 
+// CheckAccountMacaroon makes sure a macaroon that is locked to an account is
+// only used to call the LiT RPC that creates a child account. Only macaroons of
+// parent accounts are baked with the permission required for that RPC, and the
+// account service additionally checks that the account is still allowed to
+// delegate. Macaroons that are not locked to an account are not restricted.
+func CheckAccountMacaroon(macBytes []byte, fullMethod string) error {
+	mac := &macaroon.Macaroon{}
+	if err := mac.UnmarshalBinary(macBytes); err != nil {
+		return fmt.Errorf("error parsing macaroon: %v", err)
+	}
+
+	acctID, err := accountFromMacaroon(mac)
+	if err != nil {
+		return fmt.Errorf("error parsing account from macaroon: %v",
+			err)
+	}
+
+	if acctID == nil {
+		return nil
+	}
+
+	if fullMethod != createAccountURI {
+		return fmt.Errorf("account %x macaroon cannot be used to call "+
+			"%s", acctID[:], fullMethod)
+	}
+
+	return nil
+}
+
 // accountFromMacaroon attempts to extract an account ID from the custom account
-// caveat in the macaroon.
+// caveats in the macaroon.
 func accountFromMacaroon(mac *macaroon.Macaroon) (*AccountID, error) {
-	// Extract the account caveat from the macaroon.
-	macaroonAccount := macaroons.GetCustomCaveatCondition(mac, CondAccount)
-	if macaroonAccount == "" {
-		// There is no condition that locks the macaroon to an account,
-		// so there is nothing to check.
-		return nil, nil
+	var accountID *AccountID
+
+	// A macaroon can contain multiple account caveats, so we can't just
+	// use the first one we find.
+	caveatPrefix := fmt.Sprintf("%s %s ", macaroons.CondLndCustom,
+		CondAccount)
+	for _, caveat := range mac.Caveats() {
+		if !strings.HasPrefix(string(caveat.Id), caveatPrefix) {
+			continue
+		}
+		condition := strings.TrimPrefix(string(caveat.Id), caveatPrefix)
+
+		// The macaroon is indeed locked to an account.
+		accountIDBytes, err := hex.DecodeString(condition)
+		if err != nil {
+			return nil, err
+		}
+
+		var id AccountID
+		copy(id[:], accountIDBytes)
+
+		// Adding another caveat must never widen the access of a
+		// macaroon, so we don't allow it to be locked to two different
+		// accounts.
+		if accountID != nil && *accountID != id {
+			return nil, fmt.Errorf("macaroon is locked to " +
+				"multiple accounts")
+		}
+		accountID = &id
 	}
 
-	// The macaroon is indeed locked to an account. Fetch the account and
-	// validate its balance.
-	accountIDBytes, err := hex.DecodeString(macaroonAccount)
-	if err != nil {
-		return nil, err
+	// There is no condition that locks the macaroon to an account, so
+	// there is nothing to check.
+	if accountID == nil {
+		return nil, nil
 	}
 
-	var accountID AccountID
-	copy(accountID[:], accountIDBytes)
-	return &accountID, nil
+	return accountID, nil
 }
@@ -17,6 +17,18 @@ const (
 	// identifier of an account. It is 8 bytes long so guessing is
 	// improbable, but it's still not mistaken for a SHA256 hash.
 	AccountIDLen = 8
+
+	// MaxLabelLength is the maximum length of an account label and of the
+	// keys of account metadata.
+	MaxLabelLength = 64
+
+	// MaxMetadataValueLength is the maximum length of an account metadata
+	// value.
+	MaxMetadataValueLength = 256
+
+	// MaxMetadataEntries is the maximum number of metadata entries an
+	// account can have.
+	MaxMetadataEntries = 32
 )
 
 // AccountType is an enum-like type which denotes the possible account types
@@ -29,10 +41,122 @@ const (
 	// automatically.
 	TypeInitialBalance AccountType = 0
 
-	// TODO(guggero): Add support for auto-replenishing (e.g. monthly
-	// allowance) or spend-only (no invoice creation) accounts.
+	// TypeRecurringAllowance represents an account that has its balance
+	// topped back up to its initial balance at the start of every renewal
+	// period (e.g. a monthly allowance).
+	TypeRecurringAllowance AccountType = 1
+)
+
+// AccountFlags is a bit field that restricts or extends the capabilities of an
+// account. An account without any flags set can both send and receive payments.
+type AccountFlags uint8
+
+const (
+	// FlagNoSend marks an account that is not allowed to send any payments.
+	// Such a receive-only account can be used as a "donation box" that
+	// only ever collects funds through invoices.
+	FlagNoSend AccountFlags = 1 << 0
+
+	// FlagNoReceive marks an account that is not allowed to create any
+	// invoices and can therefore only spend its balance.
+	FlagNoReceive AccountFlags = 1 << 1
+
+	// FlagDelegate marks a parent account whose macaroon can create child
+	// accounts. The balance of every child account is carved out of the
+	// current balance of the parent account. The macaroon of the account
+	// also needs the additional DelegatePermissions, which it is only
+	// given if the flag is set when the macaroon is baked. Clearing the
+	// flag therefore revokes delegation immediately, while setting it only
+	// has an effect for macaroons that were baked with the permissions.
+	FlagDelegate AccountFlags = 1 << 2
+
+	// flagsAll is a mask of all currently known account flags.
+	flagsAll = FlagNoSend | FlagNoReceive | FlagDelegate
+)
+
+// Has returns true if all the given flags are set.
+func (f AccountFlags) Has(flags AccountFlags) bool {
+	return f&flags == flags
+}
+
+// validate makes sure only known flags are set and that the flags don't
+// disable both sending and receiving, which would make the account useless.
+func (f AccountFlags) validate() error {
+	if unknown := f &^ flagsAll; unknown != 0 {
+		return fmt.Errorf("unknown account flags %08b", uint8(unknown))
+	}
+
+	if f.Has(FlagNoSend | FlagNoReceive) {
+		return fmt.Errorf("an account cannot be both send-only and " +
+			"receive-only")
+	}
+
+	return nil
+}
+
+// RenewalPeriod is an enum-like type which denotes the interval at which the
+// balance of an account of type TypeRecurringAllowance is replenished.
+type RenewalPeriod uint8
+
+const (
+	// RenewalNone means the account balance is never replenished.
+	RenewalNone RenewalPeriod = 0
+
+	// RenewalDaily means the account balance is replenished every day.
+	RenewalDaily RenewalPeriod = 1
+
+	// RenewalWeekly means the account balance is replenished every week.
+	RenewalWeekly RenewalPeriod = 2
+
+	// RenewalMonthly means the account balance is replenished every month.
+	RenewalMonthly RenewalPeriod = 3
 )
 
+// String returns the string representation of a renewal period.
+func (p RenewalPeriod) String() string {
+	switch p {
+	case RenewalNone:
+		return "none"
+
+	case RenewalDaily:
+		return "daily"
+
+	case RenewalWeekly:
+		return "weekly"
+
+	case RenewalMonthly:
+		return "monthly"
+
+	default:
+		return fmt.Sprintf("unknown<%d>", uint8(p))
+	}
+}
+
+// after returns the first renewal time that is strictly after the given
+// reference time, starting from the given previous renewal time. If the node
+// was offline for multiple periods, those periods are skipped, so an account
+// is never topped up more than once.
+func (p RenewalPeriod) after(prev, ref time.Time) time.Time {
+	next := prev
+	for !next.After(ref) {
+		switch p {
+		case RenewalDaily:
+			next = next.AddDate(0, 0, 1)
+
+		case RenewalWeekly:
+			next = next.AddDate(0, 0, 7)
+
+		case RenewalMonthly:
+			next = next.AddDate(0, 1, 0)
+
+		default:
+			return time.Time{}
+		}
+	}
+
+	return next
+}
+
 // AccountID represents an account's unique ID.
 type AccountID [AccountIDLen]byte
 
@@ -53,6 +177,50 @@ func ParseAccountID(idStr string) (*AccountID, error) {
 	return &id, nil
 }
 
+// validateLabel makes sure the given account label can be used to uniquely
+// identify an account. An empty label is valid and means no label is set.
+func validateLabel(label string) error {
+	if len(label) > MaxLabelLength {
+		return fmt.Errorf("label cannot be longer than %d characters",
+			MaxLabelLength)
+	}
+
+	// A label that looks like an account ID would make it impossible to
+	// tell whether a user meant the label or the ID.
+	if _, err := ParseAccountID(label); err == nil {
+		return fmt.Errorf("label cannot be a valid account ID")
+	}
+
+	return nil
+}
+
+// validateMetadata makes sure the given account metadata is well-formed.
+func validateMetadata(metadata map[string]string) error {
+	if len(metadata) > MaxMetadataEntries {
+		return fmt.Errorf("an account cannot have more than %d "+
+			"metadata entries", MaxMetadataEntries)
+	}
+
+	for key, value := range metadata {
+		if key == "" {
+			return fmt.Errorf("metadata key cannot be empty")
+		}
+
+		if len(key) > MaxLabelLength {
+			return fmt.Errorf("metadata key cannot be longer than "+
+				"%d characters", MaxLabelLength)
+		}
+
+		if len(value) > MaxMetadataValueLength {
+			return fmt.Errorf("metadata value of key %s cannot be "+
+				"longer than %d characters", key,
+				MaxMetadataValueLength)
+		}
+	}
+
+	return nil
+}
+
 // PaymentEntry is the data we track per payment that is associated with an
 // account. This basically includes all information required to make sure
 // in-flight payments don't exceed the total available account balance.
@@ -65,6 +233,335 @@ type PaymentEntry struct {
 	// set to the fee limit set when sending the payment and updated to the
 	// actual routing fee when the payment settles.
 	FullAmount lnwire.MilliSatoshi
+
+	// Timestamp is the time at which we started tracking the payment. It
+	// is used to determine whether the payment counts towards the rolling
+	// spend limit of the account. Payments that were tracked before spend
+	// limits were introduced have a zero timestamp.
+	Timestamp time.Time
+
+	// FiatPrice is the price of one minor unit of the account's fiat
+	// currency the payment was checked with. The payment is debited from
+	// the fiat budget at this price once it succeeds. It is zero if the
+	// account has no fiat budget.
+	FiatPrice lnwire.MilliSatoshi
+}
+
+// SpendLimits holds the optional limits that restrict how fast the balance of
+// an account can be spent. A zero value means no limits apply.
+type SpendLimits struct {
+	// MaxPaymentAmount is the maximum amount in millisatoshis a single
+	// payment can send, excluding routing fees. Zero means no limit.
+	MaxPaymentAmount lnwire.MilliSatoshi
+
+	// WindowLimit is the maximum amount in millisatoshis, including
+	// routing fees, that can be spent within the rolling window. Zero
+	// means no limit.
+	WindowLimit lnwire.MilliSatoshi
+
+	// Window is the duration of the rolling window the WindowLimit
+	// applies to.
+	Window time.Duration
+}
+
+// validate makes sure the spend limits are consistent.
+func (l SpendLimits) validate() error {
+	if l.WindowLimit > 0 && l.Window <= 0 {
+		return fmt.Errorf("a spend limit requires a positive window " +
+			"duration")
+	}
+
+	if l.Window != 0 && l.WindowLimit == 0 {
+		return fmt.Errorf("a spend limit window requires a spend " +
+			"limit amount")
+	}
+
+	return nil
+}
+
+// FiatBudget is an optional budget of an account that is denominated in a fiat
+// currency. Payments are converted into the currency at the price at the time
+// of the payment and are only allowed while the budget isn't exhausted.
+type FiatBudget struct {
+	// Currency is the upper case ISO 4217 code of the currency, for
+	// example "EUR".
+	Currency string
+
+	// Budget is the total budget in the minor unit of the currency, for
+	// example in cents.
+	Budget int64
+
+	// Spent is the amount in the minor unit of the currency that was
+	// spent by succeeded payments so far.
+	Spent int64
+}
+
+// validate makes sure the fiat budget is consistent.
+func (b *FiatBudget) validate() error {
+	if err := validateCurrency(b.Currency); err != nil {
+		return err
+	}
+
+	if b.Budget <= 0 {
+		return fmt.Errorf("a fiat budget must be positive")
+	}
+
+	return nil
+}
+
+// LedgerEntryType is an enum-like type which denotes the kind of change to an
+// account's balance that a ledger entry records.
+type LedgerEntryType uint8
+
+const (
+	// LedgerInvoiceSettled records an invoice of the account being settled
+	// and its amount being credited to the account.
+	LedgerInvoiceSettled LedgerEntryType = 1
+
+	// LedgerPaymentSucceeded records a payment of the account succeeding
+	// and its amount and routing fee being debited from the account.
+	LedgerPaymentSucceeded LedgerEntryType = 2
+
+	// LedgerPaymentFailed records a payment of the account failing. The
+	// balance of the account is not changed.
+	LedgerPaymentFailed LedgerEntryType = 3
+
+	// LedgerAdminUpdate records an update of the account by the node
+	// operator through the UpdateAccount RPC.
+	LedgerAdminUpdate LedgerEntryType = 4
+
+	// LedgerRenewal records the balance of a recurring allowance account
+	// being topped back up at the start of a new renewal period.
+	LedgerRenewal LedgerEntryType = 5
+
+	// LedgerTransferSent records an amount being moved from the account
+	// to another account on the same node without touching the network.
+	LedgerTransferSent LedgerEntryType = 6
+
+	// LedgerTransferReceived records an amount being moved to the account
+	// from another account on the same node without touching the network.
+	LedgerTransferReceived LedgerEntryType = 7
+
+	// LedgerChildFunded records an amount being carved out of the account
+	// to fund the initial balance of a new child account.
+	LedgerChildFunded LedgerEntryType = 8
+)
+
+// String returns the string representation of a ledger entry type.
+func (t LedgerEntryType) String() string {
+	switch t {
+	case LedgerInvoiceSettled:
+		return "invoice_settled"
+
+	case LedgerPaymentSucceeded:
+		return "payment_succeeded"
+
+	case LedgerPaymentFailed:
+		return "payment_failed"
+
+	case LedgerAdminUpdate:
+		return "admin_update"
+
+	case LedgerRenewal:
+		return "renewal"
+
+	case LedgerTransferSent:
+		return "transfer_sent"
+
+	case LedgerTransferReceived:
+		return "transfer_received"
+
+	case LedgerChildFunded:
+		return "child_funded"
+
+	default:
+		return fmt.Sprintf("unknown<%d>", uint8(t))
+	}
+}
+
+// ExpiryActionType is an enum-like type which denotes the action that is taken
+// on an account once it has expired.
+type ExpiryActionType uint8
+
+const (
+	// ExpiryActionKeep leaves an expired account untouched.
+	ExpiryActionKeep ExpiryActionType = 0
+
+	// ExpiryActionRemove removes an expired account after a configured
+	// number of days.
+	ExpiryActionRemove ExpiryActionType = 1
+
+	// ExpiryActionSweep moves the remaining balance of an expired account
+	// to a designated treasury account.
+	ExpiryActionSweep ExpiryActionType = 2
+)
+
+// String returns the string representation of an expiry action type.
+func (t ExpiryActionType) String() string {
+	switch t {
+	case ExpiryActionKeep:
+		return "keep"
+
+	case ExpiryActionRemove:
+		return "remove"
+
+	case ExpiryActionSweep:
+		return "sweep"
+
+	default:
+		return fmt.Sprintf("unknown<%d>", uint8(t))
+	}
+}
+
+// ExpiryActionEntry is an audit record of an action that was taken on an
+// account after it expired. The records are kept independently of the
+// account, so they survive the removal of the account.
+type ExpiryActionEntry struct {
+	// Index is the sequence number of the entry within the audit log,
+	// starting at 1. It is assigned by the store when the entry is added.
+	Index uint64
+
+	// AccountID is the ID of the expired account the action was taken on.
+	AccountID AccountID
+
+	// Action is the action that was taken.
+	Action ExpiryActionType
+
+	// Amount is the amount in millisatoshis that was swept to the treasury
+	// account or, for a removed account, the balance that was left when
+	// the account was removed.
+	Amount int64
+
+	// Treasury is the ID of the account the balance was swept to, which is
+	// the parent account for child accounts and the treasury account for
+	// all others. It is only set for sweep actions.
+	Treasury AccountID
+
+	// Timestamp is the time the action was taken at. It is set by the
+	// store when the entry is added.
+	Timestamp time.Time
+}
+
+// LedgerEntry is a single entry in the append-only transaction ledger of an
+// account. Every change to the balance of an account is recorded as an entry,
+// which makes it possible to explain how the current balance came to be.
+type LedgerEntry struct {
+	// Index is the sequence number of the entry within the account's
+	// ledger, starting at 1. It is assigned by the store when the entry
+	// is added.
+	Index uint64
+
+	// Type is the kind of change the entry records.
+	Type LedgerEntryType
+
+	// Hash is the hash of the invoice or payment the entry refers to. It
+	// is empty for entries that don't refer to an invoice or payment.
+	Hash lntypes.Hash
+
+	// Amount is the amount in millisatoshis the balance of the account was
+	// changed by, excluding any routing fee. Credits are positive and
+	// debits are negative. For a failed payment this is zero, as the
+	// balance was not changed.
+	Amount int64
+
+	// Fee is the routing fee in millisatoshis that was debited from the
+	// account in addition to the amount.
+	Fee lnwire.MilliSatoshi
+
+	// Released is the amount in millisatoshis, including the maximum
+	// routing fee, that was reserved for a failed payment and is available
+	// to the account again. It is zero for all other entries.
+	Released lnwire.MilliSatoshi
+
+	// Timestamp is the time the entry was recorded at. It is set by the
+	// store when the entry is added.
+	Timestamp time.Time
+
+	// Balance is the balance of the account in millisatoshis after the
+	// change was applied. It is set by the store when the entry is added.
+	Balance int64
+
+	// FiatPrice is the price of one minor unit of the fiat currency of the
+	// account in millisatoshis that was used to convert a debit into the
+	// currency of the account's fiat budget. It is zero if the debit
+	// wasn't counted against a fiat budget.
+	FiatPrice lnwire.MilliSatoshi
+
+	// FiatAmount is the amount in the minor unit of the fiat currency of
+	// the account that was counted against its fiat budget.
+	FiatAmount int64
+}
+
+// AccountUpdateType is an enum-like type which denotes the kind of event an
+// account update notifies about.
+type AccountUpdateType uint8
+
+const (
+	// UpdateTypeBalance notifies about a change of the account's balance,
+	// for example because an invoice was settled or the account was
+	// updated by the node operator.
+	UpdateTypeBalance AccountUpdateType = 1
+
+	// UpdateTypePayment notifies about a payment of the account changing
+	// its state.
+	UpdateTypePayment AccountUpdateType = 2
+
+	// UpdateTypeExpired notifies about the account reaching its
+	// expiration date.
+	UpdateTypeExpired AccountUpdateType = 3
+
+	// UpdateTypeRemoved notifies about the account being removed.
+	UpdateTypeRemoved AccountUpdateType = 4
+
+	// UpdateTypeExpiringSoon notifies about the account reaching its
+	// expiration date within the configured warning period.
+	UpdateTypeExpiringSoon AccountUpdateType = 5
+)
+
+// String returns the string representation of an account update type.
+func (t AccountUpdateType) String() string {
+	switch t {
+	case UpdateTypeBalance:
+		return "balance"
+
+	case UpdateTypePayment:
+		return "payment"
+
+	case UpdateTypeExpired:
+		return "expired"
+
+	case UpdateTypeRemoved:
+		return "removed"
+
+	case UpdateTypeExpiringSoon:
+		return "expiring soon"
+
+	default:
+		return fmt.Sprintf("unknown<%d>", uint8(t))
+	}
+}
+
+// AccountUpdate is an event that is sent to subscribers of account updates
+// whenever the state of an account changes.
+type AccountUpdate struct {
+	// Type is the kind of event the update notifies about.
+	Type AccountUpdateType
+
+	// Account is the state of the account after the change. For an
+	// account that was removed, this is the last state before the
+	// removal.
+	Account *OffChainBalanceAccount
+
+	// PaymentHash is the hash of the payment that changed its state. It
+	// is only set for updates of type UpdateTypePayment.
+	PaymentHash lntypes.Hash
+
+	// PaymentStatus is the new state of the payment. It is only set for
+	// updates of type UpdateTypePayment.
+	PaymentStatus lnrpc.Payment_PaymentStatus
+
+	// Timestamp is the time the update was created at.
+	Timestamp time.Time
 }
 
 // OffChainBalanceAccount holds all information that is needed to keep track of
@@ -104,6 +601,49 @@ type OffChainBalanceAccount struct {
 	// Payments is a list of all payments that are associated with the
 	// account and the last status we were aware of.
 	Payments map[lntypes.Hash]*PaymentEntry
+
+	// RenewalPeriod is the interval at which the balance of an account of
+	// type TypeRecurringAllowance is topped back up to its initial
+	// balance. This is RenewalNone for all other account types.
+	RenewalPeriod RenewalPeriod
+
+	// NextRenewal is the time at which the balance of an account of type
+	// TypeRecurringAllowance is topped up next. This is a zero time for
+	// all other account types.
+	NextRenewal time.Time
+
+	// Flags restricts the capabilities of the account, for example to
+	// only send or only receive payments.
+	Flags AccountFlags
+
+	// SpendLimits are the optional limits that restrict how fast the
+	// balance of the account can be spent.
+	SpendLimits SpendLimits
+
+	// FiatBudget is the optional budget of the account in a fiat currency.
+	// It limits spending in addition to the balance of the account.
+	FiatBudget *FiatBudget
+
+	// Label is an optional human-readable label of the account. If set,
+	// it is unique among all accounts and can be used to look up the
+	// account instead of its ID.
+	Label string
+
+	// Metadata is optional free-form key/value data the node operator can
+	// attach to the account, for example to map it to a customer.
+	Metadata map[string]string
+
+	// AmpInvoices maps the hashes of the AMP invoices of the account that
+	// have been paid at least once to the total amount that has already
+	// been credited to the account for them. AMP invoices can be paid
+	// multiple times, so we need to track the amount to only credit the
+	// difference on each payment.
+	AmpInvoices map[lntypes.Hash]lnwire.MilliSatoshi
+
+	// ParentID is the ID of the parent account that created this account
+	// and whose balance the initial balance of this account was carved
+	// out of. It is empty for accounts created by the node operator.
+	ParentID AccountID
 }
 
 // HasExpired returns true if the account has an expiration date set and that
@@ -116,6 +656,97 @@ func (a *OffChainBalanceAccount) HasExpired() bool {
 	return a.ExpirationDate.Before(time.Now())
 }
 
+// CanSend returns true if the account is allowed to send payments.
+func (a *OffChainBalanceAccount) CanSend() bool {
+	return !a.Flags.Has(FlagNoSend)
+}
+
+// CanReceive returns true if the account is allowed to create invoices.
+func (a *OffChainBalanceAccount) CanReceive() bool {
+	return !a.Flags.Has(FlagNoReceive)
+}
+
+// CanDelegate returns true if the account is allowed to create child accounts.
+func (a *OffChainBalanceAccount) CanDelegate() bool {
+	return a.Flags.Has(FlagDelegate)
+}
+
+// HasParent returns true if the account is the child account of another
+// account.
+func (a *OffChainBalanceAccount) HasParent() bool {
+	return a.ParentID != zeroID
+}
+
+// MatchesMetadata returns true if the account has all the given metadata
+// key/value pairs.
+func (a *OffChainBalanceAccount) MatchesMetadata(
+	metadata map[string]string) bool {
+
+	for key, value := range metadata {
+		if actual, ok := a.Metadata[key]; !ok || actual != value {
+			return false
+		}
+	}
+
+	return true
+}
+
+// spentInWindow returns the full amount of all payments that were made within
+// the rolling spend limit window that ends at the given reference time. Failed
+// payments are not counted, in-flight payments are counted with their full
+// amount, including the maximum routing fee.
+func (a *OffChainBalanceAccount) spentInWindow(
+	now time.Time) lnwire.MilliSatoshi {
+
+	windowStart := now.Add(-a.SpendLimits.Window)
+
+	var spent lnwire.MilliSatoshi
+	for _, entry := range a.Payments {
+		if entry.Status == lnrpc.Payment_FAILED {
+			continue
+		}
+
+		if entry.Timestamp.IsZero() ||
+			!entry.Timestamp.After(windowStart) {
+
+			continue
+		}
+
+		spent += entry.FullAmount
+	}
+
+	return spent
+}
+
+// renew tops the balance of an account of type TypeRecurringAllowance back up
+// to its initial balance if the next renewal time has been reached and
+// schedules the following renewal. True is returned if the account was
+// modified and needs to be persisted.
+func (a *OffChainBalanceAccount) renew(now time.Time) bool {
+	if a.Type != TypeRecurringAllowance || a.NextRenewal.After(now) {
+		return false
+	}
+
+	// An expired account is never topped up again, otherwise its balance
+	// could be swept to the treasury account over and over.
+	if !a.ExpirationDate.IsZero() && !a.ExpirationDate.After(now) {
+		return false
+	}
+
+	// Any balance that was left over from the previous period is not
+	// carried over. But we also don't take away any balance that was
+	// added on top of the allowance by receiving payments.
+	if a.CurrentBalance < int64(a.InitialBalance) {
+		a.CurrentBalance = int64(a.InitialBalance)
+	}
+	if a.FiatBudget != nil {
+		a.FiatBudget.Spent = 0
+	}
+	a.NextRenewal = a.RenewalPeriod.after(a.NextRenewal, now)
+
+	return true
+}
+
 // CurrentBalanceSats returns the current account balance in satoshis.
 func (a *OffChainBalanceAccount) CurrentBalanceSats() int64 {
 	return a.CurrentBalance / 1000
@@ -150,6 +781,60 @@ var (
 	ErrNotSupportedWithAccounts = errors.New("this RPC call is not " +
 		"supported with restricted account macaroons")
 
+	// ErrAccSendDisabled is returned if a receive-only account attempts
+	// to send a payment.
+	ErrAccSendDisabled = errors.New("account is not allowed to send " +
+		"payments")
+
+	// ErrAccReceiveDisabled is returned if a spend-only account attempts
+	// to create an invoice.
+	ErrAccReceiveDisabled = errors.New("account is not allowed to " +
+		"create invoices")
+
+	// ErrAccPaymentLimitExceeded is returned if the amount of a single
+	// payment is larger than the maximum payment amount of the account.
+	ErrAccPaymentLimitExceeded = errors.New("account payment amount " +
+		"limit exceeded")
+
+	// ErrAccSpendLimitExceeded is returned if a payment would cause the
+	// total amount spent within the rolling spend limit window of the
+	// account to exceed the limit.
+	ErrAccSpendLimitExceeded = errors.New("account rolling spend limit " +
+		"exceeded")
+
+	// ErrLabelAlreadyExists is returned if an account label is already
+	// used by another account.
+	ErrLabelAlreadyExists = errors.New("account label already exists")
+
+	// ErrAccFiatBudgetExceeded is returned if a payment, converted into
+	// the fiat currency of the account, would exceed its fiat budget.
+	ErrAccFiatBudgetExceeded = errors.New("account fiat budget exceeded")
+
+	// ErrNoPriceOracle is returned if an account has a fiat budget but no
+	// price oracle is configured to convert payments into its currency.
+	ErrNoPriceOracle = errors.New("no price oracle configured")
+
+	// ErrDelegationNotAllowed is returned if an account that is not a
+	// parent account attempts to create a child account.
+	ErrDelegationNotAllowed = errors.New("account is not allowed to " +
+		"create child accounts")
+
+	// ErrSettledInternally is returned instead of forwarding a payment to
+	// lnd if the invoice being paid belongs to another account on the same
+	// node and the payment was therefore settled by moving the balance
+	// between the two accounts directly. Clients should treat this error
+	// as a successful payment.
+	ErrSettledInternally = errors.New("payment settled internally " +
+		"between accounts")
+
+	// DelegatePermissions are the permissions the macaroon of a parent
+	// account requires in addition to MacaroonPermissions to be able to
+	// create child accounts.
+	DelegatePermissions = []bakery.Op{{
+		Entity: "account",
+		Action: "write",
+	}}
+
 	// MacaroonPermissions are the permissions required for an account
 	// macaroon.
 	MacaroonPermissions = []bakery.Op{{
@@ -179,9 +864,27 @@ var (
 // Store is the main account store interface.
 type Store interface {
 	// NewAccount creates a new OffChainBalanceAccount with the given
-	// balance and a randomly chosen ID.
-	NewAccount(balance lnwire.MilliSatoshi,
-		expirationDate time.Time) (*OffChainBalanceAccount, error)
+	// balance and a randomly chosen ID. If the renewal period is not
+	// RenewalNone, the account is of type TypeRecurringAllowance and the
+	// balance is topped back up to the given balance periodically. The
+	// given flags restrict the capabilities of the account and the given
+	// limits restrict how fast its balance can be spent. The optional
+	// label must be unique among all accounts. The optional fiat budget
+	// limits spending in a fiat currency.
+	NewAccount(balance lnwire.MilliSatoshi, expirationDate time.Time,
+		renewalPeriod RenewalPeriod, flags AccountFlags,
+		limits SpendLimits, label string, metadata map[string]string,
+		fiatBudget *FiatBudget) (*OffChainBalanceAccount, error)
+
+	// NewChildAccount creates a new OffChainBalanceAccount of type
+	// TypeInitialBalance as the child of the given parent account. The
+	// balance of the new account is debited from the current balance of
+	// the parent account in the same database transaction, and the debit
+	// is recorded in the ledger of the parent account.
+	NewChildAccount(parentID AccountID, balance lnwire.MilliSatoshi,
+		expirationDate time.Time, flags AccountFlags,
+		limits SpendLimits, label string, metadata map[string]string,
+		fiatBudget *FiatBudget) (*OffChainBalanceAccount, error)
 
 	// UpdateAccount writes an account to the database, overwriting the
 	// existing one if it exists.
@@ -194,10 +897,58 @@ type Store interface {
 	// Accounts retrieves all accounts from the store and un-marshals them.
 	Accounts() ([]*OffChainBalanceAccount, error)
 
+	// AccountByLabel retrieves the account with the given label. If no
+	// account has the label, then ErrAccNotFound is returned.
+	AccountByLabel(label string) (*OffChainBalanceAccount, error)
+
 	// RemoveAccount finds an account by its ID and removes it from the¨
 	// store.
 	RemoveAccount(id AccountID) error
 
+	// ImportAccounts stores the given accounts and their ledgers in a
+	// single database transaction. Accounts that have the ID of an existing
+	// account are handled according to the given policy. The import fails
+	// as a whole if any of the accounts uses the label of an existing
+	// account.
+	ImportAccounts(accounts []*ExportedAccount,
+		policy ImportPolicy) ([]*ImportedAccount, error)
+
+	// UpdateAccountWithEntry writes an account to the database and appends
+	// the given entry to its transaction ledger in a single database
+	// transaction. The index, timestamp and resulting balance of the entry
+	// are set by the store.
+	UpdateAccountWithEntry(account *OffChainBalanceAccount,
+		entry *LedgerEntry) error
+
+	// LedgerEntries returns at most maxEntries entries of the transaction
+	// ledger of the given account, starting with the first entry that has
+	// an index greater than the given index offset. A maxEntries value of
+	// zero means no limit.
+	LedgerEntries(id AccountID, indexOffset,
+		maxEntries uint64) ([]*LedgerEntry, error)
+
+	// TransferBalance atomically moves the given amount from one account
+	// to another and records the transfer in the ledgers of both accounts.
+	// If the hash is not empty, the transfer pays the invoice with that
+	// hash and is recorded as a succeeded payment of the sending account.
+	// If the fiat price is not zero, the amount is converted with it and
+	// counted against the fiat budget of the sending account.
+	TransferBalance(fromID, toID AccountID, amount lnwire.MilliSatoshi,
+		hash lntypes.Hash, fiatPrice lnwire.MilliSatoshi) error
+
+	// ApplyExpiryAction takes the action of the given entry on the expired
+	// account and appends the entry to the audit log of actions taken on
+	// expired accounts in a single database transaction. The index and
+	// timestamp of the entry are set by the store.
+	ApplyExpiryAction(entry *ExpiryActionEntry) error
+
+	// ExpiryActions returns at most maxEntries entries of the audit log of
+	// actions taken on expired accounts, starting with the first entry
+	// that has an index greater than the given index offset. A maxEntries
+	// value of zero means no limit.
+	ExpiryActions(indexOffset, maxEntries uint64) ([]*ExpiryActionEntry,
+		error)
+
 	// LastIndexes returns the last invoice add and settle index or
 	// ErrNoInvoiceIndexKnown if no indexes are known yet.
 	LastIndexes() (uint64, uint64, error)
@@ -205,6 +956,14 @@ type Store interface {
 	// StoreLastIndexes stores the last invoice add and settle index.
 	StoreLastIndexes(addIndex, settleIndex uint64) error
 
+	// LastExpiryCheck returns the time of the last check for expired
+	// accounts or a zero time if no check was recorded yet.
+	LastExpiryCheck() (time.Time, error)
+
+	// StoreLastExpiryCheck stores the time of the last check for expired
+	// accounts.
+	StoreLastExpiryCheck(lastCheck time.Time) error
+
 	// Close closes the underlying store.
 	Close() error
 }
@@ -212,9 +971,26 @@ type Store interface {
 // Service is the main account service interface.
 type Service interface {
 	// CheckBalance ensures an account is valid and has a balance equal to
-	// or larger than the amount that is required.
+	// or larger than the amount that is required. If the account has a
+	// fiat budget, the amount converted at the current price must also fit
+	// into the budget.
 	CheckBalance(id AccountID, requiredBalance lnwire.MilliSatoshi) error
 
+	// CheckSpendLimits ensures a payment doesn't exceed the per-payment
+	// or rolling window spend limits of an account. The payment amount
+	// excludes any routing fees while the full amount includes the
+	// maximum routing fee the payment can incur.
+	CheckSpendLimits(id AccountID, paymentAmt,
+		fullAmt lnwire.MilliSatoshi) error
+
+	// SettleInvoiceInternally pays the invoice with the given hash from the
+	// given account by moving the amount directly to the account the
+	// invoice belongs to, if the invoice belongs to another account on
+	// this node. The returned boolean indicates whether the invoice was
+	// settled internally.
+	SettleInvoiceInternally(id AccountID, hash lntypes.Hash,
+		amount lnwire.MilliSatoshi) (bool, error)
+
 	// AssociateInvoice associates a generated invoice with the given
 	// account, making it possible for the account to be credited in case
 	// the invoice is paid.
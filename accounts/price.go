@@ -0,0 +1,344 @@
+package accounts
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/lightningnetwork/lnd/lnwire"
+)
+
+const (
+	// priceQueryTimeout is the maximum time we wait for the price oracle
+	// to return a price. Prices are queried while the store lock is held,
+	// so this also limits how long other account operations are blocked
+	// by a slow oracle.
+	priceQueryTimeout = 5 * time.Second
+)
+
+// PriceOracle provides the current exchange rate between bitcoin and fiat
+// currencies.
+type PriceOracle interface {
+	// Price returns the current price of one minor unit (for example one
+	// cent) of the given fiat currency in millisatoshis.
+	Price(ctx context.Context, currency string) (lnwire.MilliSatoshi,
+		error)
+}
+
+// FilePriceOracle is a PriceOracle that reads the prices from a local JSON file
+// that maps currency codes to the price of one minor unit of the currency in
+// millisatoshis, for example {"EUR": 16000}. The file is read on every query,
+// so the prices can be updated without restarting.
+type FilePriceOracle struct {
+	path string
+}
+
+// NewFilePriceOracle creates a new price oracle that reads the prices from the
+// given file.
+func NewFilePriceOracle(path string) *FilePriceOracle {
+	return &FilePriceOracle{
+		path: path,
+	}
+}
+
+// Price returns the current price of one minor unit of the given fiat currency
+// in millisatoshis.
+//
+// NOTE: This is part of the PriceOracle interface.
+func (o *FilePriceOracle) Price(_ context.Context,
+	currency string) (lnwire.MilliSatoshi, error) {
+
+	f, err := os.Open(o.path)
+	if err != nil {
+		return 0, fmt.Errorf("error opening price file: %w", err)
+	}
+	defer f.Close()
+
+	return parsePrice(f, currency)
+}
+
+// HTTPPriceOracle is a PriceOracle that queries the prices from an HTTP
+// endpoint, usually a local service run by the node operator. The endpoint
+// must return the same JSON format as used by the FilePriceOracle.
+type HTTPPriceOracle struct {
+	url    string
+	client *http.Client
+}
+
+// NewHTTPPriceOracle creates a new price oracle that queries the prices from
+// the given URL.
+func NewHTTPPriceOracle(url string) *HTTPPriceOracle {
+	return &HTTPPriceOracle{
+		url: url,
+		client: &http.Client{
+			Timeout: priceQueryTimeout,
+		},
+	}
+}
+
+// Price returns the current price of one minor unit of the given fiat currency
+// in millisatoshis.
+//
+// NOTE: This is part of the PriceOracle interface.
+func (o *HTTPPriceOracle) Price(ctx context.Context,
+	currency string) (lnwire.MilliSatoshi, error) {
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, o.url, nil)
+	if err != nil {
+		return 0, err
+	}
+
+	resp, err := o.client.Do(req)
+	if err != nil {
+		return 0, fmt.Errorf("error querying price: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return 0, fmt.Errorf("error querying price: unexpected status "+
+			"%d", resp.StatusCode)
+	}
+
+	return parsePrice(resp.Body, currency)
+}
+
+// parsePrice reads a JSON object that maps currency codes to prices and
+// returns the price of the given currency.
+func parsePrice(r io.Reader, currency string) (lnwire.MilliSatoshi, error) {
+	var prices map[string]uint64
+	if err := json.NewDecoder(r).Decode(&prices); err != nil {
+		return 0, fmt.Errorf("error decoding prices: %w", err)
+	}
+
+	price, ok := prices[currency]
+	if !ok || price == 0 {
+		return 0, fmt.Errorf("no price for currency %s", currency)
+	}
+
+	return lnwire.MilliSatoshi(price), nil
+}
+
+// validateCurrency makes sure the given currency looks like an ISO 4217
+// currency code.
+func validateCurrency(currency string) error {
+	if len(currency) != 3 || strings.ToUpper(currency) != currency {
+		return fmt.Errorf("invalid currency %q, must be an upper case "+
+			"ISO 4217 code", currency)
+	}
+
+	for _, c := range currency {
+		if c < 'A' || c > 'Z' {
+			return fmt.Errorf("invalid currency %q, must be an "+
+				"upper case ISO 4217 code", currency)
+		}
+	}
+
+	return nil
+}
+
+// toFiat converts the given amount into the minor unit of a fiat currency with
+// the given price per minor unit. The result is rounded up, so even the
+// smallest payment is counted against a fiat budget.
+func toFiat(amount, price lnwire.MilliSatoshi) int64 {
+	fiat := int64(amount / price)
+	if amount%price != 0 {
+		fiat++
+	}
+
+	return fiat
+}
+
+// fiatPrice queries the price of one minor unit of the given currency from the
+// configured price oracle.
+//
+// NOTE: The store lock MUST NOT be held when calling this method, as querying
+// the oracle can take a while.
+func (s *InterceptorService) fiatPrice(
+	currency string) (lnwire.MilliSatoshi, error) {
+
+	if s.cfg.priceOracle == nil {
+		return 0, ErrNoPriceOracle
+	}
+
+	ctx, cancel := context.WithTimeout(s.mainCtx, priceQueryTimeout)
+	defer cancel()
+
+	price, err := s.cfg.priceOracle.Price(ctx, currency)
+	if err != nil {
+		return 0, fmt.Errorf("error querying %s price: %w", currency,
+			err)
+	}
+
+	s.pricesMtx.Lock()
+	s.lastPrices[currency] = price
+	s.pricesMtx.Unlock()
+
+	return price, nil
+}
+
+// budgetPrice queries the current price of the fiat currency of the given
+// account's budget, or returns zero if the account doesn't have a fiat budget.
+// The store lock is only held while fetching the account.
+//
+// NOTE: The store lock MUST NOT be held when calling this method.
+func (s *InterceptorService) budgetPrice(id AccountID) (lnwire.MilliSatoshi,
+	error) {
+
+	s.RLock()
+	account, err := s.store.Account(id)
+	s.RUnlock()
+	if err != nil {
+		return 0, err
+	}
+
+	if account.FiatBudget == nil {
+		return 0, nil
+	}
+
+	return s.fiatPrice(account.FiatBudget.Currency)
+}
+
+// paymentPrice returns the price the payment with the given hash is debited
+// from the fiat budget of the given account with once it succeeds. That is the
+// price the last payment of the account was checked with, the price stored
+// with the payment if we resume tracking it, or the current price otherwise.
+// Zero is returned if the account has no fiat budget.
+//
+// NOTE: The store lock MUST NOT be held when calling this method.
+func (s *InterceptorService) paymentPrice(id AccountID,
+	hash lntypes.Hash) (lnwire.MilliSatoshi, error) {
+
+	s.pricesMtx.Lock()
+	price, ok := s.checkedPrices[id]
+	delete(s.checkedPrices, id)
+	s.pricesMtx.Unlock()
+
+	if ok && price != 0 {
+		return price, nil
+	}
+
+	s.RLock()
+	account, err := s.store.Account(id)
+	s.RUnlock()
+	if err != nil {
+		return 0, fmt.Errorf("error fetching account: %w", err)
+	}
+
+	budget := account.FiatBudget
+	if budget == nil {
+		return 0, nil
+	}
+
+	if entry, ok := account.Payments[hash]; ok && entry.FiatPrice != 0 {
+		return entry.FiatPrice, nil
+	}
+
+	// The payment is already on its way, so we can't refuse to track it
+	// if the oracle is unavailable. It will be debited at the last known
+	// price instead.
+	price, err = s.fiatPrice(budget.Currency)
+	if err != nil {
+		log.Warnf("Unable to query %s price for payment %v of "+
+			"account %x: %v", budget.Currency, hash, id[:], err)
+
+		return 0, nil
+	}
+
+	return price, nil
+}
+
+// lastPrice returns the last price the price oracle returned for the given
+// currency.
+func (s *InterceptorService) lastPrice(currency string) (lnwire.MilliSatoshi,
+	bool) {
+
+	s.pricesMtx.Lock()
+	defer s.pricesMtx.Unlock()
+
+	price, ok := s.lastPrices[currency]
+	return price, ok
+}
+
+// checkFiatBudget makes sure that spending the given amount, converted at the
+// given price, doesn't exceed the fiat budget of the account, taking into
+// account all payments of the account that are still in flight. In-flight
+// payments are converted at the price they were checked with.
+//
+// NOTE: The store lock MUST be held when calling this method.
+func (s *InterceptorService) checkFiatBudget(account *OffChainBalanceAccount,
+	amount, price lnwire.MilliSatoshi) error {
+
+	budget := account.FiatBudget
+	if budget == nil {
+		return nil
+	}
+
+	// The account might have been given a budget after the price was
+	// queried.
+	if price == 0 {
+		return fmt.Errorf("no %s price available for account %x",
+			budget.Currency, account.ID[:])
+	}
+
+	spent := budget.Spent
+	for _, pendingPayment := range s.pendingPayments {
+		if pendingPayment.accountID != account.ID {
+			continue
+		}
+
+		paymentPrice := pendingPayment.fiatPrice
+		if paymentPrice == 0 {
+			paymentPrice = price
+		}
+		spent += toFiat(pendingPayment.fullAmount, paymentPrice)
+	}
+
+	if spent+toFiat(amount, price) > budget.Budget {
+		return ErrAccFiatBudgetExceeded
+	}
+
+	return nil
+}
+
+// debitFiatBudget counts the given amount of a succeeded payment, converted at
+// the given price the payment was checked with, against the fiat budget of the
+// account and records the price used in the given ledger entry. An error is
+// returned if the account has a fiat budget but no price is known.
+//
+// NOTE: The store lock MUST be held when calling this method.
+func (s *InterceptorService) debitFiatBudget(account *OffChainBalanceAccount,
+	amount, price lnwire.MilliSatoshi, entry *LedgerEntry) error {
+
+	budget := account.FiatBudget
+	if budget == nil {
+		return nil
+	}
+
+	// A payment that was tracked before the account was given a fiat
+	// budget was never checked against it. We still count it, using the
+	// last price we know.
+	if price == 0 {
+		lastPrice, ok := s.lastPrice(budget.Currency)
+		if !ok {
+			return fmt.Errorf("no %s price known to debit %d msat "+
+				"from the fiat budget of account %x",
+				budget.Currency, amount, account.ID[:])
+		}
+
+		log.Warnf("Using last known %s price of %d msat for account "+
+			"%x", budget.Currency, lastPrice, account.ID[:])
+		price = lastPrice
+	}
+
+	entry.FiatPrice = price
+	entry.FiatAmount = toFiat(amount, price)
+	budget.Spent += entry.FiatAmount
+
+	return nil
+}
@@ -3,6 +3,7 @@ package accounts
 import (
 	"context"
 	"encoding/hex"
+	"errors"
 	"fmt"
 	"time"
 
@@ -12,6 +13,8 @@ import (
 	"github.com/lightningnetwork/lnd/lntypes"
 	"github.com/lightningnetwork/lnd/lnwire"
 	"github.com/lightningnetwork/lnd/macaroons"
+	"google.golang.org/grpc/metadata"
+	"gopkg.in/macaroon-bakery.v2/bakery"
 	"gopkg.in/macaroon-bakery.v2/bakery/checkers"
 	"gopkg.in/macaroon.v2"
 )
@@ -46,12 +49,26 @@ func NewRPCServer(service *InterceptorService,
 // Accounts only assert a maximum amount spendable. Having a certain account
 // balance does not guarantee that the node has the channel liquidity to
 // actually spend that amount.
+//
+// If the request is made with the macaroon of a parent account, a child
+// account is created whose balance is carved out of the parent's balance.
 func (s *RPCServer) CreateAccount(ctx context.Context,
 	req *litrpc.CreateAccountRequest) (*litrpc.CreateAccountResponse,
 	error) {
 
-	log.Infof("[createaccount] balance=%d, expiration=%d",
-		req.AccountBalance, req.ExpirationDate)
+	log.Infof("[createaccount] balance=%d, expiration=%d, renewal=%v, "+
+		"send_only=%v, receive_only=%v, max_payment=%d, "+
+		"spend_limit=%d, spend_limit_window=%d, label=%q, "+
+		"fiat_currency=%s, fiat_budget=%d, allow_delegation=%v",
+		req.AccountBalance, req.ExpirationDate, req.RenewalPeriod,
+		req.SendOnly, req.ReceiveOnly, req.MaxPaymentAmount,
+		req.SpendLimit, req.SpendLimitWindow, req.Label,
+		req.FiatCurrency, req.FiatBudget, req.AllowDelegation)
+
+	parentID, err := delegatingAccount(ctx)
+	if err != nil {
+		return nil, err
+	}
 
 	var (
 		balanceMsat    lnwire.MilliSatoshi
@@ -69,27 +86,94 @@ func (s *RPCServer) CreateAccount(ctx context.Context,
 	balance := btcutil.Amount(req.AccountBalance)
 	balanceMsat = lnwire.NewMSatFromSatoshis(balance)
 
+	renewalPeriod, err := unmarshalRenewalPeriod(req.RenewalPeriod)
+	if err != nil {
+		return nil, err
+	}
+
+	flags := unmarshalAccountFlags(req.SendOnly, req.ReceiveOnly)
+	if req.AllowDelegation {
+		flags |= FlagDelegate
+	}
+
+	limits := unmarshalSpendLimits(
+		req.MaxPaymentAmount, req.SpendLimit, req.SpendLimitWindow,
+	)
+
+	var fiatBudget *FiatBudget
+	if req.FiatCurrency != "" || req.FiatBudget != 0 {
+		fiatBudget = &FiatBudget{
+			Currency: req.FiatCurrency,
+			Budget:   req.FiatBudget,
+		}
+	}
+
 	// Create the actual account in the macaroon account store.
-	account, err := s.service.NewAccount(balanceMsat, expirationDate)
+	var account *OffChainBalanceAccount
+	switch {
+	case parentID == nil:
+		account, err = s.service.NewAccount(
+			balanceMsat, expirationDate, renewalPeriod, flags,
+			limits, req.Label, req.Metadata, fiatBudget,
+		)
+
+	case renewalPeriod != RenewalNone:
+		err = fmt.Errorf("child accounts cannot have a renewal period")
+
+	default:
+		account, err = s.service.NewChildAccount(
+			*parentID, balanceMsat, expirationDate, flags, limits,
+			req.Label, req.Metadata, fiatBudget,
+		)
+	}
 	if err != nil {
 		return nil, fmt.Errorf("unable to create account: %v", err)
 	}
 
+	macBytes, err := s.bakeAccountMacaroon(
+		ctx, account.ID, account.CanDelegate(),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &litrpc.CreateAccountResponse{
+		Account:  marshalAccount(account),
+		Macaroon: macBytes,
+	}, nil
+}
+
+// bakeAccountMacaroon bakes a new macaroon with all permissions required to
+// access the account with the given ID that is locked to that account. If
+// delegate is true, the macaroon is also given the permissions to create child
+// accounts, which it can use as long as the account is allowed to delegate.
+func (s *RPCServer) bakeAccountMacaroon(ctx context.Context, id AccountID,
+	delegate bool) ([]byte, error) {
+
 	var rootKeyIdSuffix [4]byte
-	copy(rootKeyIdSuffix[:], account.ID[0:4])
+	copy(rootKeyIdSuffix[:], id[0:4])
 	macRootKey := session.NewSuperMacaroonRootKeyID(rootKeyIdSuffix)
 
 	accountCaveat := checkers.Condition(
 		macaroons.CondLndCustom,
-		fmt.Sprintf("%s %x", CondAccount, account.ID[:]),
+		fmt.Sprintf("%s %x", CondAccount, id[:]),
 	)
 
-	macHex, err := s.superMacBaker(ctx, macRootKey, &session.MacaroonRecipe{
+	recipe := &session.MacaroonRecipe{
 		Permissions: MacaroonPermissions,
 		Caveats: []macaroon.Caveat{{
 			Id: []byte(accountCaveat),
 		}},
-	})
+	}
+
+	if delegate {
+		recipe.Permissions = append(
+			append([]bakery.Op{}, MacaroonPermissions...),
+			DelegatePermissions...,
+		)
+	}
+
+	macHex, err := s.superMacBaker(ctx, macRootKey, recipe)
 	if err != nil {
 		return nil, fmt.Errorf("error baking account macaroon: %v", err)
 	}
@@ -100,18 +184,58 @@ func (s *RPCServer) CreateAccount(ctx context.Context,
 			err)
 	}
 
-	return &litrpc.CreateAccountResponse{
-		Account:  marshalAccount(account),
-		Macaroon: macBytes,
-	}, nil
+	return macBytes, nil
+}
+
+// delegatingAccount returns the ID of the parent account whose macaroon was
+// used to make the request, or nil if the request was made by the node
+// operator. Whether the account is allowed to delegate is checked by the
+// account service.
+func delegatingAccount(ctx context.Context) (*AccountID, error) {
+	// Requests that were authenticated with the UI password don't carry
+	// a macaroon in their original context.
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok || len(md.Get("macaroon")) == 0 {
+		return nil, nil
+	}
+
+	macHex, err := macaroons.RawMacaroonFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	macBytes, err := hex.DecodeString(macHex)
+	if err != nil {
+		return nil, fmt.Errorf("error decoding macaroon: %v", err)
+	}
+
+	mac := &macaroon.Macaroon{}
+	if err := mac.UnmarshalBinary(macBytes); err != nil {
+		return nil, fmt.Errorf("error parsing macaroon: %v", err)
+	}
+
+	parentID, err := accountFromMacaroon(mac)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing account from macaroon: "+
+			"%v", err)
+	}
+
+	return parentID, nil
 }
 
 // UpdateAccount updates an existing account in the account database.
 func (s *RPCServer) UpdateAccount(_ context.Context,
 	req *litrpc.UpdateAccountRequest) (*litrpc.Account, error) {
 
-	log.Infof("[updateaccount] id=%s, balance=%d, expiration=%d", req.Id,
-		req.AccountBalance, req.ExpirationDate)
+	log.Infof("[updateaccount] id=%s, balance=%d, expiration=%d, "+
+		"update_capabilities=%v, send_only=%v, receive_only=%v, "+
+		"update_spend_limits=%v, max_payment=%d, spend_limit=%d, "+
+		"spend_limit_window=%d, label=%q, clear_label=%v, "+
+		"metadata=%v, allow_delegation=%v", req.Id, req.AccountBalance,
+		req.ExpirationDate, req.UpdateCapabilities, req.SendOnly,
+		req.ReceiveOnly, req.UpdateSpendLimits, req.MaxPaymentAmount,
+		req.SpendLimit, req.SpendLimitWindow, req.Label, req.ClearLabel,
+		req.Metadata, req.AllowDelegation)
 
 	// Account ID is always a hex string, convert it to our account ID type.
 	var accountID AccountID
@@ -121,9 +245,42 @@ func (s *RPCServer) UpdateAccount(_ context.Context,
 	}
 	copy(accountID[:], decoded)
 
+	// The capabilities are only updated if explicitly requested.
+	var flags *AccountFlags
+	if req.UpdateCapabilities {
+		newFlags := unmarshalAccountFlags(req.SendOnly, req.ReceiveOnly)
+		if req.AllowDelegation {
+			newFlags |= FlagDelegate
+		}
+		flags = &newFlags
+	}
+
+	// The same goes for the spend limits.
+	var limits *SpendLimits
+	if req.UpdateSpendLimits {
+		newLimits := unmarshalSpendLimits(
+			req.MaxPaymentAmount, req.SpendLimit,
+			req.SpendLimitWindow,
+		)
+		limits = &newLimits
+	}
+
+	// An empty label means the label isn't updated, unless the caller
+	// explicitly asked for it to be cleared.
+	var label *string
+	switch {
+	case req.ClearLabel && req.Label != "":
+		return nil, fmt.Errorf("cannot set and clear the label at " +
+			"the same time")
+
+	case req.ClearLabel || req.Label != "":
+		label = &req.Label
+	}
+
 	// Ask the service to update the account.
 	account, err := s.service.UpdateAccount(
-		accountID, req.AccountBalance, req.ExpirationDate,
+		accountID, req.AccountBalance, req.ExpirationDate, flags,
+		limits, label, req.Metadata,
 	)
 	if err != nil {
 		return nil, err
@@ -133,24 +290,58 @@ func (s *RPCServer) UpdateAccount(_ context.Context,
 }
 
 // ListAccounts returns all accounts that are currently stored in the account
-// database.
-func (s *RPCServer) ListAccounts(context.Context,
-	*litrpc.ListAccountsRequest) (*litrpc.ListAccountsResponse, error) {
+// database, optionally filtered by label or metadata.
+func (s *RPCServer) ListAccounts(_ context.Context,
+	req *litrpc.ListAccountsRequest) (*litrpc.ListAccountsResponse,
+	error) {
+
+	log.Infof("[listaccounts] label=%q, metadata=%v, parent_id=%s",
+		req.Label, req.Metadata, req.ParentId)
 
-	log.Info("[listaccounts]")
+	var parentID *AccountID
+	if req.ParentId != "" {
+		id, err := ParseAccountID(req.ParentId)
+		if err != nil {
+			return nil, fmt.Errorf("invalid parent ID: %v", err)
+		}
+		parentID = id
+	}
 
-	// Retrieve all accounts from the macaroon account store.
-	accts, err := s.service.Accounts()
+	// Retrieve all accounts from the macaroon account store. If a label is
+	// given, there can be at most one matching account.
+	var (
+		accts []*OffChainBalanceAccount
+		err   error
+	)
+	if req.Label != "" {
+		var acct *OffChainBalanceAccount
+		acct, err = s.service.AccountByLabel(req.Label)
+		if err == nil {
+			accts = append(accts, acct)
+		} else if errors.Is(err, ErrAccNotFound) {
+			err = nil
+		}
+	} else {
+		accts, err = s.service.Accounts()
+	}
 	if err != nil {
 		return nil, fmt.Errorf("unable to list accounts: %v", err)
 	}
 
 	// Map the response into the proper response type and return it.
-	rpcAccounts := make([]*litrpc.Account, len(accts))
-	for i, acct := range accts {
+	rpcAccounts := make([]*litrpc.Account, 0, len(accts))
+	for _, acct := range accts {
 		acct := acct
 
-		rpcAccounts[i] = marshalAccount(acct)
+		if !acct.MatchesMetadata(req.Metadata) {
+			continue
+		}
+
+		if parentID != nil && acct.ParentID != *parentID {
+			continue
+		}
+
+		rpcAccounts = append(rpcAccounts, marshalAccount(acct))
 	}
 
 	return &litrpc.ListAccountsResponse{
@@ -182,6 +373,270 @@ func (s *RPCServer) RemoveAccount(_ context.Context,
 	return &litrpc.RemoveAccountResponse{}, nil
 }
 
+// ListAccountTransactions returns the transaction ledger of an account,
+// paginated by the index of its entries.
+func (s *RPCServer) ListAccountTransactions(_ context.Context,
+	req *litrpc.ListAccountTransactionsRequest) (
+	*litrpc.ListAccountTransactionsResponse, error) {
+
+	log.Infof("[listaccounttransactions] id=%v, index_offset=%d, "+
+		"max_transactions=%d", req.Id, req.IndexOffset,
+		req.MaxTransactions)
+
+	// Account ID is always a hex string, convert it to our account ID type.
+	var accountID AccountID
+	decoded, err := hex.DecodeString(req.Id)
+	if err != nil {
+		return nil, fmt.Errorf("error decoding account ID: %v", err)
+	}
+	copy(accountID[:], decoded)
+
+	entries, err := s.service.LedgerEntries(
+		accountID, req.IndexOffset, req.MaxTransactions,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("error listing account transactions: "+
+			"%v", err)
+	}
+
+	resp := &litrpc.ListAccountTransactionsResponse{
+		Transactions: make(
+			[]*litrpc.AccountTransaction, len(entries),
+		),
+		LastIndexOffset: req.IndexOffset,
+	}
+	for idx, entry := range entries {
+		resp.Transactions[idx] = marshalLedgerEntry(entry)
+		resp.LastIndexOffset = entry.Index
+	}
+
+	return resp, nil
+}
+
+// TransferBetweenAccounts moves an amount from one account to another account
+// without sending a payment through the network.
+func (s *RPCServer) TransferBetweenAccounts(_ context.Context,
+	req *litrpc.TransferBetweenAccountsRequest) (
+	*litrpc.TransferBetweenAccountsResponse, error) {
+
+	log.Infof("[transferbetweenaccounts] from_id=%v, to_id=%v, amount=%d",
+		req.FromId, req.ToId, req.Amount)
+
+	fromID, err := ParseAccountID(req.FromId)
+	if err != nil {
+		return nil, err
+	}
+
+	toID, err := ParseAccountID(req.ToId)
+	if err != nil {
+		return nil, err
+	}
+
+	// Convert from satoshis to millisatoshis for the transfer.
+	amount := lnwire.NewMSatFromSatoshis(btcutil.Amount(req.Amount))
+	err = s.service.TransferBetweenAccounts(*fromID, *toID, amount)
+	if err != nil {
+		return nil, fmt.Errorf("error transferring between accounts: "+
+			"%w", err)
+	}
+
+	fromAccount, err := s.service.Account(*fromID)
+	if err != nil {
+		return nil, fmt.Errorf("error fetching account: %v", err)
+	}
+
+	toAccount, err := s.service.Account(*toID)
+	if err != nil {
+		return nil, fmt.Errorf("error fetching account: %v", err)
+	}
+
+	return &litrpc.TransferBetweenAccountsResponse{
+		FromAccount: marshalAccount(fromAccount),
+		ToAccount:   marshalAccount(toAccount),
+	}, nil
+}
+
+// SubscribeAccountUpdates streams updates of all accounts, or only the
+// accounts with the given IDs, to the client until the client cancels the
+// stream or the service shuts down.
+func (s *RPCServer) SubscribeAccountUpdates(
+	req *litrpc.SubscribeAccountUpdatesRequest,
+	stream litrpc.Accounts_SubscribeAccountUpdatesServer) error {
+
+	log.Infof("[subscribeaccountupdates] account_ids=%v", req.AccountIds)
+
+	filter := make(map[AccountID]struct{}, len(req.AccountIds))
+	for _, idStr := range req.AccountIds {
+		id, err := ParseAccountID(idStr)
+		if err != nil {
+			return err
+		}
+
+		filter[*id] = struct{}{}
+	}
+
+	client, err := s.service.SubscribeAccountUpdates()
+	if err != nil {
+		return fmt.Errorf("error subscribing to account updates: %v",
+			err)
+	}
+	defer client.Cancel()
+
+	for {
+		select {
+		case item := <-client.Updates():
+			update, ok := item.(*AccountUpdate)
+			if !ok {
+				return fmt.Errorf("unexpected account update "+
+					"type %T", item)
+			}
+
+			if len(filter) > 0 {
+				_, ok := filter[update.Account.ID]
+				if !ok {
+					continue
+				}
+			}
+
+			err := stream.Send(marshalAccountUpdate(update))
+			if err != nil {
+				return err
+			}
+
+		case <-client.Quit():
+			return errors.New("account update subscription closed")
+
+		case <-stream.Context().Done():
+			return stream.Context().Err()
+		}
+	}
+}
+
+// ListExpiryActions returns the audit log of actions that were taken on expired
+// accounts.
+func (s *RPCServer) ListExpiryActions(_ context.Context,
+	req *litrpc.ListExpiryActionsRequest) (*litrpc.ListExpiryActionsResponse,
+	error) {
+
+	log.Infof("[listexpiryactions] index_offset=%d, max_actions=%d",
+		req.IndexOffset, req.MaxActions)
+
+	entries, err := s.service.ExpiryActions(
+		req.IndexOffset, req.MaxActions,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("error listing expiry actions: %v", err)
+	}
+
+	resp := &litrpc.ListExpiryActionsResponse{
+		Actions:         make([]*litrpc.ExpiryAction, len(entries)),
+		LastIndexOffset: req.IndexOffset,
+	}
+	for idx, entry := range entries {
+		resp.Actions[idx] = marshalExpiryAction(entry)
+		resp.LastIndexOffset = entry.Index
+	}
+
+	return resp, nil
+}
+
+// ExportAccounts serializes accounts and their ledgers into a versioned and
+// checksummed export that can be imported into another litd instance.
+func (s *RPCServer) ExportAccounts(_ context.Context,
+	req *litrpc.ExportAccountsRequest) (*litrpc.ExportAccountsResponse,
+	error) {
+
+	log.Infof("[exportaccounts] account_ids=%v", req.AccountIds)
+
+	ids := make([]AccountID, len(req.AccountIds))
+	for idx, idStr := range req.AccountIds {
+		id, err := ParseAccountID(idStr)
+		if err != nil {
+			return nil, err
+		}
+
+		ids[idx] = *id
+	}
+
+	export, numAccounts, err := s.service.ExportAccounts(ids)
+	if err != nil {
+		return nil, fmt.Errorf("error exporting accounts: %v", err)
+	}
+
+	return &litrpc.ExportAccountsResponse{
+		Export:      export,
+		NumAccounts: uint32(numAccounts),
+	}, nil
+}
+
+// ImportAccounts imports the accounts of an export created by ExportAccounts
+// and bakes a new macaroon for every imported account.
+func (s *RPCServer) ImportAccounts(ctx context.Context,
+	req *litrpc.ImportAccountsRequest) (*litrpc.ImportAccountsResponse,
+	error) {
+
+	log.Infof("[importaccounts] export_size=%d, collision_policy=%v",
+		len(req.Export), req.CollisionPolicy)
+
+	policy, err := unmarshalImportPolicy(req.CollisionPolicy)
+	if err != nil {
+		return nil, err
+	}
+
+	results, err := s.service.ImportAccounts(req.Export, policy)
+	if err != nil {
+		return nil, fmt.Errorf("error importing accounts: %v", err)
+	}
+
+	resp := &litrpc.ImportAccountsResponse{
+		Accounts: make([]*litrpc.ImportedAccount, len(results)),
+	}
+	for idx, result := range results {
+		rpcResult := &litrpc.ImportedAccount{
+			Account:    marshalAccount(result.Account),
+			OriginalId: hex.EncodeToString(result.OriginalID[:]),
+			Skipped:    result.Skipped,
+		}
+
+		// The macaroons of the old node are tied to its root key, so
+		// we need to bake new ones for the imported accounts.
+		if !result.Skipped {
+			rpcResult.Macaroon, err = s.bakeAccountMacaroon(
+				ctx, result.Account.ID,
+				result.Account.CanDelegate(),
+			)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		resp.Accounts[idx] = rpcResult
+	}
+
+	return resp, nil
+}
+
+// unmarshalImportPolicy converts an RPC import collision policy into its
+// native counterpart.
+func unmarshalImportPolicy(
+	policy litrpc.ImportCollisionPolicy) (ImportPolicy, error) {
+
+	switch policy {
+	case litrpc.ImportCollisionPolicy_IMPORT_FAIL:
+		return ImportFailOnCollision, nil
+
+	case litrpc.ImportCollisionPolicy_IMPORT_SKIP:
+		return ImportSkipExisting, nil
+
+	case litrpc.ImportCollisionPolicy_IMPORT_NEW_ID:
+		return ImportNewID, nil
+
+	default:
+		return 0, fmt.Errorf("unknown import collision policy %v",
+			policy)
+	}
+}
+
 // marshalAccount converts an account into its RPC counterpart.
 func marshalAccount(acct *OffChainBalanceAccount) *litrpc.Account {
 	rpcAccount := &litrpc.Account{
@@ -196,6 +651,18 @@ func marshalAccount(acct *OffChainBalanceAccount) *litrpc.Account {
 		Payments: make(
 			[]*litrpc.AccountPayment, 0, len(acct.Payments),
 		),
+		SendOnly:    !acct.CanReceive(),
+		ReceiveOnly: !acct.CanSend(),
+		MaxPaymentAmount: uint64(
+			acct.SpendLimits.MaxPaymentAmount.ToSatoshis(),
+		),
+		SpendLimit: uint64(
+			acct.SpendLimits.WindowLimit.ToSatoshis(),
+		),
+		SpendLimitWindow: uint64(acct.SpendLimits.Window.Seconds()),
+		Label:            acct.Label,
+		Metadata:         acct.Metadata,
+		AllowDelegation:  acct.CanDelegate(),
 	}
 
 	for hash := range acct.Invoices {
@@ -219,5 +686,217 @@ func marshalAccount(acct *OffChainBalanceAccount) *litrpc.Account {
 		rpcAccount.ExpirationDate = acct.ExpirationDate.Unix()
 	}
 
+	if acct.Type == TypeRecurringAllowance {
+		rpcAccount.RenewalPeriod = marshalRenewalPeriod(
+			acct.RenewalPeriod,
+		)
+		rpcAccount.NextRenewal = acct.NextRenewal.Unix()
+	}
+
+	if acct.HasParent() {
+		rpcAccount.ParentId = hex.EncodeToString(acct.ParentID[:])
+	}
+
+	if acct.FiatBudget != nil {
+		rpcAccount.FiatBudget = &litrpc.FiatBudget{
+			Currency: acct.FiatBudget.Currency,
+			Budget:   acct.FiatBudget.Budget,
+			Spent:    acct.FiatBudget.Spent,
+		}
+	}
+
 	return rpcAccount
 }
+
+// unmarshalAccountFlags converts the RPC capability flags of an account into
+// their internal counterpart.
+func unmarshalAccountFlags(sendOnly, receiveOnly bool) AccountFlags {
+	var flags AccountFlags
+	if sendOnly {
+		flags |= FlagNoReceive
+	}
+	if receiveOnly {
+		flags |= FlagNoSend
+	}
+
+	return flags
+}
+
+// unmarshalSpendLimits converts the RPC spend limits of an account, given in
+// satoshis and seconds, into their internal counterpart.
+func unmarshalSpendLimits(maxPaymentAmt, spendLimit,
+	spendLimitWindow uint64) SpendLimits {
+
+	return SpendLimits{
+		MaxPaymentAmount: lnwire.NewMSatFromSatoshis(
+			btcutil.Amount(maxPaymentAmt),
+		),
+		WindowLimit: lnwire.NewMSatFromSatoshis(
+			btcutil.Amount(spendLimit),
+		),
+		Window: time.Duration(spendLimitWindow) * time.Second,
+	}
+}
+
+// marshalLedgerEntry converts a ledger entry into its RPC counterpart.
+func marshalLedgerEntry(entry *LedgerEntry) *litrpc.AccountTransaction {
+	rpcTx := &litrpc.AccountTransaction{
+		Index:         entry.Index,
+		Type:          marshalLedgerEntryType(entry.Type),
+		AmountMsat:    entry.Amount,
+		FeeMsat:       uint64(entry.Fee),
+		ReleasedMsat:  uint64(entry.Released),
+		Timestamp:     entry.Timestamp.Unix(),
+		BalanceMsat:   entry.Balance,
+		FiatPriceMsat: uint64(entry.FiatPrice),
+		FiatAmount:    entry.FiatAmount,
+	}
+
+	if entry.Hash != (lntypes.Hash{}) {
+		rpcTx.Hash = entry.Hash[:]
+	}
+
+	return rpcTx
+}
+
+// marshalAccountUpdate converts an account update into its RPC counterpart.
+func marshalAccountUpdate(update *AccountUpdate) *litrpc.AccountUpdate {
+	rpcUpdate := &litrpc.AccountUpdate{
+		Type:      marshalAccountUpdateType(update.Type),
+		Account:   marshalAccount(update.Account),
+		Timestamp: update.Timestamp.Unix(),
+	}
+
+	if update.Type == UpdateTypePayment {
+		rpcUpdate.PaymentHash = update.PaymentHash[:]
+		rpcUpdate.PaymentState = update.PaymentStatus.String()
+	}
+
+	return rpcUpdate
+}
+
+// marshalExpiryAction converts an expiry action audit entry into its RPC
+// counterpart.
+func marshalExpiryAction(entry *ExpiryActionEntry) *litrpc.ExpiryAction {
+	rpcAction := &litrpc.ExpiryAction{
+		Index:      entry.Index,
+		AccountId:  hex.EncodeToString(entry.AccountID[:]),
+		AmountMsat: entry.Amount,
+		Timestamp:  entry.Timestamp.Unix(),
+	}
+
+	switch entry.Action {
+	case ExpiryActionRemove:
+		rpcAction.Action = litrpc.ExpiryActionType_EXPIRY_ACTION_REMOVE
+
+	case ExpiryActionSweep:
+		rpcAction.Action = litrpc.ExpiryActionType_EXPIRY_ACTION_SWEEP
+		rpcAction.TreasuryId = hex.EncodeToString(entry.Treasury[:])
+
+	default:
+		rpcAction.Action = litrpc.ExpiryActionType_EXPIRY_ACTION_UNKNOWN
+	}
+
+	return rpcAction
+}
+
+// marshalAccountUpdateType converts an account update type into its RPC
+// counterpart.
+func marshalAccountUpdateType(
+	updateType AccountUpdateType) litrpc.AccountUpdateType {
+
+	switch updateType {
+	case UpdateTypeBalance:
+		return litrpc.AccountUpdateType_BALANCE_CHANGED
+
+	case UpdateTypePayment:
+		return litrpc.AccountUpdateType_PAYMENT_STATE_CHANGED
+
+	case UpdateTypeExpired:
+		return litrpc.AccountUpdateType_EXPIRED
+
+	case UpdateTypeRemoved:
+		return litrpc.AccountUpdateType_REMOVED
+
+	case UpdateTypeExpiringSoon:
+		return litrpc.AccountUpdateType_EXPIRING_SOON
+
+	default:
+		return litrpc.AccountUpdateType_UPDATE_UNKNOWN
+	}
+}
+
+// marshalLedgerEntryType converts a ledger entry type into its RPC
+// counterpart.
+func marshalLedgerEntryType(
+	entryType LedgerEntryType) litrpc.AccountTransactionType {
+
+	switch entryType {
+	case LedgerInvoiceSettled:
+		return litrpc.AccountTransactionType_INVOICE_SETTLED
+
+	case LedgerPaymentSucceeded:
+		return litrpc.AccountTransactionType_PAYMENT_SUCCEEDED
+
+	case LedgerPaymentFailed:
+		return litrpc.AccountTransactionType_PAYMENT_FAILED
+
+	case LedgerAdminUpdate:
+		return litrpc.AccountTransactionType_ADMIN_UPDATE
+
+	case LedgerRenewal:
+		return litrpc.AccountTransactionType_RENEWAL
+
+	case LedgerTransferSent:
+		return litrpc.AccountTransactionType_TRANSFER_SENT
+
+	case LedgerTransferReceived:
+		return litrpc.AccountTransactionType_TRANSFER_RECEIVED
+
+	case LedgerChildFunded:
+		return litrpc.AccountTransactionType_CHILD_FUNDED
+
+	default:
+		return litrpc.AccountTransactionType_TRANSACTION_UNKNOWN
+	}
+}
+
+// marshalRenewalPeriod converts a renewal period into its RPC counterpart.
+func marshalRenewalPeriod(period RenewalPeriod) litrpc.RenewalPeriod {
+	switch period {
+	case RenewalDaily:
+		return litrpc.RenewalPeriod_RENEWAL_DAILY
+
+	case RenewalWeekly:
+		return litrpc.RenewalPeriod_RENEWAL_WEEKLY
+
+	case RenewalMonthly:
+		return litrpc.RenewalPeriod_RENEWAL_MONTHLY
+
+	default:
+		return litrpc.RenewalPeriod_RENEWAL_NONE
+	}
+}
+
+// unmarshalRenewalPeriod converts an RPC renewal period into its internal
+// counterpart.
+func unmarshalRenewalPeriod(period litrpc.RenewalPeriod) (RenewalPeriod,
+	error) {
+
+	switch period {
+	case litrpc.RenewalPeriod_RENEWAL_NONE:
+		return RenewalNone, nil
+
+	case litrpc.RenewalPeriod_RENEWAL_DAILY:
+		return RenewalDaily, nil
+
+	case litrpc.RenewalPeriod_RENEWAL_WEEKLY:
+		return RenewalWeekly, nil
+
+	case litrpc.RenewalPeriod_RENEWAL_MONTHLY:
+		return RenewalMonthly, nil
+
+	default:
+		return 0, fmt.Errorf("unknown renewal period <%d>", period)
+	}
+}
@@ -12,8 +12,13 @@ import (
 	"github.com/lightningnetwork/lnd/lnrpc"
 	"github.com/lightningnetwork/lnd/lntypes"
 	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/subscribe"
 )
 
+// renewalCheckInterval is the interval at which we check whether any of the
+// recurring allowance accounts are due for a renewal of their balance.
+const renewalCheckInterval = time.Minute
+
 // trackedPayment is a struct that holds all information that identifies a
 // payment that we are tracking in the service.
 type trackedPayment struct {
@@ -29,6 +34,12 @@ type trackedPayment struct {
 	// payment and might be higher than the actual routing fee.
 	fullAmount lnwire.MilliSatoshi
 
+	// fiatPrice is the price of one minor unit of the account's fiat
+	// currency the payment was checked with. The payment is debited from
+	// the fiat budget at this price once it succeeds. It is zero if the
+	// account has no fiat budget.
+	fiatPrice lnwire.MilliSatoshi
+
 	// cancel is the context cancel function that can be called to abort the
 	// TrackPayment RPC stream.
 	cancel context.CancelFunc
@@ -44,6 +55,8 @@ type InterceptorService struct {
 
 	store Store
 
+	cfg *Config
+
 	routerClient lndclient.RouterClient
 
 	mainCtx       context.Context
@@ -58,14 +71,32 @@ type InterceptorService struct {
 	invoiceToAccount map[lntypes.Hash]AccountID
 	pendingPayments  map[lntypes.Hash]*trackedPayment
 
+	// updateServer dispatches account updates to all subscribers.
+	updateServer *subscribe.Server
+
+	// lastPrices holds the last price returned by the price oracle for
+	// each fiat currency. It is used to debit a payment from a fiat budget
+	// that was added while the payment was in flight. The prices are
+	// queried without holding the store lock, so they are guarded by their
+	// own mutex.
+	lastPrices map[string]lnwire.MilliSatoshi
+
+	// checkedPrices holds the price the last payment of each account was
+	// checked against the account's fiat budget with. It is handed over
+	// to the payment once it is tracked.
+	checkedPrices map[AccountID]lnwire.MilliSatoshi
+	pricesMtx     sync.Mutex
+
 	mainErrChan chan<- error
 	wg          sync.WaitGroup
 	quit        chan struct{}
 }
 
 // NewService returns a service backed by the macaroon Bolt DB stored in the
-// passed-in directory.
-func NewService(dir string, errChan chan<- error) (*InterceptorService, error) {
+// passed-in directory. The given config must already be validated.
+func NewService(dir string, errChan chan<- error,
+	cfg *Config) (*InterceptorService, error) {
+
 	accountStore, err := NewBoltStore(dir, DBFilename)
 	if err != nil {
 		return nil, err
@@ -75,10 +106,14 @@ func NewService(dir string, errChan chan<- error) (*InterceptorService, error) {
 
 	return &InterceptorService{
 		store:            accountStore,
+		cfg:              cfg,
 		mainCtx:          mainCtx,
 		contextCancel:    contextCancel,
 		invoiceToAccount: make(map[lntypes.Hash]AccountID),
 		pendingPayments:  make(map[lntypes.Hash]*trackedPayment),
+		updateServer:     subscribe.NewServer(),
+		lastPrices:       make(map[string]lnwire.MilliSatoshi),
+		checkedPrices:    make(map[AccountID]lnwire.MilliSatoshi),
 		mainErrChan:      errChan,
 		quit:             make(chan struct{}),
 	}, nil
@@ -91,6 +126,13 @@ func (s *InterceptorService) Start(lightningClient lndclient.LightningClient,
 	s.routerClient = routerClient
 	s.checkers = NewAccountChecker(s, params)
 
+	// Start the update server first, so we can already dispatch updates
+	// while resuming to track payments.
+	if err := s.updateServer.Start(); err != nil {
+		return fmt.Errorf("error starting account update server: %v",
+			err)
+	}
+
 	// Let's first fill our cache that maps invoices to accounts, which
 	// allows us to credit an account easily once an invoice is settled. We
 	// also track payments that aren't in a final state yet.
@@ -123,6 +165,37 @@ func (s *InterceptorService) Start(lightningClient lndclient.LightningClient,
 		}
 	}
 
+	// Expired accounts can only be swept if the treasury account exists, so
+	// we refuse to start with a treasury account that was removed.
+	if s.cfg.action == ExpiryActionSweep {
+		_, err := s.store.Account(s.cfg.treasuryID)
+		if err != nil {
+			return fmt.Errorf("error fetching treasury account "+
+				"%x: %w", s.cfg.treasuryID[:], err)
+		}
+	}
+
+	// Top up any recurring allowance accounts that became due while we were
+	// offline and then keep checking for renewals periodically.
+	if err := s.renewAccounts(time.Now()); err != nil {
+		return fmt.Errorf("error renewing accounts: %v", err)
+	}
+
+	// The first expiry check covers the time since the last check before
+	// we went offline, so subscribers are still notified about accounts
+	// that reached their warning period or expired in the meantime. If we
+	// never checked before, there is nothing to catch up on.
+	lastCheck, err := s.store.LastExpiryCheck()
+	if err != nil {
+		return fmt.Errorf("error fetching last expiry check: %v", err)
+	}
+	if lastCheck.IsZero() {
+		lastCheck = time.Now()
+	}
+
+	s.wg.Add(1)
+	go s.renewAccountsForever(lastCheck)
+
 	// First ask our DB about the highest indexes we know. If this is the
 	// first startup then the ErrNoInvoiceIndexKnown error is returned, and
 	// we know we need to do a lookup.
@@ -210,20 +283,89 @@ func (s *InterceptorService) Start(lightningClient lndclient.LightningClient,
 }
 
 // NewAccount creates a new OffChainBalanceAccount with the given balance and a
-// randomly chosen ID.
+// randomly chosen ID. If the renewal period is not RenewalNone, the balance is
+// topped back up to the given balance periodically. The given flags restrict
+// the capabilities of the account and the given limits restrict how fast its
+// balance can be spent. The optional label must be unique among all accounts.
 func (s *InterceptorService) NewAccount(balance lnwire.MilliSatoshi,
-	expirationDate time.Time) (*OffChainBalanceAccount, error) {
+	expirationDate time.Time, renewalPeriod RenewalPeriod,
+	flags AccountFlags, limits SpendLimits, label string,
+	metadata map[string]string,
+	fiatBudget *FiatBudget) (*OffChainBalanceAccount, error) {
+
+	s.Lock()
+	defer s.Unlock()
+
+	return s.store.NewAccount(
+		balance, expirationDate, renewalPeriod, flags, limits, label,
+		metadata, fiatBudget,
+	)
+}
+
+// NewChildAccount creates a new account on behalf of the given parent account.
+// The balance of the new account is carved out of the balance of the parent
+// that is not reserved by payments in flight. A child account can't outlive
+// its parent, can't create child accounts itself and is never renewed, as
+// renewing it would create balance that was never carved out of the parent.
+func (s *InterceptorService) NewChildAccount(parentID AccountID,
+	balance lnwire.MilliSatoshi, expirationDate time.Time,
+	flags AccountFlags, limits SpendLimits, label string,
+	metadata map[string]string,
+	fiatBudget *FiatBudget) (*OffChainBalanceAccount, error) {
 
 	s.Lock()
 	defer s.Unlock()
 
-	return s.store.NewAccount(balance, expirationDate)
+	parent, err := s.store.Account(parentID)
+	if err != nil {
+		return nil, fmt.Errorf("error fetching parent account: %w", err)
+	}
+
+	if !parent.CanDelegate() {
+		return nil, ErrDelegationNotAllowed
+	}
+
+	if flags.Has(FlagDelegate) {
+		return nil, fmt.Errorf("child accounts cannot create child " +
+			"accounts themselves")
+	}
+
+	parentExpiry := parent.ExpirationDate
+	if !parentExpiry.IsZero() && (expirationDate.IsZero() ||
+		expirationDate.After(parentExpiry)) {
+
+		return nil, fmt.Errorf("child account cannot expire after "+
+			"its parent account, which expires at %v", parentExpiry)
+	}
+
+	if err := s.checkBalance(parent, balance); err != nil {
+		return nil, err
+	}
+
+	account, err := s.store.NewChildAccount(
+		parentID, balance, expirationDate, flags, limits, label,
+		metadata, fiatBudget,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.notifyBalanceUpdates(parentID); err != nil {
+		return nil, err
+	}
+
+	return account, nil
 }
 
 // UpdateAccount writes an account to the database, overwriting the existing one
-// if it exists.
+// if it exists. If flags, limits or label are nil, the capabilities, spend
+// limits or label of the account are not updated. An empty label removes the
+// label. The given metadata entries are merged into the existing metadata, an
+// entry with an empty value removes the key.
 func (s *InterceptorService) UpdateAccount(accountID AccountID, accountBalance,
-	expirationDate int64) (*OffChainBalanceAccount, error) {
+	expirationDate int64, flags *AccountFlags, limits *SpendLimits,
+	label *string, metadata map[string]string) (*OffChainBalanceAccount,
+	error) {
 
 	s.Lock()
 	defer s.Unlock()
@@ -246,17 +388,98 @@ func (s *InterceptorService) UpdateAccount(accountID AccountID, accountBalance,
 
 	// If the new account balance was set, parse it as millisatoshis. A
 	// value of -1 signals "don't update the balance".
+	prevBalance := account.CurrentBalance
 	if accountBalance >= 0 {
 		// Convert from satoshis to millisatoshis for storage.
 		account.CurrentBalance = int64(accountBalance) * 1000
 	}
 
-	// Create the actual account in the macaroon account store.
-	err = s.store.UpdateAccount(account)
+	// Replace the capabilities of the account if new flags were set.
+	if flags != nil {
+		if err := flags.validate(); err != nil {
+			return nil, err
+		}
+
+		if flags.Has(FlagDelegate) && account.HasParent() {
+			return nil, fmt.Errorf("child accounts cannot create " +
+				"child accounts themselves")
+		}
+
+		if flags.Has(FlagDelegate) != account.CanDelegate() {
+			log.Infof("Changed delegation of account %x to %v",
+				account.ID[:], flags.Has(FlagDelegate))
+		}
+
+		account.Flags = *flags
+	}
+
+	// Replace the spend limits of the account if new limits were set.
+	if limits != nil {
+		if err := limits.validate(); err != nil {
+			return nil, err
+		}
+
+		account.SpendLimits = *limits
+	}
+
+	prevLabel := account.Label
+	if label != nil && *label != "" {
+		if err := validateLabel(*label); err != nil {
+			return nil, err
+		}
+
+		account.Label = *label
+	} else if label != nil {
+		account.Label = ""
+	}
+
+	if len(metadata) > 0 && account.Metadata == nil {
+		account.Metadata = make(map[string]string, len(metadata))
+	}
+	for key, value := range metadata {
+		if value == "" {
+			delete(account.Metadata, key)
+			continue
+		}
+
+		account.Metadata[key] = value
+	}
+	if err := validateMetadata(account.Metadata); err != nil {
+		return nil, err
+	}
+
+	// Create the actual account in the macaroon account store and record
+	// the update in its ledger.
+	err = s.store.UpdateAccountWithEntry(account, &LedgerEntry{
+		Type:   LedgerAdminUpdate,
+		Amount: account.CurrentBalance - prevBalance,
+	})
 	if err != nil {
 		return nil, fmt.Errorf("unable to update account: %v", err)
 	}
 
+	if account.Label != prevLabel {
+		log.Infof("Changed label of account %x from %q to %q",
+			account.ID[:], prevLabel, account.Label)
+	}
+	for key, value := range metadata {
+		if value == "" {
+			log.Infof("Removed metadata key %q from account %x",
+				key, account.ID[:])
+			continue
+		}
+
+		log.Infof("Set metadata key %q of account %x to %q", key,
+			account.ID[:], value)
+	}
+
+	if account.CurrentBalance != prevBalance {
+		s.notifyUpdate(&AccountUpdate{
+			Type:    UpdateTypeBalance,
+			Account: account,
+		})
+	}
+
 	return account, nil
 }
 
@@ -271,6 +494,17 @@ func (s *InterceptorService) Account(id AccountID) (*OffChainBalanceAccount,
 	return s.store.Account(id)
 }
 
+// AccountByLabel retrieves the account with the given label. If no account has
+// the label, then ErrAccNotFound is returned.
+func (s *InterceptorService) AccountByLabel(
+	label string) (*OffChainBalanceAccount, error) {
+
+	s.RLock()
+	defer s.RUnlock()
+
+	return s.store.AccountByLabel(label)
+}
+
 // Accounts retrieves all accounts from the bolt DB and un-marshals them.
 func (s *InterceptorService) Accounts() ([]*OffChainBalanceAccount, error) {
 	s.RLock()
@@ -284,6 +518,18 @@ func (s *InterceptorService) RemoveAccount(id AccountID) error {
 	s.Lock()
 	defer s.Unlock()
 
+	// Balances of expired accounts are swept to the treasury account, so
+	// it must not be removed while it's in use.
+	if s.cfg.action == ExpiryActionSweep && id == s.cfg.treasuryID {
+		return fmt.Errorf("cannot remove the treasury account of the " +
+			"sweep expiry action")
+	}
+
+	account, err := s.store.Account(id)
+	if err != nil {
+		return err
+	}
+
 	// Are we currently tracking any payments?
 	for hash, payment := range s.pendingPayments {
 		if payment.accountID != id {
@@ -297,14 +543,34 @@ func (s *InterceptorService) RemoveAccount(id AccountID) error {
 		}
 	}
 
-	return s.store.RemoveAccount(id)
+	if err := s.store.RemoveAccount(id); err != nil {
+		return err
+	}
+	s.forgetInvoices(account)
+
+	s.notifyUpdate(&AccountUpdate{
+		Type:    UpdateTypeRemoved,
+		Account: account,
+	})
+
+	return nil
 }
 
 // CheckBalance ensures an account is valid and has a balance equal to or larger
-// than the amount that is required.
+// than the amount that is required. If the account has a fiat budget, the
+// amount converted at the current price must also fit into the budget.
 func (s *InterceptorService) CheckBalance(id AccountID,
 	requiredBalance lnwire.MilliSatoshi) error {
 
+	// The amount is about to be spent, so it is also converted at the
+	// current price and checked against the fiat budget of the account.
+	// We query the price before acquiring the lock, as that can take a
+	// while.
+	price, err := s.budgetPrice(id)
+	if err != nil {
+		return err
+	}
+
 	s.RLock()
 	defer s.RUnlock()
 
@@ -315,6 +581,32 @@ func (s *InterceptorService) CheckBalance(id AccountID,
 		return err
 	}
 
+	if err := s.checkBalance(account, requiredBalance); err != nil {
+		return err
+	}
+
+	err = s.checkFiatBudget(account, requiredBalance, price)
+	if err != nil {
+		return err
+	}
+
+	// The payment will be debited from the fiat budget at the same price
+	// it was checked with.
+	s.pricesMtx.Lock()
+	s.checkedPrices[id] = price
+	s.pricesMtx.Unlock()
+
+	return nil
+}
+
+// checkBalance ensures the given account hasn't expired and has a balance,
+// minus any in-flight payments, equal to or larger than the amount that is
+// required.
+//
+// NOTE: The store lock MUST be held when calling this method.
+func (s *InterceptorService) checkBalance(account *OffChainBalanceAccount,
+	requiredBalance lnwire.MilliSatoshi) error {
+
 	if account.HasExpired() {
 		return ErrAccExpired
 	}
@@ -332,6 +624,145 @@ func (s *InterceptorService) CheckBalance(id AccountID,
 	return nil
 }
 
+// CheckSpendLimits ensures a payment doesn't exceed the per-payment or rolling
+// window spend limits of an account. The payment amount excludes any routing
+// fees while the full amount includes the maximum routing fee the payment can
+// incur.
+func (s *InterceptorService) CheckSpendLimits(id AccountID, paymentAmt,
+	fullAmt lnwire.MilliSatoshi) error {
+
+	s.RLock()
+	defer s.RUnlock()
+
+	account, err := s.store.Account(id)
+	if err != nil {
+		return err
+	}
+
+	limits := account.SpendLimits
+	if limits.MaxPaymentAmount > 0 && paymentAmt > limits.MaxPaymentAmount {
+		return fmt.Errorf("%w: payment amount of %d msat is above "+
+			"maximum of %d msat", ErrAccPaymentLimitExceeded,
+			paymentAmt, limits.MaxPaymentAmount)
+	}
+
+	if limits.WindowLimit == 0 {
+		return nil
+	}
+
+	spent := account.spentInWindow(time.Now())
+	if spent+fullAmt > limits.WindowLimit {
+		return fmt.Errorf("%w: %d msat already spent in the last %v, "+
+			"payment of up to %d msat would exceed limit of %d "+
+			"msat", ErrAccSpendLimitExceeded, spent, limits.Window,
+			fullAmt, limits.WindowLimit)
+	}
+
+	return nil
+}
+
+// TransferBetweenAccounts moves the given amount from one account to another
+// account without touching the network. The sending account must be allowed to
+// send and the receiving account must be allowed to receive.
+func (s *InterceptorService) TransferBetweenAccounts(fromID, toID AccountID,
+	amount lnwire.MilliSatoshi) error {
+
+	s.Lock()
+	defer s.Unlock()
+
+	return s.transfer(fromID, toID, amount, lntypes.Hash{}, 0)
+}
+
+// SettleInvoiceInternally pays the invoice with the given hash from the given
+// account by moving the amount directly to the account the invoice belongs to,
+// if the invoice belongs to another account on this node. The returned boolean
+// indicates whether the invoice was settled internally.
+func (s *InterceptorService) SettleInvoiceInternally(id AccountID,
+	hash lntypes.Hash, amount lnwire.MilliSatoshi) (bool, error) {
+
+	// Paying an invoice counts against the fiat budget of the paying
+	// account. We query the price before acquiring the lock, as that can
+	// take a while.
+	price, err := s.budgetPrice(id)
+	if err != nil {
+		return false, err
+	}
+
+	s.Lock()
+	defer s.Unlock()
+
+	// If the invoice isn't associated with any other account, it needs to
+	// be paid through lnd as usual.
+	toID, ok := s.invoiceToAccount[hash]
+	if !ok || toID == id {
+		return false, nil
+	}
+
+	if err := s.transfer(id, toID, amount, hash, price); err != nil {
+		return false, err
+	}
+
+	log.Debugf("Settled invoice %v of account %x internally with %d "+
+		"msat from account %x", hash, toID[:], amount, id[:])
+
+	// The invoice is now paid, just as if it was settled by lnd. So we
+	// don't need to keep it mapped in memory anymore.
+	delete(s.invoiceToAccount, hash)
+
+	return true, nil
+}
+
+// transfer moves the given amount from one account to another in the store
+// after making sure both accounts are allowed to take part in the transfer. If
+// the transfer pays an invoice, the amount is converted at the given price and
+// counted against the fiat budget of the sending account.
+//
+// NOTE: The store lock MUST be held when calling this method.
+func (s *InterceptorService) transfer(fromID, toID AccountID,
+	amount lnwire.MilliSatoshi, hash lntypes.Hash,
+	price lnwire.MilliSatoshi) error {
+
+	from, err := s.store.Account(fromID)
+	if err != nil {
+		return fmt.Errorf("error fetching sending account: %w", err)
+	}
+
+	to, err := s.store.Account(toID)
+	if err != nil {
+		return fmt.Errorf("error fetching receiving account: %w", err)
+	}
+
+	if !from.CanSend() {
+		return ErrAccSendDisabled
+	}
+
+	if !to.CanReceive() {
+		return ErrAccReceiveDisabled
+	}
+
+	if err := s.checkBalance(from, amount); err != nil {
+		return err
+	}
+
+	// Paying an invoice of another account is spending, so it counts
+	// against the fiat budget of the paying account. Moving balance on
+	// behalf of the node operator does not.
+	var fiatPrice lnwire.MilliSatoshi
+	if hash != (lntypes.Hash{}) && from.FiatBudget != nil {
+		if err := s.checkFiatBudget(from, amount, price); err != nil {
+			return err
+		}
+		fiatPrice = price
+	}
+
+	err = s.store.TransferBalance(fromID, toID, amount, hash, fiatPrice)
+	if err != nil {
+		return err
+	}
+
+	return s.notifyBalanceUpdates(fromID, toID)
+}
+
 // AssociateInvoice associates a generated invoice with the given account,
 // making it possible for the account to be credited in case the invoice is
 // paid.
@@ -379,6 +810,12 @@ func (s *InterceptorService) invoiceUpdate(invoice *lndclient.Invoice) error {
 		}
 	}
 
+	// AMP invoices can be paid multiple times and stay open while being
+	// paid, with the paid amount growing with each settled payment.
+	if invoice.State == invpkg.ContractOpen && invoice.AmountPaid > 0 {
+		return s.ampInvoiceUpdate(invoice)
+	}
+
 	// The invoice hasn't been settled yet, there is nothing for us to do.
 	// If it eventually settles, we'll be called again.
 	if invoice.State != invpkg.ContractSettled {
@@ -401,10 +838,20 @@ func (s *InterceptorService) invoiceUpdate(invoice *lndclient.Invoice) error {
 	// it that was just paid. Credit the amount to the account and update it
 	// in the DB.
 	account.CurrentBalance += int64(invoice.AmountPaid)
-	if err := s.store.UpdateAccount(account); err != nil {
+	err = s.store.UpdateAccountWithEntry(account, &LedgerEntry{
+		Type:   LedgerInvoiceSettled,
+		Hash:   invoice.Hash,
+		Amount: int64(invoice.AmountPaid),
+	})
+	if err != nil {
 		return fmt.Errorf("error updating account: %v", err)
 	}
 
+	s.notifyUpdate(&AccountUpdate{
+		Type:    UpdateTypeBalance,
+		Account: account,
+	})
+
 	// We've now fully processed the invoice and don't need to keep it
 	// mapped in memory anymore.
 	delete(s.invoiceToAccount, invoice.Hash)
@@ -412,11 +859,65 @@ func (s *InterceptorService) invoiceUpdate(invoice *lndclient.Invoice) error {
 	return nil
 }
 
+// ampInvoiceUpdate credits the amount paid to an AMP invoice since the last
+// update to the account the invoice belongs to. The invoice stays mapped to the
+// account as it can be paid again.
+//
+// NOTE: The store lock MUST be held when calling this method.
+func (s *InterceptorService) ampInvoiceUpdate(
+	invoice *lndclient.Invoice) error {
+
+	acctID, ok := s.invoiceToAccount[invoice.Hash]
+	if !ok {
+		return nil
+	}
+
+	account, err := s.store.Account(acctID)
+	if err != nil {
+		return fmt.Errorf("error fetching account: %v", err)
+	}
+
+	// We might be notified about the same payment more than once, for
+	// example after a restart. So we only credit the amount that was paid
+	// since the last update.
+	credited := account.AmpInvoices[invoice.Hash]
+	if invoice.AmountPaid <= credited {
+		return nil
+	}
+	amount := invoice.AmountPaid - credited
+
+	if account.AmpInvoices == nil {
+		account.AmpInvoices = make(map[lntypes.Hash]lnwire.MilliSatoshi)
+	}
+	account.AmpInvoices[invoice.Hash] = invoice.AmountPaid
+	account.CurrentBalance += int64(amount)
+	err = s.store.UpdateAccountWithEntry(account, &LedgerEntry{
+		Type:   LedgerInvoiceSettled,
+		Hash:   invoice.Hash,
+		Amount: int64(amount),
+	})
+	if err != nil {
+		return fmt.Errorf("error updating account: %v", err)
+	}
+
+	s.notifyUpdate(&AccountUpdate{
+		Type:    UpdateTypeBalance,
+		Account: account,
+	})
+
+	return nil
+}
+
 // TrackPayment adds a new payment to be tracked to the service. If the payment
 // is eventually settled, its amount needs to be debited from the given account.
 func (s *InterceptorService) TrackPayment(id AccountID, hash lntypes.Hash,
 	fullAmt lnwire.MilliSatoshi) error {
 
+	price, err := s.paymentPrice(id, hash)
+	if err != nil {
+		return err
+	}
+
 	s.Lock()
 	defer s.Unlock()
 
@@ -442,16 +943,32 @@ func (s *InterceptorService) TrackPayment(id AccountID, hash lntypes.Hash,
 		return nil
 	}
 
+	// If we're resuming tracking an in-flight payment, it keeps the time
+	// it was first tracked at.
+	timestamp := time.Now()
+	if ok && !entry.Timestamp.IsZero() {
+		timestamp = entry.Timestamp
+	}
+
 	// Okay, we haven't tracked this payment before. So let's now associate
 	// the account with it.
 	account.Payments[hash] = &PaymentEntry{
 		Status:     lnrpc.Payment_UNKNOWN,
 		FullAmount: fullAmt,
+		Timestamp:  timestamp,
+		FiatPrice:  price,
 	}
 	if err := s.store.UpdateAccount(account); err != nil {
 		return fmt.Errorf("error updating account: %v", err)
 	}
 
+	s.notifyUpdate(&AccountUpdate{
+		Type:          UpdateTypePayment,
+		Account:       account,
+		PaymentHash:   hash,
+		PaymentStatus: lnrpc.Payment_IN_FLIGHT,
+	})
+
 	// And start the long-running TrackPayment RPC.
 	ctxc, cancel := context.WithCancel(s.mainCtx)
 	statusChan, errChan, err := s.routerClient.TrackPayment(ctxc, hash)
@@ -466,6 +983,7 @@ func (s *InterceptorService) TrackPayment(id AccountID, hash lntypes.Hash,
 		accountID:  id,
 		hash:       hash,
 		fullAmount: fullAmt,
+		fiatPrice:  price,
 		cancel:     cancel,
 	}
 
@@ -558,22 +1076,187 @@ func (s *InterceptorService) paymentUpdate(hash lntypes.Hash,
 
 	fullAmount := status.Value + status.Fee
 
-	// Update the account and store it in the database.
+	// Update the account and store it in the database. We keep the
+	// original timestamp of the payment, so it continues to count towards
+	// the correct spend limit window.
 	account.CurrentBalance -= int64(fullAmount)
-	account.Payments[hash] = &PaymentEntry{
+	entry := &PaymentEntry{
 		Status:     lnrpc.Payment_SUCCEEDED,
 		FullAmount: fullAmount,
+		Timestamp:  time.Now(),
+		FiatPrice:  pendingPayment.fiatPrice,
 	}
-	if err := s.store.UpdateAccount(account); err != nil {
+	if prevEntry, ok := account.Payments[hash]; ok {
+		entry.Timestamp = prevEntry.Timestamp
+	}
+	account.Payments[hash] = entry
+	ledgerEntry := &LedgerEntry{
+		Type:   LedgerPaymentSucceeded,
+		Hash:   hash,
+		Amount: -int64(status.Value),
+		Fee:    status.Fee,
+	}
+	err = s.debitFiatBudget(
+		account, fullAmount, pendingPayment.fiatPrice, ledgerEntry,
+	)
+	if err != nil {
+		log.Errorf("Unable to debit payment %v from fiat budget: %v",
+			hash, err)
+	}
+	err = s.store.UpdateAccountWithEntry(account, ledgerEntry)
+	if err != nil {
 		return terminalState, fmt.Errorf("error updating account: %v",
 			err)
 	}
 
+	s.notifyUpdate(&AccountUpdate{
+		Type:    UpdateTypeBalance,
+		Account: account,
+	})
+
 	// We've now fully processed the payment and don't need to keep it
 	// mapped or tracked anymore.
 	return terminalState, s.removePayment(hash, lnrpc.Payment_SUCCEEDED)
 }
 
+// renewAccountsForever periodically tops up the balance of all recurring
+// allowance accounts that are due for a renewal and handles accounts that are
+// about to expire or expired since the given time.
+//
+// NOTE: This MUST be called in a goroutine.
+func (s *InterceptorService) renewAccountsForever(lastCheck time.Time) {
+	defer s.wg.Done()
+
+	ticker := time.NewTicker(renewalCheckInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case now := <-ticker.C:
+			err := s.checkExpiries(lastCheck, now)
+			if err != nil {
+				log.Errorf("Error checking for expired "+
+					"accounts: %v", err)
+			}
+			lastCheck = now
+
+			err = s.store.StoreLastExpiryCheck(now)
+			if err != nil {
+				log.Errorf("Error storing last expiry check: "+
+					"%v", err)
+			}
+
+			if err := s.renewAccounts(now); err != nil {
+				log.Errorf("Error renewing accounts: %v", err)
+
+				select {
+				case s.mainErrChan <- err:
+				case <-s.mainCtx.Done():
+				case <-s.quit:
+				}
+				return
+			}
+
+		case <-s.mainCtx.Done():
+			return
+
+		case <-s.quit:
+			return
+		}
+	}
+}
+
+// renewAccounts tops up the balance of all recurring allowance accounts whose
+// next renewal time is not after the given reference time.
+func (s *InterceptorService) renewAccounts(now time.Time) error {
+	s.Lock()
+	defer s.Unlock()
+
+	accounts, err := s.store.Accounts()
+	if err != nil {
+		return fmt.Errorf("error querying accounts: %v", err)
+	}
+
+	for _, account := range accounts {
+		prevBalance := account.CurrentBalance
+		if !account.renew(now) {
+			continue
+		}
+
+		log.Debugf("Renewed balance of account %x to %d msat, next "+
+			"renewal at %v", account.ID[:], account.CurrentBalance,
+			account.NextRenewal)
+
+		err := s.store.UpdateAccountWithEntry(account, &LedgerEntry{
+			Type:   LedgerRenewal,
+			Amount: account.CurrentBalance - prevBalance,
+		})
+		if err != nil {
+			return fmt.Errorf("error updating account: %v", err)
+		}
+
+		s.notifyUpdate(&AccountUpdate{
+			Type:    UpdateTypeBalance,
+			Account: account,
+		})
+	}
+
+	return nil
+}
+
+// SubscribeAccountUpdates returns a client that receives an *AccountUpdate for
+// every balance change, payment state transition, expiry and removal of any
+// account. The client must be cancelled once it's no longer needed.
+func (s *InterceptorService) SubscribeAccountUpdates() (*subscribe.Client,
+	error) {
+
+	return s.updateServer.Subscribe()
+}
+
+// notifyBalanceUpdates notifies subscribers about the current state of the
+// accounts with the given IDs after their balance changed.
+//
+// NOTE: The store lock MUST be held when calling this method.
+func (s *InterceptorService) notifyBalanceUpdates(ids ...AccountID) error {
+	for _, id := range ids {
+		account, err := s.store.Account(id)
+		if err != nil {
+			return err
+		}
+
+		s.notifyUpdate(&AccountUpdate{
+			Type:    UpdateTypeBalance,
+			Account: account,
+		})
+	}
+
+	return nil
+}
+
+// forgetInvoices removes the invoices of the given removed account from the
+// invoice to account mapping.
+//
+// NOTE: The store lock MUST be held when calling this method.
+func (s *InterceptorService) forgetInvoices(account *OffChainBalanceAccount) {
+	for hash := range account.Invoices {
+		delete(s.invoiceToAccount, hash)
+	}
+}
+
+// notifyUpdate dispatches the given update to all subscribers. Failing to do
+// so is only logged, as this should never abort the operation that caused the
+// update.
+func (s *InterceptorService) notifyUpdate(update *AccountUpdate) {
+	if update.Timestamp.IsZero() {
+		update.Timestamp = time.Now()
+	}
+
+	if err := s.updateServer.SendUpdate(update); err != nil {
+		log.Warnf("Unable to send %v update for account %x: %v",
+			update.Type, update.Account.ID[:], err)
+	}
+}
+
 // RemovePayment removes a failed payment from the service because it no longer
 // needs to be tracked. The payment is certain to never succeed, so we never
 // need to debit the amount from the account.
@@ -612,9 +1295,56 @@ func (s *InterceptorService) removePayment(hash lntypes.Hash,
 		return nil
 	}
 
-	// If we did, let's set the status correctly in the DB now.
+	// If we did, let's set the status correctly in the DB now. A failed
+	// payment is also recorded in the ledger, even though it doesn't
+	// change the balance.
 	account.Payments[hash].Status = status
-	return s.store.UpdateAccount(account)
+	if status != lnrpc.Payment_FAILED {
+		err = s.store.UpdateAccount(account)
+	} else {
+		err = s.store.UpdateAccountWithEntry(account, &LedgerEntry{
+			Type:     LedgerPaymentFailed,
+			Hash:     hash,
+			Released: pendingPayment.fullAmount,
+		})
+	}
+	if err != nil {
+		return err
+	}
+
+	s.notifyUpdate(&AccountUpdate{
+		Type:          UpdateTypePayment,
+		Account:       account,
+		PaymentHash:   hash,
+		PaymentStatus: status,
+	})
+
+	return nil
+}
+
+// ExpiryActions returns at most maxEntries entries of the audit log of actions
+// taken on expired accounts, starting with the first entry that has an index
+// greater than the given index offset. A maxEntries value of zero means no
+// limit.
+func (s *InterceptorService) ExpiryActions(indexOffset,
+	maxEntries uint64) ([]*ExpiryActionEntry, error) {
+
+	s.RLock()
+	defer s.RUnlock()
+
+	return s.store.ExpiryActions(indexOffset, maxEntries)
+}
+
+// LedgerEntries returns at most maxEntries entries of the transaction ledger of
+// the given account, starting with the first entry that has an index greater
+// than the given index offset. A maxEntries value of zero means no limit.
+func (s *InterceptorService) LedgerEntries(id AccountID, indexOffset,
+	maxEntries uint64) ([]*LedgerEntry, error) {
+
+	s.RLock()
+	defer s.RUnlock()
+
+	return s.store.LedgerEntries(id, indexOffset, maxEntries)
 }
 
 // Stop shuts down the account service.
@@ -624,5 +1354,9 @@ func (s *InterceptorService) Stop() error {
 
 	s.wg.Wait()
 
+	if err := s.updateServer.Stop(); err != nil {
+		log.Errorf("Error stopping account update server: %v", err)
+	}
+
 	return s.store.Close()
 }
@@ -2,7 +2,10 @@ package accounts
 
 import (
 	"context"
+	"encoding/hex"
 	"errors"
+	"os"
+	"path/filepath"
 	"testing"
 	"time"
 
@@ -10,6 +13,7 @@ import (
 	invpkg "github.com/lightningnetwork/lnd/invoices"
 	"github.com/lightningnetwork/lnd/lnrpc"
 	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/lightningnetwork/lnd/lnwire"
 	"github.com/stretchr/testify/require"
 )
 
@@ -21,6 +25,7 @@ var (
 	testInterval   = time.Millisecond * 20
 
 	testHash2 = lntypes.Hash{99, 88, 77}
+	testID2   = AccountID{22, 33, 44}
 )
 
 type mockLnd struct {
@@ -179,6 +184,16 @@ func TestAccountService(t *testing.T) {
 
 			lnd.assertNoInvoiceRequest(t)
 		},
+	}, {
+		name: "startup err on missing treasury account",
+		setup: func(t *testing.T, lnd *mockLnd, s *InterceptorService) {
+			s.cfg = &Config{
+				ExpiryAction:    ExpiryActionSweep.String(),
+				TreasuryAccount: hex.EncodeToString(testID2[:]),
+			}
+			require.NoError(t, s.cfg.Validate())
+		},
+		startupErr: ErrAccNotFound.Error(),
 	}, {
 		name: "goroutine err sent on main err chan",
 		setup: func(t *testing.T, lnd *mockLnd, s *InterceptorService) {
@@ -206,7 +221,10 @@ func TestAccountService(t *testing.T) {
 	}, {
 		name: "startup do not track completed payments",
 		setup: func(t *testing.T, lnd *mockLnd, s *InterceptorService) {
-			acct, err := s.store.NewAccount(1234, testExpiration)
+			acct, err := s.store.NewAccount(
+				1234, testExpiration, RenewalNone, 0,
+				SpendLimits{}, "", nil, nil,
+			)
 			require.NoError(t, err)
 
 			acct.Invoices[testHash] = struct{}{}
@@ -388,6 +406,703 @@ func TestAccountService(t *testing.T) {
 
 				return acct.CurrentBalance == (1234 + 777)
 			})
+
+			// The credit is also recorded in the account's ledger.
+			entries, err := s.store.LedgerEntries(testID, 0, 0)
+			require.NoError(t, err)
+			require.Len(t, entries, 1)
+			require.Equal(t, LedgerInvoiceSettled, entries[0].Type)
+			require.Equal(t, testHash, entries[0].Hash)
+			require.EqualValues(t, 777, entries[0].Amount)
+			require.EqualValues(t, 1234+777, entries[0].Balance)
+		},
+	}, {
+		name: "credit AMP invoice",
+		setup: func(t *testing.T, lnd *mockLnd, s *InterceptorService) {
+			acct := &OffChainBalanceAccount{
+				ID:             testID,
+				Type:           TypeInitialBalance,
+				CurrentBalance: 1234,
+				Invoices: map[lntypes.Hash]struct{}{
+					testHash: {},
+				},
+				Payments: make(map[lntypes.Hash]*PaymentEntry),
+			}
+
+			err := s.store.UpdateAccount(acct)
+			require.NoError(t, err)
+		},
+		validate: func(t *testing.T, lnd *mockLnd,
+			s *InterceptorService) {
+
+			lnd.assertInvoiceRequest(t, 0, 0)
+
+			// An AMP invoice stays open while the amount paid grows
+			// with each payment. We also replay the second update
+			// which must not be credited twice.
+			for idx, amtPaid := range []lnwire.MilliSatoshi{
+				500, 800, 800, 1000,
+			} {
+				lnd.invoiceChan <- &lndclient.Invoice{
+					AddIndex:    12,
+					SettleIndex: uint64(12 + idx),
+					Hash:        testHash,
+					AmountPaid:  amtPaid,
+					State:       invpkg.ContractOpen,
+				}
+			}
+
+			assertEventually(t, func() bool {
+				acct, err := s.store.Account(testID)
+				require.NoError(t, err)
+
+				return acct.CurrentBalance == (1234 + 1000)
+			})
+
+			acct, err := s.store.Account(testID)
+			require.NoError(t, err)
+			require.EqualValues(t, 1000, acct.AmpInvoices[testHash])
+
+			// Each payment is recorded in the ledger separately.
+			entries, err := s.store.LedgerEntries(testID, 0, 0)
+			require.NoError(t, err)
+			require.Len(t, entries, 3)
+			require.EqualValues(t, 500, entries[0].Amount)
+			require.EqualValues(t, 300, entries[1].Amount)
+			require.EqualValues(t, 200, entries[2].Amount)
+
+			// The invoice can be paid again, so it must still be
+			// mapped to the account.
+			s.RLock()
+			require.Contains(t, s.invoiceToAccount, testHash)
+			s.RUnlock()
+		},
+	}, {
+		name: "renew recurring allowance on startup",
+		setup: func(t *testing.T, lnd *mockLnd, s *InterceptorService) {
+			// The account was due for renewal while we were
+			// offline.
+			acct := &OffChainBalanceAccount{
+				ID:             testID,
+				Type:           TypeRecurringAllowance,
+				InitialBalance: 5000,
+				CurrentBalance: 1234,
+				Invoices:       make(map[lntypes.Hash]struct{}),
+				Payments:       make(map[lntypes.Hash]*PaymentEntry),
+				RenewalPeriod:  RenewalWeekly,
+				NextRenewal:    time.Now().Add(-time.Hour),
+			}
+
+			err := s.store.UpdateAccount(acct)
+			require.NoError(t, err)
+		},
+		validate: func(t *testing.T, lnd *mockLnd,
+			s *InterceptorService) {
+
+			lnd.assertInvoiceRequest(t, 0, 0)
+
+			acct, err := s.store.Account(testID)
+			require.NoError(t, err)
+
+			require.EqualValues(t, 5000, acct.CurrentBalance)
+			require.True(t, acct.NextRenewal.After(time.Now()))
+
+			entries, err := s.store.LedgerEntries(testID, 0, 0)
+			require.NoError(t, err)
+			require.Len(t, entries, 1)
+			require.Equal(t, LedgerRenewal, entries[0].Type)
+			require.EqualValues(t, 5000-1234, entries[0].Amount)
+		},
+	}, {
+		name: "rolling spend limit",
+		setup: func(t *testing.T, lnd *mockLnd, s *InterceptorService) {
+			acct := &OffChainBalanceAccount{
+				ID:             testID,
+				Type:           TypeInitialBalance,
+				CurrentBalance: 50000,
+				Invoices:       make(map[lntypes.Hash]struct{}),
+				Payments: map[lntypes.Hash]*PaymentEntry{
+					// A recent payment counts towards the
+					// limit.
+					testHash: {
+						Status:     lnrpc.Payment_SUCCEEDED,
+						FullAmount: 2000,
+						Timestamp: time.Now().Add(
+							-time.Hour,
+						),
+					},
+					// A payment outside the window doesn't.
+					testHash2: {
+						Status:     lnrpc.Payment_SUCCEEDED,
+						FullAmount: 9000,
+						Timestamp: time.Now().Add(
+							-48 * time.Hour,
+						),
+					},
+				},
+				SpendLimits: SpendLimits{
+					MaxPaymentAmount: 2500,
+					WindowLimit:      5000,
+					Window:           24 * time.Hour,
+				},
+			}
+
+			err := s.store.UpdateAccount(acct)
+			require.NoError(t, err)
+		},
+		validate: func(t *testing.T, lnd *mockLnd,
+			s *InterceptorService) {
+
+			err := s.CheckSpendLimits(testID, 2500, 3000)
+			require.NoError(t, err)
+
+			err = s.CheckSpendLimits(testID, 2501, 2501)
+			require.ErrorIs(t, err, ErrAccPaymentLimitExceeded)
+
+			err = s.CheckSpendLimits(testID, 2500, 3001)
+			require.ErrorIs(t, err, ErrAccSpendLimitExceeded)
+		},
+	}, {
+		name: "settle invoice internally",
+		setup: func(t *testing.T, lnd *mockLnd, s *InterceptorService) {
+			sender := &OffChainBalanceAccount{
+				ID:             testID,
+				Type:           TypeInitialBalance,
+				CurrentBalance: 5000,
+				Invoices:       make(map[lntypes.Hash]struct{}),
+				Payments:       make(map[lntypes.Hash]*PaymentEntry),
+			}
+			receiver := &OffChainBalanceAccount{
+				ID:             testID2,
+				Type:           TypeInitialBalance,
+				CurrentBalance: 1000,
+				Invoices: map[lntypes.Hash]struct{}{
+					testHash: {},
+				},
+				Payments: make(map[lntypes.Hash]*PaymentEntry),
+			}
+
+			require.NoError(t, s.store.UpdateAccount(sender))
+			require.NoError(t, s.store.UpdateAccount(receiver))
+		},
+		validate: func(t *testing.T, lnd *mockLnd,
+			s *InterceptorService) {
+
+			// An account can't pay its own invoice internally, and
+			// unknown invoices need to go through lnd.
+			settled, err := s.SettleInvoiceInternally(
+				testID2, testHash, 3000,
+			)
+			require.NoError(t, err)
+			require.False(t, settled)
+
+			settled, err = s.SettleInvoiceInternally(
+				testID, testHash2, 3000,
+			)
+			require.NoError(t, err)
+			require.False(t, settled)
+
+			// The balance must be sufficient.
+			_, err = s.SettleInvoiceInternally(
+				testID, testHash, 5001,
+			)
+			require.ErrorIs(t, err, ErrAccBalanceInsufficient)
+
+			settled, err = s.SettleInvoiceInternally(
+				testID, testHash, 3000,
+			)
+			require.NoError(t, err)
+			require.True(t, settled)
+			require.NotContains(t, s.invoiceToAccount, testHash)
+
+			sender, err := s.store.Account(testID)
+			require.NoError(t, err)
+			require.EqualValues(t, 2000, sender.CurrentBalance)
+			require.Equal(
+				t, lnrpc.Payment_SUCCEEDED,
+				sender.Payments[testHash].Status,
+			)
+
+			receiver, err := s.store.Account(testID2)
+			require.NoError(t, err)
+			require.EqualValues(t, 4000, receiver.CurrentBalance)
+			require.NotContains(t, receiver.Invoices, testHash)
+
+			// The invoice can only be paid once.
+			settled, err = s.SettleInvoiceInternally(
+				testID, testHash, 3000,
+			)
+			require.NoError(t, err)
+			require.False(t, settled)
+
+			// A direct transfer is recorded in both ledgers.
+			err = s.TransferBetweenAccounts(testID2, testID, 500)
+			require.NoError(t, err)
+
+			entries, err := s.store.LedgerEntries(testID, 0, 0)
+			require.NoError(t, err)
+			require.Len(t, entries, 2)
+			require.Equal(t, LedgerTransferSent, entries[0].Type)
+			require.Equal(t, testHash, entries[0].Hash)
+			require.Equal(
+				t, LedgerTransferReceived, entries[1].Type,
+			)
+			require.EqualValues(t, 2500, entries[1].Balance)
+
+			// A receive-only account can't send a transfer.
+			receiver.Flags = FlagNoSend
+			require.NoError(t, s.store.UpdateAccount(receiver))
+
+			err = s.TransferBetweenAccounts(testID2, testID, 500)
+			require.ErrorIs(t, err, ErrAccSendDisabled)
+		},
+	}, {
+		name: "subscribe account updates",
+		setup: func(t *testing.T, lnd *mockLnd, s *InterceptorService) {
+			acct := &OffChainBalanceAccount{
+				ID:             testID,
+				Type:           TypeInitialBalance,
+				CurrentBalance: 1234,
+				ExpirationDate: testExpiration,
+				Invoices: map[lntypes.Hash]struct{}{
+					testHash: {},
+				},
+				Payments: make(map[lntypes.Hash]*PaymentEntry),
+			}
+
+			err := s.store.UpdateAccount(acct)
+			require.NoError(t, err)
+		},
+		validate: func(t *testing.T, lnd *mockLnd,
+			s *InterceptorService) {
+
+			client, err := s.SubscribeAccountUpdates()
+			require.NoError(t, err)
+			defer client.Cancel()
+
+			lnd.assertInvoiceRequest(t, 0, 0)
+			lnd.invoiceChan <- &lndclient.Invoice{
+				AddIndex:    12,
+				SettleIndex: 12,
+				Hash:        testHash,
+				AmountPaid:  777,
+				State:       invpkg.ContractSettled,
+			}
+
+			update := receiveUpdate(t, client.Updates())
+			require.Equal(t, UpdateTypeBalance, update.Type)
+			require.Equal(t, testID, update.Account.ID)
+			require.EqualValues(
+				t, 1234+777, update.Account.CurrentBalance,
+			)
+
+			err = s.checkExpiries(
+				time.Now(), testExpiration.Add(time.Second),
+			)
+			require.NoError(t, err)
+
+			update = receiveUpdate(t, client.Updates())
+			require.Equal(t, UpdateTypeExpired, update.Type)
+			require.Equal(t, testID, update.Account.ID)
+
+			require.NoError(t, s.RemoveAccount(testID))
+
+			update = receiveUpdate(t, client.Updates())
+			require.Equal(t, UpdateTypeRemoved, update.Type)
+			require.Equal(t, testID, update.Account.ID)
+		},
+	}, {
+		name: "expiry actions",
+		setup: func(t *testing.T, lnd *mockLnd, s *InterceptorService) {
+			s.cfg = &Config{
+				ExpiryWarning:   2 * time.Hour,
+				ExpiryAction:    ExpiryActionSweep.String(),
+				TreasuryAccount: hex.EncodeToString(testID2[:]),
+			}
+			require.NoError(t, s.cfg.Validate())
+
+			expired := &OffChainBalanceAccount{
+				ID:             testID,
+				Type:           TypeInitialBalance,
+				CurrentBalance: 5000,
+				ExpirationDate: time.Now().Add(-time.Hour),
+				Invoices: map[lntypes.Hash]struct{}{
+					testHash: {},
+				},
+				Payments: make(map[lntypes.Hash]*PaymentEntry),
+			}
+			treasury := &OffChainBalanceAccount{
+				ID:             testID2,
+				Type:           TypeInitialBalance,
+				CurrentBalance: 1000,
+				Invoices:       make(map[lntypes.Hash]struct{}),
+				Payments:       make(map[lntypes.Hash]*PaymentEntry),
+			}
+
+			require.NoError(t, s.store.UpdateAccount(expired))
+			require.NoError(t, s.store.UpdateAccount(treasury))
+		},
+		validate: func(t *testing.T, lnd *mockLnd,
+			s *InterceptorService) {
+
+			client, err := s.SubscribeAccountUpdates()
+			require.NoError(t, err)
+			defer client.Cancel()
+
+			// Both the start of the warning period and the
+			// expiration fall into the checked window.
+			now := time.Now()
+			err = s.checkExpiries(now.Add(-4*time.Hour), now)
+			require.NoError(t, err)
+
+			update := receiveUpdate(t, client.Updates())
+			require.Equal(t, UpdateTypeExpiringSoon, update.Type)
+			require.Equal(t, testID, update.Account.ID)
+
+			update = receiveUpdate(t, client.Updates())
+			require.Equal(t, UpdateTypeExpired, update.Type)
+			require.Equal(t, testID, update.Account.ID)
+
+			// The remaining balance is swept to the treasury.
+			update = receiveUpdate(t, client.Updates())
+			require.Equal(t, UpdateTypeBalance, update.Type)
+			require.Zero(t, update.Account.CurrentBalance)
+
+			update = receiveUpdate(t, client.Updates())
+			require.Equal(t, UpdateTypeBalance, update.Type)
+			require.Equal(t, testID2, update.Account.ID)
+			require.EqualValues(
+				t, 6000, update.Account.CurrentBalance,
+			)
+
+			// A second check doesn't sweep again.
+			err = s.checkExpiries(now, time.Now())
+			require.NoError(t, err)
+
+			actions, err := s.ExpiryActions(0, 0)
+			require.NoError(t, err)
+			require.Len(t, actions, 1)
+			require.Equal(t, testID, actions[0].AccountID)
+			require.Equal(t, ExpiryActionSweep, actions[0].Action)
+			require.EqualValues(t, 5000, actions[0].Amount)
+			require.Equal(t, testID2, actions[0].Treasury)
+
+			// The treasury account can't be removed while in use.
+			err = s.RemoveAccount(testID2)
+			require.ErrorContains(t, err, "treasury account")
+
+			// With the remove action, the account is only removed
+			// once the configured number of days has passed.
+			s.cfg.ExpiryAction = ExpiryActionRemove.String()
+			s.cfg.RemoveAfterDays = 1
+			require.NoError(t, s.cfg.Validate())
+
+			require.NoError(t, s.checkExpiries(now, time.Now()))
+			_, err = s.store.Account(testID)
+			require.NoError(t, err)
+
+			err = s.checkExpiries(now, now.Add(24*time.Hour))
+			require.NoError(t, err)
+			_, err = s.store.Account(testID)
+			require.ErrorIs(t, err, ErrAccNotFound)
+
+			s.RLock()
+			require.NotContains(t, s.invoiceToAccount, testHash)
+			s.RUnlock()
+
+			update = receiveUpdate(t, client.Updates())
+			require.Equal(t, UpdateTypeRemoved, update.Type)
+			require.Equal(t, testID, update.Account.ID)
+
+			actions, err = s.ExpiryActions(1, 0)
+			require.NoError(t, err)
+			require.Len(t, actions, 1)
+			require.Equal(t, ExpiryActionRemove, actions[0].Action)
+			require.EqualValues(t, 2, actions[0].Index)
+		},
+	}, {
+		name: "create child account",
+		setup: func(t *testing.T, lnd *mockLnd, s *InterceptorService) {
+			parent := &OffChainBalanceAccount{
+				ID:             testID,
+				Type:           TypeInitialBalance,
+				CurrentBalance: 5000,
+				ExpirationDate: testExpiration,
+				Flags:          FlagDelegate,
+				Invoices:       make(map[lntypes.Hash]struct{}),
+				Payments: map[lntypes.Hash]*PaymentEntry{
+					testHash: {
+						Status:     lnrpc.Payment_IN_FLIGHT,
+						FullAmount: 1000,
+					},
+				},
+			}
+			other := &OffChainBalanceAccount{
+				ID:             testID2,
+				Type:           TypeInitialBalance,
+				CurrentBalance: 5000,
+				Invoices:       make(map[lntypes.Hash]struct{}),
+				Payments:       make(map[lntypes.Hash]*PaymentEntry),
+			}
+
+			require.NoError(t, s.store.UpdateAccount(parent))
+			require.NoError(t, s.store.UpdateAccount(other))
+		},
+		validate: func(t *testing.T, lnd *mockLnd,
+			s *InterceptorService) {
+
+			// Only accounts with the delegate flag can create
+			// child accounts.
+			_, err := s.NewChildAccount(
+				testID2, 1000, time.Time{}, 0, SpendLimits{}, "",
+				nil, nil,
+			)
+			require.ErrorIs(t, err, ErrDelegationNotAllowed)
+
+			// A child can't outlive its parent.
+			_, err = s.NewChildAccount(
+				testID, 1000, time.Time{}, 0, SpendLimits{}, "",
+				nil, nil,
+			)
+			require.ErrorContains(t, err, "cannot expire after")
+
+			// The payment in flight reserves part of the balance.
+			_, err = s.NewChildAccount(
+				testID, 4001, testExpiration, 0, SpendLimits{},
+				"", nil, nil,
+			)
+			require.ErrorIs(t, err, ErrAccBalanceInsufficient)
+
+			_, err = s.NewChildAccount(
+				testID, 1000, testExpiration, FlagDelegate,
+				SpendLimits{}, "", nil, nil,
+			)
+			require.ErrorContains(t, err, "cannot create child")
+
+			child, err := s.NewChildAccount(
+				testID, 4000, testExpiration, 0, SpendLimits{},
+				"", nil, nil,
+			)
+			require.NoError(t, err)
+			require.Equal(t, testID, child.ParentID)
+
+			parent, err := s.store.Account(testID)
+			require.NoError(t, err)
+			require.EqualValues(t, 1000, parent.CurrentBalance)
+
+			// The node operator can revoke delegation, which takes
+			// effect immediately.
+			flags := FlagNoReceive
+			parent, err = s.UpdateAccount(
+				testID, -1, -1, &flags, nil, nil, nil,
+			)
+			require.NoError(t, err)
+			require.False(t, parent.CanDelegate())
+			require.False(t, parent.CanReceive())
+
+			_, err = s.NewChildAccount(
+				testID, 100, testExpiration, 0, SpendLimits{},
+				"", nil, nil,
+			)
+			require.ErrorIs(t, err, ErrDelegationNotAllowed)
+
+			// Delegation can be granted again, but never to a
+			// child account.
+			flags = FlagDelegate
+			parent, err = s.UpdateAccount(
+				testID, -1, -1, &flags, nil, nil, nil,
+			)
+			require.NoError(t, err)
+			require.True(t, parent.CanDelegate())
+
+			_, err = s.UpdateAccount(
+				child.ID, -1, -1, &flags, nil, nil, nil,
+			)
+			require.ErrorContains(t, err, "cannot create child")
+		},
+	}, {
+		name: "child account expiry sweep",
+		setup: func(t *testing.T, lnd *mockLnd, s *InterceptorService) {
+			s.cfg = &Config{
+				ExpiryAction:    ExpiryActionSweep.String(),
+				TreasuryAccount: hex.EncodeToString(testID2[:]),
+			}
+			require.NoError(t, s.cfg.Validate())
+
+			newAccount := func(id AccountID, balance int64,
+				parentID AccountID) {
+
+				acct := &OffChainBalanceAccount{
+					ID:             id,
+					Type:           TypeInitialBalance,
+					CurrentBalance: balance,
+					ParentID:       parentID,
+					Invoices: make(
+						map[lntypes.Hash]struct{},
+					),
+					Payments: make(
+						map[lntypes.Hash]*PaymentEntry,
+					),
+				}
+				require.NoError(t, s.store.UpdateAccount(acct))
+			}
+			newAccount(testID2, 0, AccountID{})
+			newAccount(testID, 1000, AccountID{})
+			newAccount(AccountID{1}, 500, testID)
+
+			child, err := s.store.Account(AccountID{1})
+			require.NoError(t, err)
+			child.ExpirationDate = time.Now().Add(-time.Hour)
+			require.NoError(t, s.store.UpdateAccount(child))
+		},
+		validate: func(t *testing.T, lnd *mockLnd,
+			s *InterceptorService) {
+
+			now := time.Now()
+			err := s.checkExpiries(now.Add(-2*time.Hour), now)
+			require.NoError(t, err)
+
+			// The balance of the child is returned to its parent
+			// instead of the treasury account.
+			parent, err := s.store.Account(testID)
+			require.NoError(t, err)
+			require.EqualValues(t, 1500, parent.CurrentBalance)
+
+			treasury, err := s.store.Account(testID2)
+			require.NoError(t, err)
+			require.Zero(t, treasury.CurrentBalance)
+
+			actions, err := s.ExpiryActions(0, 0)
+			require.NoError(t, err)
+			require.Len(t, actions, 1)
+			require.Equal(t, testID, actions[0].Treasury)
+			require.EqualValues(t, 500, actions[0].Amount)
+		},
+	}, {
+		name: "update label",
+		setup: func(t *testing.T, lnd *mockLnd, s *InterceptorService) {
+			acct := &OffChainBalanceAccount{
+				ID:             testID,
+				Type:           TypeInitialBalance,
+				CurrentBalance: 1000,
+				Label:          "old",
+				Invoices:       make(map[lntypes.Hash]struct{}),
+				Payments: make(
+					map[lntypes.Hash]*PaymentEntry,
+				),
+			}
+			require.NoError(t, s.store.UpdateAccount(acct))
+		},
+		validate: func(t *testing.T, lnd *mockLnd,
+			s *InterceptorService) {
+
+			// A nil label leaves the label unchanged.
+			acct, err := s.UpdateAccount(
+				testID, -1, -1, nil, nil, nil, nil,
+			)
+			require.NoError(t, err)
+			require.Equal(t, "old", acct.Label)
+
+			newLabel := "new"
+			acct, err = s.UpdateAccount(
+				testID, -1, -1, nil, nil, &newLabel, nil,
+			)
+			require.NoError(t, err)
+			require.Equal(t, "new", acct.Label)
+
+			// An empty label removes it.
+			noLabel := ""
+			acct, err = s.UpdateAccount(
+				testID, -1, -1, nil, nil, &noLabel, nil,
+			)
+			require.NoError(t, err)
+			require.Empty(t, acct.Label)
+
+			acct, err = s.store.Account(testID)
+			require.NoError(t, err)
+			require.Empty(t, acct.Label)
+		},
+	}, {
+		name: "fiat budget",
+		setup: func(t *testing.T, lnd *mockLnd, s *InterceptorService) {
+			// One cent costs 1k msats, so the in-flight payment of
+			// 2k msats counts as two cents against the budget.
+			priceFile := filepath.Join(t.TempDir(), "prices.json")
+			err := os.WriteFile(
+				priceFile, []byte(`{"EUR": 1000}`), 0600,
+			)
+			require.NoError(t, err)
+
+			s.cfg = DefaultConfig()
+			s.cfg.PriceOracleFile = priceFile
+			require.NoError(t, s.cfg.Validate())
+
+			acct := &OffChainBalanceAccount{
+				ID:             testID,
+				Type:           TypeInitialBalance,
+				CurrentBalance: 50000,
+				Invoices:       make(map[lntypes.Hash]struct{}),
+				Payments: map[lntypes.Hash]*PaymentEntry{
+					testHash: {
+						Status:     lnrpc.Payment_IN_FLIGHT,
+						FullAmount: 2000,
+					},
+				},
+				FiatBudget: &FiatBudget{
+					Currency: "EUR",
+					Budget:   10,
+					Spent:    2,
+				},
+			}
+
+			err = s.store.UpdateAccount(acct)
+			require.NoError(t, err)
+		},
+		validate: func(t *testing.T, lnd *mockLnd,
+			s *InterceptorService) {
+
+			// The balance would allow the payment, but six cents is
+			// all that is left of the fiat budget.
+			err := s.CheckBalance(testID, 6000)
+			require.NoError(t, err)
+
+			err = s.CheckBalance(testID, 6001)
+			require.ErrorIs(t, err, ErrAccFiatBudgetExceeded)
+
+			// The price the payment was tracked with is stored, so
+			// a price change doesn't affect it anymore.
+			acct, err := s.store.Account(testID)
+			require.NoError(t, err)
+			require.EqualValues(
+				t, 1000, acct.Payments[testHash].FiatPrice,
+			)
+
+			err = os.WriteFile(
+				s.cfg.PriceOracleFile, []byte(`{"EUR": 500}`),
+				0600,
+			)
+			require.NoError(t, err)
+
+			// Once the payment succeeds, its full amount is debited
+			// from the budget at the price it was tracked with.
+			lnd.paymentChans[testHash] <- lndclient.PaymentStatus{
+				State: lnrpc.Payment_SUCCEEDED,
+				Fee:   200,
+				Value: 1800,
+			}
+
+			assertEventually(t, func() bool {
+				acct, err := s.store.Account(testID)
+				require.NoError(t, err)
+
+				return acct.FiatBudget.Spent == 4
+			})
+
+			entries, err := s.LedgerEntries(testID, 0, 0)
+			require.NoError(t, err)
+			require.Len(t, entries, 1)
+			require.EqualValues(t, 1000, entries[0].FiatPrice)
+			require.EqualValues(t, 2, entries[0].FiatAmount)
 		},
 	}, {
 		name: "in-flight payments",
@@ -451,6 +1166,7 @@ func TestAccountService(t *testing.T) {
 			lndMock := newMockLnd()
 			service, err := NewService(
 				t.TempDir(), lndMock.mainErrChan,
+				DefaultConfig(),
 			)
 			require.NoError(t, err)
 
@@ -486,6 +1202,19 @@ func TestAccountService(t *testing.T) {
 	}
 }
 
+// receiveUpdate waits for the next account update on the given channel.
+func receiveUpdate(t *testing.T, updates <-chan interface{}) *AccountUpdate {
+	select {
+	case update := <-updates:
+		require.IsType(t, &AccountUpdate{}, update)
+		return update.(*AccountUpdate)
+
+	case <-time.After(testTimeout):
+		t.Fatalf("timed out waiting for account update")
+		return nil
+	}
+}
+
 // assertEventually asserts that the given predicate is eventually satisfied.
 func assertEventually(t *testing.T, predicate func() bool) {
 	require.Eventually(t, predicate, testTimeout, testInterval)
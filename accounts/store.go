@@ -10,6 +10,7 @@ import (
 
 	"github.com/btcsuite/btcwallet/walletdb"
 	"github.com/lightningnetwork/lnd/kvdb"
+	"github.com/lightningnetwork/lnd/lnrpc"
 	"github.com/lightningnetwork/lnd/lntypes"
 	"github.com/lightningnetwork/lnd/lnwire"
 	"go.etcd.io/bbolt"
@@ -37,6 +38,15 @@ var (
 	// based balances are stored.
 	accountBucketName = []byte("accounts")
 
+	// ledgerBucketName is the name of the bucket where the transaction
+	// ledgers of all accounts are stored. Each account has its own
+	// sub-bucket keyed by the account ID.
+	ledgerBucketName = []byte("ledger")
+
+	// expiryActionBucketName is the name of the bucket where the audit log
+	// of actions taken on expired accounts is stored.
+	expiryActionBucketName = []byte("expiry-actions")
+
 	// lastAddIndexKey is the name of the key under which we store the last
 	// known invoice add index.
 	lastAddIndexKey = []byte("last-add-index")
@@ -45,6 +55,10 @@ var (
 	// last known invoice settle index.
 	lastSettleIndexKey = []byte("last-settle-index")
 
+	// lastExpiryCheckKey is the name of the key under which we store the
+	// time of the last check for expired accounts.
+	lastExpiryCheckKey = []byte("last-expiry-check")
+
 	// byteOrder is the binary byte order we use to encode integers.
 	byteOrder = binary.BigEndian
 
@@ -83,9 +97,19 @@ func NewBoltStore(dir, fileName string) (*BoltStore, error) {
 		return nil, err
 	}
 
-	// If the store's bucket doesn't exist, create it.
+	// If the store's buckets don't exist, create them.
 	err = db.Update(func(tx kvdb.RwTx) error {
 		_, err := tx.CreateTopLevelBucket(accountBucketName)
+		if err != nil {
+			return err
+		}
+
+		_, err = tx.CreateTopLevelBucket(ledgerBucketName)
+		if err != nil {
+			return err
+		}
+
+		_, err = tx.CreateTopLevelBucket(expiryActionBucketName)
 		return err
 	}, func() {})
 	if err != nil {
@@ -102,29 +126,29 @@ func (s *BoltStore) Close() error {
 }
 
 // NewAccount creates a new OffChainBalanceAccount with the given balance and a
-// randomly chosen ID.
+// randomly chosen ID. If the renewal period is not RenewalNone, the account is
+// of type TypeRecurringAllowance and the balance is topped back up to the given
+// balance periodically. The given flags restrict the capabilities of the
+// account and the given limits restrict how fast its balance can be spent. The
+// optional label must be unique among all accounts. The optional fiat budget
+// limits spending in a fiat currency.
 func (s *BoltStore) NewAccount(balance lnwire.MilliSatoshi,
-	expirationDate time.Time) (*OffChainBalanceAccount, error) {
-
-	if balance == 0 {
-		return nil, fmt.Errorf("a new account cannot have balance of 0")
-	}
+	expirationDate time.Time, renewalPeriod RenewalPeriod,
+	flags AccountFlags, limits SpendLimits, label string,
+	metadata map[string]string,
+	fiatBudget *FiatBudget) (*OffChainBalanceAccount, error) {
 
-	// First, create a new instance of an account. Currently, only the type
-	// TypeInitialBalance is supported.
-	account := &OffChainBalanceAccount{
-		Type:           TypeInitialBalance,
-		InitialBalance: balance,
-		CurrentBalance: int64(balance),
-		ExpirationDate: expirationDate,
-		LastUpdate:     time.Now(),
-		Invoices:       make(map[lntypes.Hash]struct{}),
-		Payments:       make(map[lntypes.Hash]*PaymentEntry),
+	account, err := newAccount(
+		balance, expirationDate, renewalPeriod, flags, limits, label,
+		metadata, fiatBudget,
+	)
+	if err != nil {
+		return nil, err
 	}
 
 	// Try storing the account in the account database, so we can keep track
 	// of its balance.
-	err := s.db.Update(func(tx walletdb.ReadWriteTx) error {
+	err = s.db.Update(func(tx walletdb.ReadWriteTx) error {
 		bucket := tx.ReadWriteBucket(accountBucketName)
 		if bucket == nil {
 			return ErrAccountBucketNotFound
@@ -148,6 +172,156 @@ func (s *BoltStore) NewAccount(balance lnwire.MilliSatoshi,
 	return account, nil
 }
 
+// NewChildAccount creates a new OffChainBalanceAccount of type
+// TypeInitialBalance as the child of the given parent account. The balance of
+// the new account is debited from the current balance of the parent account in
+// the same database transaction, and the debit is recorded in the ledger of the
+// parent account.
+func (s *BoltStore) NewChildAccount(parentID AccountID,
+	balance lnwire.MilliSatoshi, expirationDate time.Time,
+	flags AccountFlags, limits SpendLimits, label string,
+	metadata map[string]string,
+	fiatBudget *FiatBudget) (*OffChainBalanceAccount, error) {
+
+	account, err := newAccount(
+		balance, expirationDate, RenewalNone, flags, limits, label,
+		metadata, fiatBudget,
+	)
+	if err != nil {
+		return nil, err
+	}
+	account.ParentID = parentID
+
+	err = s.db.Update(func(tx kvdb.RwTx) error {
+		bucket := tx.ReadWriteBucket(accountBucketName)
+		if bucket == nil {
+			return ErrAccountBucketNotFound
+		}
+
+		ledgerBucket := tx.ReadWriteBucket(ledgerBucketName)
+		if ledgerBucket == nil {
+			return ErrAccountBucketNotFound
+		}
+
+		parent, err := fetchAccount(bucket, parentID)
+		if err != nil {
+			return fmt.Errorf("error fetching parent account: %w",
+				err)
+		}
+
+		// The balance of a child account is never created out of thin
+		// air, so the parent must be able to cover all of it.
+		if parent.CurrentBalance < int64(balance) {
+			return ErrAccBalanceInsufficient
+		}
+
+		id, err := uniqueRandomAccountID(bucket)
+		if err != nil {
+			return fmt.Errorf("error creating random account ID: "+
+				"%w", err)
+		}
+		account.ID = id
+
+		parent.CurrentBalance -= int64(balance)
+		parent.LastUpdate = account.LastUpdate
+
+		if err := storeAccount(bucket, parent); err != nil {
+			return err
+		}
+		if err := storeAccount(bucket, account); err != nil {
+			return err
+		}
+
+		return appendLedgerEntry(ledgerBucket, parentID, &LedgerEntry{
+			Type:      LedgerChildFunded,
+			Amount:    -int64(balance),
+			Timestamp: parent.LastUpdate,
+			Balance:   parent.CurrentBalance,
+		})
+	}, func() {
+		account.ID = zeroID
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return account, nil
+}
+
+// newAccount validates the given parameters and creates a new account instance
+// from them that has not been stored yet and therefore has no ID.
+func newAccount(balance lnwire.MilliSatoshi, expirationDate time.Time,
+	renewalPeriod RenewalPeriod, flags AccountFlags, limits SpendLimits,
+	label string, metadata map[string]string,
+	fiatBudget *FiatBudget) (*OffChainBalanceAccount, error) {
+
+	if balance == 0 {
+		return nil, fmt.Errorf("a new account cannot have balance of 0")
+	}
+
+	if err := flags.validate(); err != nil {
+		return nil, err
+	}
+
+	if err := limits.validate(); err != nil {
+		return nil, err
+	}
+
+	if err := validateLabel(label); err != nil {
+		return nil, err
+	}
+
+	if err := validateMetadata(metadata); err != nil {
+		return nil, err
+	}
+
+	if fiatBudget != nil {
+		if err := fiatBudget.validate(); err != nil {
+			return nil, err
+		}
+
+		// A new account hasn't spent anything yet.
+		fiatBudget = &FiatBudget{
+			Currency: fiatBudget.Currency,
+			Budget:   fiatBudget.Budget,
+		}
+	}
+
+	// First, create a new instance of an account.
+	now := time.Now()
+	account := &OffChainBalanceAccount{
+		Type:           TypeInitialBalance,
+		InitialBalance: balance,
+		CurrentBalance: int64(balance),
+		ExpirationDate: expirationDate,
+		LastUpdate:     now,
+		Invoices:       make(map[lntypes.Hash]struct{}),
+		Payments:       make(map[lntypes.Hash]*PaymentEntry),
+		Flags:          flags,
+		SpendLimits:    limits,
+		Label:          label,
+		Metadata:       metadata,
+		FiatBudget:     fiatBudget,
+	}
+
+	// An account with a renewal period is topped up for the first time one
+	// full period after it was created.
+	switch renewalPeriod {
+	case RenewalNone:
+
+	case RenewalDaily, RenewalWeekly, RenewalMonthly:
+		account.Type = TypeRecurringAllowance
+		account.RenewalPeriod = renewalPeriod
+		account.NextRenewal = renewalPeriod.after(now, now)
+
+	default:
+		return nil, fmt.Errorf("unknown renewal period %v",
+			renewalPeriod)
+	}
+
+	return account, nil
+}
+
 // UpdateAccount writes an account to the database, overwriting the existing one
 // if it exists.
 func (s *BoltStore) UpdateAccount(account *OffChainBalanceAccount) error {
@@ -162,11 +336,352 @@ func (s *BoltStore) UpdateAccount(account *OffChainBalanceAccount) error {
 	}, func() {})
 }
 
-// storeAccount serializes and writes the given account to the given account
+// ImportAccounts stores the given accounts and their ledgers in a single
+// database transaction. Accounts that have the ID of an existing account are
+// handled according to the given policy. Child accounts of imported accounts
+// that were assigned a new ID are linked to the new ID of their parent. The
+// import fails as a whole if any of the accounts uses the label of an existing
+// account.
+func (s *BoltStore) ImportAccounts(accounts []*ExportedAccount,
+	policy ImportPolicy) ([]*ImportedAccount, error) {
+
+	originalIDs := make([]AccountID, len(accounts))
+	originalParentIDs := make([]AccountID, len(accounts))
+	for idx, exported := range accounts {
+		originalIDs[idx] = exported.Account.ID
+		originalParentIDs[idx] = exported.Account.ParentID
+	}
+
+	var results []*ImportedAccount
+	err := s.db.Update(func(tx kvdb.RwTx) error {
+		bucket := tx.ReadWriteBucket(accountBucketName)
+		if bucket == nil {
+			return ErrAccountBucketNotFound
+		}
+
+		ledgerBucket := tx.ReadWriteBucket(ledgerBucketName)
+		if ledgerBucket == nil {
+			return ErrAccountBucketNotFound
+		}
+
+		newIDs := make(map[AccountID]AccountID)
+		for _, exported := range accounts {
+			account := exported.Account
+			result := &ImportedAccount{
+				Account:    account,
+				OriginalID: account.ID,
+			}
+			results = append(results, result)
+
+			if bucket.Get(account.ID[:]) != nil {
+				switch policy {
+				case ImportFailOnCollision:
+					return fmt.Errorf("%w: %x",
+						ErrAccountIDCollision,
+						account.ID[:])
+
+				case ImportSkipExisting:
+					result.Skipped = true
+					continue
+
+				case ImportNewID:
+					id, err := uniqueRandomAccountID(bucket)
+					if err != nil {
+						return fmt.Errorf("error "+
+							"creating random "+
+							"account ID: %w", err)
+					}
+					account.ID = id
+					newIDs[result.OriginalID] = id
+
+				default:
+					return fmt.Errorf("unknown import "+
+						"policy %d", policy)
+				}
+			}
+
+			account.LastUpdate = time.Now()
+			if err := storeAccount(bucket, account); err != nil {
+				return fmt.Errorf("error storing account "+
+					"%x: %w", result.OriginalID[:], err)
+			}
+
+			err := importLedger(
+				ledgerBucket, account.ID, exported.Ledger,
+			)
+			if err != nil {
+				return fmt.Errorf("error storing ledger of "+
+					"account %x: %w", result.OriginalID[:],
+					err)
+			}
+		}
+
+		// Now that all new IDs are known, we can update the parent ID
+		// of any child account whose parent got a new ID.
+		for _, result := range results {
+			account := result.Account
+			newID, ok := newIDs[account.ParentID]
+			if result.Skipped || !account.HasParent() || !ok {
+				continue
+			}
+
+			account.ParentID = newID
+			if err := storeAccount(bucket, account); err != nil {
+				return fmt.Errorf("error storing account "+
+					"%x: %w", result.OriginalID[:], err)
+			}
+		}
+
+		return nil
+	}, func() {
+		results = nil
+		for idx, exported := range accounts {
+			exported.Account.ID = originalIDs[idx]
+			exported.Account.ParentID = originalParentIDs[idx]
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return results, nil
+}
+
+// UpdateAccountWithEntry writes an account to the database and appends the
+// given entry to its transaction ledger in a single database transaction. The
+// index, timestamp and resulting balance of the entry are set by the store.
+func (s *BoltStore) UpdateAccountWithEntry(account *OffChainBalanceAccount,
+	entry *LedgerEntry) error {
+
+	return s.db.Update(func(tx kvdb.RwTx) error {
+		bucket := tx.ReadWriteBucket(accountBucketName)
+		if bucket == nil {
+			return ErrAccountBucketNotFound
+		}
+
+		ledgerBucket := tx.ReadWriteBucket(ledgerBucketName)
+		if ledgerBucket == nil {
+			return ErrAccountBucketNotFound
+		}
+
+		account.LastUpdate = time.Now()
+		if err := storeAccount(bucket, account); err != nil {
+			return err
+		}
+
+		entry.Timestamp = account.LastUpdate
+		entry.Balance = account.CurrentBalance
+		return appendLedgerEntry(ledgerBucket, account.ID, entry)
+	}, func() {})
+}
+
+// TransferBalance atomically moves the given amount from one account to another
+// and records the transfer in the ledgers of both accounts. If the hash is not
+// empty, the transfer pays the invoice with that hash and is recorded as a
+// succeeded payment of the sending account. If the fiat price is not zero, the
+// amount is converted with it and counted against the fiat budget of the
+// sending account.
+func (s *BoltStore) TransferBalance(fromID, toID AccountID,
+	amount lnwire.MilliSatoshi, hash lntypes.Hash,
+	fiatPrice lnwire.MilliSatoshi) error {
+
+	if fromID == toID {
+		return fmt.Errorf("cannot transfer to the same account")
+	}
+
+	if amount == 0 {
+		return fmt.Errorf("cannot transfer an amount of 0")
+	}
+
+	return s.db.Update(func(tx kvdb.RwTx) error {
+		return transferBalance(tx, fromID, toID, amount, hash, fiatPrice)
+	}, func() {})
+}
+
+// transferBalance moves the given amount from one account to another within the
+// given database transaction and records the transfer in the ledgers of both
+// accounts. If the transfer pays an invoice, the invoice is removed from the
+// receiving account.
+func transferBalance(tx kvdb.RwTx, fromID, toID AccountID,
+	amount lnwire.MilliSatoshi, hash lntypes.Hash,
+	fiatPrice lnwire.MilliSatoshi) error {
+
+	bucket := tx.ReadWriteBucket(accountBucketName)
+	if bucket == nil {
+		return ErrAccountBucketNotFound
+	}
+
+	ledgerBucket := tx.ReadWriteBucket(ledgerBucketName)
+	if ledgerBucket == nil {
+		return ErrAccountBucketNotFound
+	}
+
+	from, err := fetchAccount(bucket, fromID)
+	if err != nil {
+		return err
+	}
+
+	to, err := fetchAccount(bucket, toID)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	from.CurrentBalance -= int64(amount)
+	from.LastUpdate = now
+	to.CurrentBalance += int64(amount)
+	to.LastUpdate = now
+
+	if hash != (lntypes.Hash{}) {
+		if _, ok := from.Payments[hash]; ok {
+			return fmt.Errorf("payment %v already exists", hash)
+		}
+
+		from.Payments[hash] = &PaymentEntry{
+			Status:     lnrpc.Payment_SUCCEEDED,
+			FullAmount: amount,
+			Timestamp:  now,
+		}
+
+		// The invoice is paid now, so it must not be mapped to the
+		// receiving account again when the accounts are loaded.
+		delete(to.Invoices, hash)
+	}
+
+	sentEntry := &LedgerEntry{
+		Type:      LedgerTransferSent,
+		Hash:      hash,
+		Amount:    -int64(amount),
+		Timestamp: now,
+		Balance:   from.CurrentBalance,
+	}
+	if from.FiatBudget != nil && fiatPrice != 0 {
+		sentEntry.FiatPrice = fiatPrice
+		sentEntry.FiatAmount = toFiat(amount, fiatPrice)
+		from.FiatBudget.Spent += sentEntry.FiatAmount
+	}
+
+	if err := storeAccount(bucket, from); err != nil {
+		return err
+	}
+	if err := storeAccount(bucket, to); err != nil {
+		return err
+	}
+
+	err = appendLedgerEntry(ledgerBucket, fromID, sentEntry)
+	if err != nil {
+		return err
+	}
+
+	return appendLedgerEntry(ledgerBucket, toID, &LedgerEntry{
+		Type:      LedgerTransferReceived,
+		Hash:      hash,
+		Amount:    int64(amount),
+		Timestamp: now,
+		Balance:   to.CurrentBalance,
+	})
+}
+
+// fetchAccount reads and deserializes the account with the given ID from the
+// given account bucket.
+func fetchAccount(accountBucket kvdb.RBucket,
+	id AccountID) (*OffChainBalanceAccount, error) {
+
+	accountBinary := accountBucket.Get(id[:])
+	if len(accountBinary) == 0 {
+		return nil, ErrAccNotFound
+	}
+
+	return deserializeAccount(accountBinary)
+}
+
+// appendLedgerEntry assigns the next free index of the account's ledger to the
+// given entry and writes it to the account's sub-bucket of the given ledger
 // bucket.
+func appendLedgerEntry(ledgerBucket kvdb.RwBucket, id AccountID,
+	entry *LedgerEntry) error {
+
+	accountLedger, err := ledgerBucket.CreateBucketIfNotExists(id[:])
+	if err != nil {
+		return err
+	}
+
+	index, err := accountLedger.NextSequence()
+	if err != nil {
+		return err
+	}
+	entry.Index = index
+
+	entryBinary, err := serializeLedgerEntry(entry)
+	if err != nil {
+		return err
+	}
+
+	var key [8]byte
+	byteOrder.PutUint64(key[:], index)
+
+	return accountLedger.Put(key[:], entryBinary)
+}
+
+// importLedger writes the given ledger entries of an imported account to the
+// account's sub-bucket of the given ledger bucket, keeping their original
+// indexes. New entries are appended after the highest imported index.
+func importLedger(ledgerBucket kvdb.RwBucket, id AccountID,
+	entries []*LedgerEntry) error {
+
+	// A stale ledger of a removed account with the same ID must not be
+	// mixed with the imported one.
+	if ledgerBucket.NestedReadWriteBucket(id[:]) != nil {
+		if err := ledgerBucket.DeleteNestedBucket(id[:]); err != nil {
+			return err
+		}
+	}
+
+	if len(entries) == 0 {
+		return nil
+	}
+
+	accountLedger, err := ledgerBucket.CreateBucket(id[:])
+	if err != nil {
+		return err
+	}
+
+	var lastIndex uint64
+	for _, entry := range entries {
+		if entry.Index <= lastIndex {
+			return fmt.Errorf("ledger entry index %d out of order",
+				entry.Index)
+		}
+		lastIndex = entry.Index
+
+		entryBinary, err := serializeLedgerEntry(entry)
+		if err != nil {
+			return err
+		}
+
+		var key [8]byte
+		byteOrder.PutUint64(key[:], entry.Index)
+		if err := accountLedger.Put(key[:], entryBinary); err != nil {
+			return err
+		}
+	}
+
+	return accountLedger.SetSequence(lastIndex)
+}
+
+// storeAccount serializes and writes the given account to the given account
+// bucket. If the account has a label, it is made sure that no other account
+// uses the same label.
 func storeAccount(accountBucket kvdb.RwBucket,
 	account *OffChainBalanceAccount) error {
 
+	if account.Label != "" {
+		err := ensureUniqueLabel(accountBucket, account)
+		if err != nil {
+			return err
+		}
+	}
+
 	accountBinary, err := serializeAccount(account)
 	if err != nil {
 		return err
@@ -175,6 +690,80 @@ func storeAccount(accountBucket kvdb.RwBucket,
 	return accountBucket.Put(account.ID[:], accountBinary)
 }
 
+// ensureUniqueLabel returns ErrLabelAlreadyExists if any account other than the
+// given one uses the label of the given account.
+func ensureUniqueLabel(accountBucket kvdb.RBucket,
+	account *OffChainBalanceAccount) error {
+
+	// Most updates don't touch the label, so we can avoid looking at all
+	// other accounts if the label didn't change.
+	existing := accountBucket.Get(account.ID[:])
+	if len(existing) != 0 {
+		stored, err := deserializeAccount(existing)
+		if err != nil {
+			return err
+		}
+
+		if stored.Label == account.Label {
+			return nil
+		}
+	}
+
+	other, err := accountByLabel(accountBucket, account.Label)
+	switch {
+	case err == ErrAccNotFound:
+		return nil
+
+	case err != nil:
+		return err
+
+	case other.ID != account.ID:
+		return ErrLabelAlreadyExists
+
+	default:
+		return nil
+	}
+}
+
+// accountByLabel finds the account with the given label in the given account
+// bucket. If no account has the label, then ErrAccNotFound is returned.
+func accountByLabel(accountBucket kvdb.RBucket,
+	label string) (*OffChainBalanceAccount, error) {
+
+	var account *OffChainBalanceAccount
+	err := accountBucket.ForEach(func(k, v []byte) error {
+		// Skip the special purpose keys.
+		if isSpecialPurposeKey(k) {
+			return nil
+		}
+
+		// We can stop deserializing once we found the account.
+		if account != nil || v == nil {
+			return nil
+		}
+
+		acct, err := deserializeAccount(v)
+		if err != nil {
+			return err
+		}
+
+		if acct.Label == label {
+			account = acct
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if account == nil {
+		return nil, ErrAccNotFound
+	}
+
+	return account, nil
+}
+
 // uniqueRandomAccountID generates a new random ID and makes sure it does not
 // yet exist in the DB.
 func uniqueRandomAccountID(accountBucket kvdb.RBucket) (AccountID, error) {
@@ -243,10 +832,8 @@ func (s *BoltStore) Accounts() ([]*OffChainBalanceAccount, error) {
 		// is also the ID is not used because it is also marshaled into
 		// the value.
 		readFn := func(k, v []byte) error {
-			// Skip the two special purpose keys.
-			if bytes.Equal(k, lastAddIndexKey) ||
-				bytes.Equal(k, lastSettleIndexKey) {
-
+			// Skip the special purpose keys.
+			if isSpecialPurposeKey(k) {
 				return nil
 			}
 
@@ -277,23 +864,234 @@ func (s *BoltStore) Accounts() ([]*OffChainBalanceAccount, error) {
 	return accounts, nil
 }
 
-// RemoveAccount finds an account by its ID and removes it from the DB.
+// AccountByLabel retrieves the account with the given label from the bolt DB.
+// If no account has the label, then ErrAccNotFound is returned.
+func (s *BoltStore) AccountByLabel(label string) (*OffChainBalanceAccount,
+	error) {
+
+	if label == "" {
+		return nil, ErrAccNotFound
+	}
+
+	var account *OffChainBalanceAccount
+	err := s.db.View(func(tx kvdb.RTx) error {
+		bucket := tx.ReadBucket(accountBucketName)
+		if bucket == nil {
+			return ErrAccountBucketNotFound
+		}
+
+		var err error
+		account, err = accountByLabel(bucket, label)
+		return err
+	}, func() {
+		account = nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return account, nil
+}
+
+// RemoveAccount finds an account by its ID and removes it and its transaction
+// ledger from the DB.
 func (s *BoltStore) RemoveAccount(id AccountID) error {
 	return s.db.Update(func(tx kvdb.RwTx) error {
-		bucket := tx.ReadWriteBucket(accountBucketName)
+		return removeAccount(tx, id)
+	}, func() {})
+}
+
+// removeAccount removes the account with the given ID and its transaction
+// ledger within the given database transaction.
+func removeAccount(tx kvdb.RwTx, id AccountID) error {
+	bucket := tx.ReadWriteBucket(accountBucketName)
+	if bucket == nil {
+		return ErrAccountBucketNotFound
+	}
+
+	account := bucket.Get(id[:])
+	if len(account) == 0 {
+		return ErrAccNotFound
+	}
+
+	ledgerBucket := tx.ReadWriteBucket(ledgerBucketName)
+	if ledgerBucket == nil {
+		return ErrAccountBucketNotFound
+	}
+
+	if ledgerBucket.NestedReadWriteBucket(id[:]) != nil {
+		err := ledgerBucket.DeleteNestedBucket(id[:])
+		if err != nil {
+			return err
+		}
+	}
+
+	return bucket.Delete(id[:])
+}
+
+// LedgerEntries returns at most maxEntries entries of the transaction ledger of
+// the given account, starting with the first entry that has an index greater
+// than the given index offset. A maxEntries value of zero means no limit.
+func (s *BoltStore) LedgerEntries(id AccountID, indexOffset,
+	maxEntries uint64) ([]*LedgerEntry, error) {
+
+	var entries []*LedgerEntry
+	err := s.db.View(func(tx kvdb.RTx) error {
+		bucket := tx.ReadBucket(accountBucketName)
 		if bucket == nil {
 			return ErrAccountBucketNotFound
 		}
 
-		account := bucket.Get(id[:])
-		if len(account) == 0 {
+		if len(bucket.Get(id[:])) == 0 {
 			return ErrAccNotFound
 		}
 
-		return bucket.Delete(id[:])
+		ledgerBucket := tx.ReadBucket(ledgerBucketName)
+		if ledgerBucket == nil {
+			return ErrAccountBucketNotFound
+		}
+
+		// An account that never had any changes doesn't have a ledger
+		// yet.
+		accountLedger := ledgerBucket.NestedReadBucket(id[:])
+		if accountLedger == nil {
+			return nil
+		}
+
+		var startKey [8]byte
+		byteOrder.PutUint64(startKey[:], indexOffset+1)
+
+		cursor := accountLedger.ReadCursor()
+		k, v := cursor.Seek(startKey[:])
+		for ; k != nil; k, v = cursor.Next() {
+			numEntries := uint64(len(entries))
+			if maxEntries != 0 && numEntries >= maxEntries {
+				break
+			}
+
+			entry, err := deserializeLedgerEntry(v)
+			if err != nil {
+				return err
+			}
+
+			entries = append(entries, entry)
+		}
+
+		return nil
+	}, func() {
+		entries = nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return entries, nil
+}
+
+// ApplyExpiryAction takes the action of the given entry on the expired account
+// and appends the entry to the audit log of actions taken on expired accounts
+// in a single database transaction. A remove action removes the account and its
+// ledger, a sweep action moves the entry's amount to the entry's treasury
+// account. The index and timestamp of the entry are set by the store.
+func (s *BoltStore) ApplyExpiryAction(entry *ExpiryActionEntry) error {
+	if entry.Action == ExpiryActionSweep && entry.Amount <= 0 {
+		return fmt.Errorf("cannot sweep an amount of %d", entry.Amount)
+	}
+
+	return s.db.Update(func(tx kvdb.RwTx) error {
+		bucket := tx.ReadWriteBucket(expiryActionBucketName)
+		if bucket == nil {
+			return ErrAccountBucketNotFound
+		}
+
+		var err error
+		switch entry.Action {
+		case ExpiryActionRemove:
+			err = removeAccount(tx, entry.AccountID)
+
+		case ExpiryActionSweep:
+			if entry.AccountID == entry.Treasury {
+				return fmt.Errorf("cannot sweep to the same " +
+					"account")
+			}
+
+			err = transferBalance(
+				tx, entry.AccountID, entry.Treasury,
+				lnwire.MilliSatoshi(entry.Amount),
+				lntypes.Hash{}, 0,
+			)
+
+		default:
+			err = fmt.Errorf("unknown expiry action %v",
+				entry.Action)
+		}
+		if err != nil {
+			return err
+		}
+
+		index, err := bucket.NextSequence()
+		if err != nil {
+			return err
+		}
+		entry.Index = index
+		entry.Timestamp = time.Now()
+
+		entryBinary, err := serializeExpiryAction(entry)
+		if err != nil {
+			return err
+		}
+
+		var key [8]byte
+		byteOrder.PutUint64(key[:], index)
+
+		return bucket.Put(key[:], entryBinary)
 	}, func() {})
 }
 
+// ExpiryActions returns at most maxEntries entries of the audit log of actions
+// taken on expired accounts, starting with the first entry that has an index
+// greater than the given index offset. A maxEntries value of zero means no
+// limit.
+func (s *BoltStore) ExpiryActions(indexOffset,
+	maxEntries uint64) ([]*ExpiryActionEntry, error) {
+
+	var entries []*ExpiryActionEntry
+	err := s.db.View(func(tx kvdb.RTx) error {
+		bucket := tx.ReadBucket(expiryActionBucketName)
+		if bucket == nil {
+			return ErrAccountBucketNotFound
+		}
+
+		var startKey [8]byte
+		byteOrder.PutUint64(startKey[:], indexOffset+1)
+
+		cursor := bucket.ReadCursor()
+		k, v := cursor.Seek(startKey[:])
+		for ; k != nil; k, v = cursor.Next() {
+			numEntries := uint64(len(entries))
+			if maxEntries != 0 && numEntries >= maxEntries {
+				break
+			}
+
+			entry, err := deserializeExpiryAction(v)
+			if err != nil {
+				return err
+			}
+
+			entries = append(entries, entry)
+		}
+
+		return nil
+	}, func() {
+		entries = nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return entries, nil
+}
+
 // LastIndexes returns the last invoice add and settle index or
 // ErrNoInvoiceIndexKnown if no indexes are known yet.
 func (s *BoltStore) LastIndexes() (uint64, uint64, error) {
@@ -347,3 +1145,50 @@ func (s *BoltStore) StoreLastIndexes(addIndex, settleIndex uint64) error {
 		return bucket.Put(lastSettleIndexKey, settleValue)
 	}, func() {})
 }
+
+// LastExpiryCheck returns the time of the last check for expired accounts or a
+// zero time if no check was recorded yet.
+func (s *BoltStore) LastExpiryCheck() (time.Time, error) {
+	var lastCheck time.Time
+	err := s.db.View(func(tx kvdb.RTx) error {
+		bucket := tx.ReadBucket(accountBucketName)
+		if bucket == nil {
+			return ErrAccountBucketNotFound
+		}
+
+		value := bucket.Get(lastExpiryCheckKey)
+		if len(value) == 0 {
+			return nil
+		}
+
+		lastCheck = time.Unix(0, int64(byteOrder.Uint64(value)))
+		return nil
+	}, func() {
+		lastCheck = time.Time{}
+	})
+
+	return lastCheck, err
+}
+
+// StoreLastExpiryCheck stores the time of the last check for expired accounts.
+func (s *BoltStore) StoreLastExpiryCheck(lastCheck time.Time) error {
+	value := make([]byte, 8)
+	byteOrder.PutUint64(value, uint64(lastCheck.UnixNano()))
+
+	return s.db.Update(func(tx kvdb.RwTx) error {
+		bucket := tx.ReadWriteBucket(accountBucketName)
+		if bucket == nil {
+			return ErrAccountBucketNotFound
+		}
+
+		return bucket.Put(lastExpiryCheckKey, value)
+	}, func() {})
+}
+
+// isSpecialPurposeKey returns true if the given key of the account bucket
+// doesn't belong to an account.
+func isSpecialPurposeKey(k []byte) bool {
+	return bytes.Equal(k, lastAddIndexKey) ||
+		bytes.Equal(k, lastSettleIndexKey) ||
+		bytes.Equal(k, lastExpiryCheckKey)
+}
@@ -18,11 +18,30 @@ func TestAccountStore(t *testing.T) {
 
 	// An initial balance of 0 is not allowed, but later we can reach a
 	// zero balance.
-	_, err = store.NewAccount(0, time.Time{})
+	_, err = store.NewAccount(
+		0, time.Time{}, RenewalNone, 0, SpendLimits{}, "", nil, nil,
+	)
 	require.ErrorContains(t, err, "cannot have balance of 0")
 
+	// An account that can neither send nor receive is useless.
+	_, err = store.NewAccount(
+		123, time.Time{}, RenewalNone, FlagNoSend|FlagNoReceive,
+		SpendLimits{}, "", nil, nil,
+	)
+	require.ErrorContains(t, err, "cannot be both send-only and")
+
+	// A spend limit without a window doesn't make sense.
+	_, err = store.NewAccount(
+		123, time.Time{}, RenewalNone, 0, SpendLimits{
+			WindowLimit: 1000,
+		}, "", nil, nil,
+	)
+	require.ErrorContains(t, err, "requires a positive window")
+
 	// Create an account that does not expire.
-	acct1, err := store.NewAccount(123, time.Time{})
+	acct1, err := store.NewAccount(
+		123, time.Time{}, RenewalNone, 0, SpendLimits{}, "", nil, nil,
+	)
 	require.NoError(t, err)
 	require.False(t, acct1.HasExpired())
 
@@ -41,9 +60,16 @@ func TestAccountStore(t *testing.T) {
 	acct1.Payments[lntypes.Hash{34, 56, 78, 90}] = &PaymentEntry{
 		Status:     lnrpc.Payment_SUCCEEDED,
 		FullAmount: 789456123789,
+		Timestamp:  time.Unix(0, 1234567890),
 	}
 	acct1.Invoices[lntypes.Hash{12, 34, 56, 78}] = struct{}{}
 	acct1.Invoices[lntypes.Hash{34, 56, 78, 90}] = struct{}{}
+	acct1.Flags = FlagNoReceive
+	acct1.SpendLimits = SpendLimits{
+		MaxPaymentAmount: 1000,
+		WindowLimit:      5000,
+		Window:           24 * time.Hour,
+	}
 	err = store.UpdateAccount(acct1)
 	require.NoError(t, err)
 
@@ -74,8 +100,76 @@ func TestAccountStore(t *testing.T) {
 	require.ErrorIs(t, err, ErrAccNotFound)
 }
 
+// TestRecurringAllowanceAccount tests that recurring allowance accounts are
+// stored correctly and that their balance is renewed as expected.
+func TestRecurringAllowanceAccount(t *testing.T) {
+	t.Parallel()
+
+	store, err := NewBoltStore(t.TempDir(), DBFilename)
+	require.NoError(t, err)
+
+	_, err = store.NewAccount(
+		123, time.Time{}, RenewalPeriod(99), 0, SpendLimits{}, "", nil,
+		nil,
+	)
+	require.ErrorContains(t, err, "unknown renewal period")
+
+	acct, err := store.NewAccount(
+		5000, time.Time{}, RenewalDaily, 0, SpendLimits{}, "", nil, nil,
+	)
+	require.NoError(t, err)
+	require.Equal(t, TypeRecurringAllowance, acct.Type)
+	require.Equal(t, RenewalDaily, acct.RenewalPeriod)
+	require.Equal(
+		t, acct.LastUpdate.AddDate(0, 0, 1).UnixNano(),
+		acct.NextRenewal.UnixNano(),
+	)
+
+	dbAccount, err := store.Account(acct.ID)
+	require.NoError(t, err)
+	assertEqualAccounts(t, acct, dbAccount)
+
+	// The account shouldn't be renewed before the next renewal time.
+	acct.CurrentBalance = 1000
+	nextRenewal := acct.NextRenewal
+	require.False(t, acct.renew(nextRenewal.Add(-time.Second)))
+	require.EqualValues(t, 1000, acct.CurrentBalance)
+
+	// If we missed multiple periods, the balance is only topped up once
+	// and the next renewal is scheduled in the future.
+	now := nextRenewal.Add(50 * time.Hour)
+	require.True(t, acct.renew(now))
+	require.EqualValues(t, 5000, acct.CurrentBalance)
+	require.Equal(
+		t, nextRenewal.AddDate(0, 0, 3).UnixNano(),
+		acct.NextRenewal.UnixNano(),
+	)
+
+	// A balance above the allowance isn't taken away when renewing.
+	acct.CurrentBalance = 7000
+	require.True(t, acct.renew(acct.NextRenewal))
+	require.EqualValues(t, 7000, acct.CurrentBalance)
+
+	require.NoError(t, store.UpdateAccount(acct))
+	dbAccount, err = store.Account(acct.ID)
+	require.NoError(t, err)
+	assertEqualAccounts(t, acct, dbAccount)
+
+	// Accounts with an initial balance are never renewed.
+	acct2, err := store.NewAccount(
+		5000, time.Time{}, RenewalNone, 0, SpendLimits{}, "", nil, nil,
+	)
+	require.NoError(t, err)
+	require.Equal(t, TypeInitialBalance, acct2.Type)
+	require.True(t, acct2.NextRenewal.IsZero())
+
+	acct2.CurrentBalance = 1000
+	require.False(t, acct2.renew(time.Now().AddDate(1, 0, 0)))
+	require.EqualValues(t, 1000, acct2.CurrentBalance)
+}
+
 // assertEqualAccounts asserts that two accounts are equal. This helper function
-// is needed because an account contains two time.Time values that cannot be
+// is needed because an account contains time.Time values that cannot be
 // compared using reflect.DeepEqual().
 func assertEqualAccounts(t *testing.T, expected,
 	actual *OffChainBalanceAccount) {
@@ -84,21 +178,182 @@ func assertEqualAccounts(t *testing.T, expected,
 	actualExpiry := actual.ExpirationDate
 	expectedUpdate := expected.LastUpdate
 	actualUpdate := actual.LastUpdate
+	expectedRenewal := expected.NextRenewal
+	actualRenewal := actual.NextRenewal
 
 	expected.ExpirationDate = time.Time{}
 	expected.LastUpdate = time.Time{}
+	expected.NextRenewal = time.Time{}
 	actual.ExpirationDate = time.Time{}
 	actual.LastUpdate = time.Time{}
+	actual.NextRenewal = time.Time{}
 
 	require.Equal(t, expected, actual)
 	require.Equal(t, expectedExpiry.UnixNano(), actualExpiry.UnixNano())
 	require.Equal(t, expectedUpdate.UnixNano(), actualUpdate.UnixNano())
+	require.Equal(t, expectedRenewal.UnixNano(), actualRenewal.UnixNano())
 
 	// Restore the old values to not influence the tests.
 	expected.ExpirationDate = expectedExpiry
 	expected.LastUpdate = expectedUpdate
+	expected.NextRenewal = expectedRenewal
 	actual.ExpirationDate = actualExpiry
 	actual.LastUpdate = actualUpdate
+	actual.NextRenewal = actualRenewal
+}
+
+// TestAccountLedger tests that ledger entries are appended atomically with the
+// account update and that they can be listed with pagination.
+func TestAccountLedger(t *testing.T) {
+	t.Parallel()
+
+	store, err := NewBoltStore(t.TempDir(), DBFilename)
+	require.NoError(t, err)
+
+	acct, err := store.NewAccount(
+		10_000, time.Time{}, RenewalNone, 0, SpendLimits{}, "", nil, nil,
+	)
+	require.NoError(t, err)
+
+	// A fresh account doesn't have any ledger entries yet.
+	entries, err := store.LedgerEntries(acct.ID, 0, 0)
+	require.NoError(t, err)
+	require.Empty(t, entries)
+
+	_, err = store.LedgerEntries(AccountID{1, 2, 3}, 0, 0)
+	require.ErrorIs(t, err, ErrAccNotFound)
+
+	// Record a settled invoice, a succeeded and a failed payment.
+	acct.CurrentBalance += 3_000
+	err = store.UpdateAccountWithEntry(acct, &LedgerEntry{
+		Type:   LedgerInvoiceSettled,
+		Hash:   lntypes.Hash{1},
+		Amount: 3_000,
+	})
+	require.NoError(t, err)
+
+	acct.CurrentBalance -= 5_100
+	err = store.UpdateAccountWithEntry(acct, &LedgerEntry{
+		Type:   LedgerPaymentSucceeded,
+		Hash:   lntypes.Hash{2},
+		Amount: -5_000,
+		Fee:    100,
+	})
+	require.NoError(t, err)
+
+	err = store.UpdateAccountWithEntry(acct, &LedgerEntry{
+		Type:     LedgerPaymentFailed,
+		Hash:     lntypes.Hash{3},
+		Released: 2_000,
+	})
+	require.NoError(t, err)
+
+	dbAccount, err := store.Account(acct.ID)
+	require.NoError(t, err)
+	require.EqualValues(t, 7_900, dbAccount.CurrentBalance)
+
+	entries, err = store.LedgerEntries(acct.ID, 0, 0)
+	require.NoError(t, err)
+	require.Len(t, entries, 3)
+
+	expectedBalances := []int64{13_000, 7_900, 7_900}
+	for idx, entry := range entries {
+		require.EqualValues(t, idx+1, entry.Index)
+		require.Equal(t, expectedBalances[idx], entry.Balance)
+		require.False(t, entry.Timestamp.IsZero())
+	}
+	require.Equal(t, LedgerPaymentSucceeded, entries[1].Type)
+	require.Equal(t, lntypes.Hash{2}, entries[1].Hash)
+	require.EqualValues(t, -5_000, entries[1].Amount)
+	require.EqualValues(t, 100, entries[1].Fee)
+
+	// Test pagination.
+	entries, err = store.LedgerEntries(acct.ID, 1, 1)
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	require.EqualValues(t, 2, entries[0].Index)
+
+	entries, err = store.LedgerEntries(acct.ID, 3, 10)
+	require.NoError(t, err)
+	require.Empty(t, entries)
+
+	// Listing accounts must not be confused by the ledger bucket, and
+	// removing the account also removes its ledger.
+	accounts, err := store.Accounts()
+	require.NoError(t, err)
+	require.Len(t, accounts, 1)
+
+	require.NoError(t, store.RemoveAccount(acct.ID))
+
+	_, err = store.LedgerEntries(acct.ID, 0, 0)
+	require.ErrorIs(t, err, ErrAccNotFound)
+}
+
+// TestAccountLabels tests that account labels are unique, can be used to look
+// up accounts and that metadata is stored correctly.
+func TestAccountLabels(t *testing.T) {
+	t.Parallel()
+
+	store, err := NewBoltStore(t.TempDir(), DBFilename)
+	require.NoError(t, err)
+
+	// A label must not be confused with an account ID.
+	_, err = store.NewAccount(
+		123, time.Time{}, RenewalNone, 0, SpendLimits{},
+		"0011223344556677", nil, nil,
+	)
+	require.ErrorContains(t, err, "label cannot be a valid account ID")
+
+	_, err = store.NewAccount(
+		123, time.Time{}, RenewalNone, 0, SpendLimits{}, "",
+		map[string]string{"": "foo"}, nil,
+	)
+	require.ErrorContains(t, err, "metadata key cannot be empty")
+
+	acct1, err := store.NewAccount(
+		123, time.Time{}, RenewalNone, 0, SpendLimits{}, "alice",
+		map[string]string{"email": "alice@example.com", "tier": "1"},
+		nil,
+	)
+	require.NoError(t, err)
+
+	dbAccount, err := store.Account(acct1.ID)
+	require.NoError(t, err)
+	assertEqualAccounts(t, acct1, dbAccount)
+
+	dbAccount, err = store.AccountByLabel("alice")
+	require.NoError(t, err)
+	require.Equal(t, acct1.ID, dbAccount.ID)
+
+	_, err = store.AccountByLabel("bob")
+	require.ErrorIs(t, err, ErrAccNotFound)
+
+	// The same label can't be used twice.
+	_, err = store.NewAccount(
+		123, time.Time{}, RenewalNone, 0, SpendLimits{}, "alice", nil,
+		nil,
+	)
+	require.ErrorIs(t, err, ErrLabelAlreadyExists)
+
+	acct2, err := store.NewAccount(
+		123, time.Time{}, RenewalNone, 0, SpendLimits{}, "bob", nil, nil,
+	)
+	require.NoError(t, err)
+
+	// Neither when renaming an account.
+	acct2.Label = "alice"
+	require.ErrorIs(t, store.UpdateAccount(acct2), ErrLabelAlreadyExists)
+
+	// But an account can be updated without changing its label and the
+	// label of a removed account can be reused.
+	acct1.CurrentBalance = 5
+	require.NoError(t, store.UpdateAccount(acct1))
+	require.NoError(t, store.RemoveAccount(acct1.ID))
+	require.NoError(t, store.UpdateAccount(acct2))
+
+	dbAccount, err = store.AccountByLabel("alice")
+	require.NoError(t, err)
+	require.Equal(t, acct2.ID, dbAccount.ID)
 }
 
 // TestLastInvoiceIndexes makes sure the last known invoice indexes can be
@@ -119,3 +374,260 @@ func TestLastInvoiceIndexes(t *testing.T) {
 	require.EqualValues(t, 7, add)
 	require.EqualValues(t, 99, settle)
 }
+
+// TestLastExpiryCheck tests that the time of the last expiry check is stored
+// and doesn't show up as an account.
+func TestLastExpiryCheck(t *testing.T) {
+	t.Parallel()
+
+	store, err := NewBoltStore(t.TempDir(), DBFilename)
+	require.NoError(t, err)
+
+	lastCheck, err := store.LastExpiryCheck()
+	require.NoError(t, err)
+	require.True(t, lastCheck.IsZero())
+
+	now := time.Now()
+	require.NoError(t, store.StoreLastExpiryCheck(now))
+
+	lastCheck, err = store.LastExpiryCheck()
+	require.NoError(t, err)
+	require.True(t, now.Equal(lastCheck))
+
+	accounts, err := store.Accounts()
+	require.NoError(t, err)
+	require.Empty(t, accounts)
+}
+
+// TestAccountExportImport tests that accounts can be exported and imported
+// into another store and that ID collisions are handled correctly.
+func TestAccountExportImport(t *testing.T) {
+	t.Parallel()
+
+	srcStore, err := NewBoltStore(t.TempDir(), DBFilename)
+	require.NoError(t, err)
+
+	acct1, err := srcStore.NewAccount(
+		123, time.Time{}, RenewalNone, 0, SpendLimits{}, "alice",
+		map[string]string{"customer": "1"}, nil,
+	)
+	require.NoError(t, err)
+	acct1.Invoices[lntypes.Hash{12, 34}] = struct{}{}
+	acct1.Payments[lntypes.Hash{34, 56}] = &PaymentEntry{
+		Status:     lnrpc.Payment_SUCCEEDED,
+		FullAmount: 100,
+	}
+	acct1.CurrentBalance += 1000
+	require.NoError(t, srcStore.UpdateAccountWithEntry(acct1, &LedgerEntry{
+		Type:   LedgerAdminUpdate,
+		Amount: 1000,
+	}))
+
+	acct2, err := srcStore.NewAccount(
+		456, time.Now().Add(time.Hour), RenewalNone, 0, SpendLimits{},
+		"", nil, nil,
+	)
+	require.NoError(t, err)
+
+	ledger1, err := srcStore.LedgerEntries(acct1.ID, 0, 0)
+	require.NoError(t, err)
+	require.NotEmpty(t, ledger1)
+
+	export, err := encodeAccountExport([]*ExportedAccount{{
+		Account: acct1,
+		Ledger:  ledger1,
+	}, {
+		Account: acct2,
+	}})
+	require.NoError(t, err)
+
+	assertEqualLedgers := func(expected, actual []*LedgerEntry) {
+		require.Len(t, actual, len(expected))
+		for idx := range expected {
+			require.Equal(t, expected[idx].Index, actual[idx].Index)
+			require.Equal(t, expected[idx].Type, actual[idx].Type)
+			require.Equal(
+				t, expected[idx].Amount, actual[idx].Amount,
+			)
+			require.Equal(
+				t, expected[idx].Balance, actual[idx].Balance,
+			)
+			require.True(t, expected[idx].Timestamp.Equal(
+				actual[idx].Timestamp,
+			))
+		}
+	}
+
+	// The accounts, including their invoices, payments and ledgers,
+	// survive the round trip.
+	decoded, err := decodeAccountExport(export)
+	require.NoError(t, err)
+	require.Len(t, decoded, 2)
+	assertEqualAccounts(t, acct1, decoded[0].Account)
+	assertEqualAccounts(t, acct2, decoded[1].Account)
+	assertEqualLedgers(ledger1, decoded[0].Ledger)
+	require.Empty(t, decoded[1].Ledger)
+
+	// Any modification of the export is detected.
+	tampered := append([]byte{}, export...)
+	tampered[len(exportMagic)+10] ^= 0xff
+	_, err = decodeAccountExport(tampered)
+	require.ErrorIs(t, err, ErrExportChecksumMismatch)
+
+	// Accounts with payments in flight can't be moved.
+	acct2.Payments[lntypes.Hash{56, 78}] = &PaymentEntry{
+		Status: lnrpc.Payment_IN_FLIGHT,
+	}
+	require.ErrorContains(
+		t, ensureNoPaymentsInFlight(acct2), "in flight",
+	)
+
+	dstStore, err := NewBoltStore(t.TempDir(), DBFilename)
+	require.NoError(t, err)
+
+	importExport := func(policy ImportPolicy) ([]*ImportedAccount, error) {
+		accounts, err := decodeAccountExport(export)
+		require.NoError(t, err)
+
+		return dstStore.ImportAccounts(accounts, policy)
+	}
+	assertNumAccounts := func(num int) {
+		accounts, err := dstStore.Accounts()
+		require.NoError(t, err)
+		require.Len(t, accounts, num)
+	}
+
+	results, err := importExport(ImportFailOnCollision)
+	require.NoError(t, err)
+	require.Len(t, results, 2)
+	for _, result := range results {
+		require.False(t, result.Skipped)
+		require.Equal(t, result.OriginalID, result.Account.ID)
+	}
+
+	dbAccount, err := dstStore.Account(acct1.ID)
+	require.NoError(t, err)
+	require.Equal(t, acct1.Invoices, dbAccount.Invoices)
+	require.Equal(t, acct1.Label, dbAccount.Label)
+	require.Equal(t, acct1.Metadata, dbAccount.Metadata)
+	require.Len(t, dbAccount.Payments, 1)
+
+	// The ledger is imported with its original indexes and continues
+	// after them.
+	dbLedger, err := dstStore.LedgerEntries(acct1.ID, 0, 0)
+	require.NoError(t, err)
+	assertEqualLedgers(ledger1, dbLedger)
+
+	require.NoError(t, dstStore.UpdateAccountWithEntry(
+		dbAccount, &LedgerEntry{Type: LedgerAdminUpdate},
+	))
+	dbLedger, err = dstStore.LedgerEntries(acct1.ID, 0, 0)
+	require.NoError(t, err)
+	require.Len(t, dbLedger, len(ledger1)+1)
+	require.Equal(
+		t, ledger1[len(ledger1)-1].Index+1, dbLedger[len(ledger1)].Index,
+	)
+
+	// Importing the same accounts again collides with the existing IDs.
+	_, err = importExport(ImportFailOnCollision)
+	require.ErrorIs(t, err, ErrAccountIDCollision)
+	assertNumAccounts(2)
+
+	results, err = importExport(ImportSkipExisting)
+	require.NoError(t, err)
+	require.True(t, results[0].Skipped)
+	require.True(t, results[1].Skipped)
+	assertNumAccounts(2)
+
+	// With new IDs, the label of the first account is still in use, which
+	// aborts the whole import.
+	_, err = importExport(ImportNewID)
+	require.ErrorIs(t, err, ErrLabelAlreadyExists)
+	assertNumAccounts(2)
+
+	// Once the label is free, the accounts are imported with new IDs.
+	require.NoError(t, dstStore.RemoveAccount(acct1.ID))
+	results, err = importExport(ImportNewID)
+	require.NoError(t, err)
+	require.Equal(t, acct1.ID, results[0].Account.ID)
+	require.NotEqual(t, acct2.ID, results[1].Account.ID)
+	require.Equal(t, acct2.ID, results[1].OriginalID)
+	assertNumAccounts(3)
+}
+
+// TestChildAccount makes sure the balance of a child account is carved out of
+// the balance of its parent account.
+func TestChildAccount(t *testing.T) {
+	t.Parallel()
+
+	store, err := NewBoltStore(t.TempDir(), DBFilename)
+	require.NoError(t, err)
+
+	parent, err := store.NewAccount(
+		5000, time.Time{}, RenewalNone, FlagDelegate, SpendLimits{}, "",
+		nil, nil,
+	)
+	require.NoError(t, err)
+	require.True(t, parent.CanDelegate())
+
+	// The parent can't fund a child with more than its balance.
+	_, err = store.NewChildAccount(
+		parent.ID, 5001, time.Time{}, 0, SpendLimits{}, "", nil, nil,
+	)
+	require.ErrorIs(t, err, ErrAccBalanceInsufficient)
+
+	_, err = store.NewChildAccount(
+		AccountID{1, 2, 3}, 1000, time.Time{}, 0, SpendLimits{}, "",
+		nil, nil,
+	)
+	require.ErrorIs(t, err, ErrAccNotFound)
+
+	child, err := store.NewChildAccount(
+		parent.ID, 2000, time.Time{}, FlagNoSend, SpendLimits{},
+		"bob", nil, nil,
+	)
+	require.NoError(t, err)
+	require.Equal(t, parent.ID, child.ParentID)
+	require.True(t, child.HasParent())
+
+	dbChild, err := store.Account(child.ID)
+	require.NoError(t, err)
+	assertEqualAccounts(t, child, dbChild)
+
+	dbParent, err := store.Account(parent.ID)
+	require.NoError(t, err)
+	require.EqualValues(t, 3000, dbParent.CurrentBalance)
+	require.False(t, dbParent.HasParent())
+
+	entries, err := store.LedgerEntries(parent.ID, 0, 0)
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	require.Equal(t, LedgerChildFunded, entries[0].Type)
+	require.EqualValues(t, -2000, entries[0].Amount)
+	require.EqualValues(t, 3000, entries[0].Balance)
+
+	// If the parent gets a new ID when importing both accounts into a
+	// store that already has an account with the parent's ID, the child
+	// is linked to the new ID.
+	dstStore, err := NewBoltStore(t.TempDir(), DBFilename)
+	require.NoError(t, err)
+
+	dbParent.Label = ""
+	require.NoError(t, dstStore.UpdateAccount(dbParent))
+
+	dbChild.Label = ""
+	results, err := dstStore.ImportAccounts([]*ExportedAccount{{
+		Account: dbParent,
+	}, {
+		Account: dbChild,
+	}}, ImportNewID)
+	require.NoError(t, err)
+	require.Len(t, results, 2)
+
+	newParentID := results[0].Account.ID
+	require.NotEqual(t, parent.ID, newParentID)
+
+	importedChild, err := dstStore.Account(child.ID)
+	require.NoError(t, err)
+	require.Equal(t, newParentID, importedChild.ParentID)
+}
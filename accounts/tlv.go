@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"fmt"
 	"io"
+	"sort"
 	"time"
 
 	"github.com/lightningnetwork/lnd/lnrpc"
@@ -21,6 +22,43 @@ const (
 	typeExpirationDate tlv.Type = 6
 	typeInvoices       tlv.Type = 7
 	typePayments       tlv.Type = 8
+	typeRenewalPeriod  tlv.Type = 9
+	typeNextRenewal    tlv.Type = 10
+	typeFlags          tlv.Type = 11
+	typeMaxPaymentAmt  tlv.Type = 12
+	typeWindowLimit    tlv.Type = 13
+	typeWindow         tlv.Type = 14
+	typePaymentTimes   tlv.Type = 15
+	typeLabel          tlv.Type = 16
+	typeMetadata       tlv.Type = 17
+	typeAmpInvoices    tlv.Type = 18
+	typeFiatCurrency   tlv.Type = 19
+	typeFiatBudget     tlv.Type = 20
+	typeFiatSpent      tlv.Type = 21
+	typeParentID       tlv.Type = 22
+	typePaymentPrices  tlv.Type = 23
+)
+
+const (
+	typeEntryIndex     tlv.Type = 1
+	typeEntryType      tlv.Type = 2
+	typeEntryHash      tlv.Type = 3
+	typeEntryAmount    tlv.Type = 4
+	typeEntryFee       tlv.Type = 5
+	typeEntryTimestamp tlv.Type = 6
+	typeEntryBalance   tlv.Type = 7
+	typeEntryFiatPrice tlv.Type = 8
+	typeEntryFiatAmt   tlv.Type = 9
+	typeEntryReleased  tlv.Type = 10
+)
+
+const (
+	typeActionIndex     tlv.Type = 1
+	typeActionAccountID tlv.Type = 2
+	typeActionType      tlv.Type = 3
+	typeActionAmount    tlv.Type = 4
+	typeActionTreasury  tlv.Type = 5
+	typeActionTimestamp tlv.Type = 6
 )
 
 func serializeAccount(account *OffChainBalanceAccount) ([]byte, error) {
@@ -57,6 +95,116 @@ func serializeAccount(account *OffChainBalanceAccount) ([]byte, error) {
 		newPaymentEntryMapRecord(typePayments, &account.Payments),
 	)
 
+	if account.Type == TypeRecurringAllowance {
+		var (
+			renewalPeriod = uint8(account.RenewalPeriod)
+			nextRenewal   = uint64(account.NextRenewal.UnixNano())
+		)
+		tlvRecords = append(
+			tlvRecords,
+			tlv.MakePrimitiveRecord(
+				typeRenewalPeriod, &renewalPeriod,
+			),
+			tlv.MakePrimitiveRecord(typeNextRenewal, &nextRenewal),
+		)
+	}
+
+	if account.Flags != 0 {
+		flags := uint8(account.Flags)
+		tlvRecords = append(tlvRecords, tlv.MakePrimitiveRecord(
+			typeFlags, &flags,
+		))
+	}
+
+	if account.SpendLimits.MaxPaymentAmount != 0 {
+		maxPaymentAmt := uint64(account.SpendLimits.MaxPaymentAmount)
+		tlvRecords = append(tlvRecords, tlv.MakePrimitiveRecord(
+			typeMaxPaymentAmt, &maxPaymentAmt,
+		))
+	}
+
+	if account.SpendLimits.WindowLimit != 0 {
+		var (
+			windowLimit = uint64(account.SpendLimits.WindowLimit)
+			window      = uint64(account.SpendLimits.Window)
+		)
+		tlvRecords = append(
+			tlvRecords,
+			tlv.MakePrimitiveRecord(typeWindowLimit, &windowLimit),
+			tlv.MakePrimitiveRecord(typeWindow, &window),
+		)
+	}
+
+	// The payment timestamps are stored in a separate record to stay
+	// compatible with the fixed size encoding of the payment entries.
+	paymentTimes := make(map[lntypes.Hash]time.Time)
+	for hash, entry := range account.Payments {
+		if !entry.Timestamp.IsZero() {
+			paymentTimes[hash] = entry.Timestamp
+		}
+	}
+	if len(paymentTimes) > 0 {
+		tlvRecords = append(tlvRecords, newTimestampMapRecord(
+			typePaymentTimes, &paymentTimes,
+		))
+	}
+
+	if account.Label != "" {
+		label := []byte(account.Label)
+		tlvRecords = append(tlvRecords, tlv.MakePrimitiveRecord(
+			typeLabel, &label,
+		))
+	}
+
+	if len(account.Metadata) > 0 {
+		tlvRecords = append(tlvRecords, newStringMapRecord(
+			typeMetadata, &account.Metadata,
+		))
+	}
+
+	if len(account.AmpInvoices) > 0 {
+		tlvRecords = append(tlvRecords, newAmountMapRecord(
+			typeAmpInvoices, &account.AmpInvoices,
+		))
+	}
+
+	if account.FiatBudget != nil {
+		var (
+			fiatCurrency = []byte(account.FiatBudget.Currency)
+			fiatBudget   = uint64(account.FiatBudget.Budget)
+			fiatSpent    = uint64(account.FiatBudget.Spent)
+		)
+		tlvRecords = append(
+			tlvRecords,
+			tlv.MakePrimitiveRecord(
+				typeFiatCurrency, &fiatCurrency,
+			),
+			tlv.MakePrimitiveRecord(typeFiatBudget, &fiatBudget),
+			tlv.MakePrimitiveRecord(typeFiatSpent, &fiatSpent),
+		)
+	}
+
+	if account.HasParent() {
+		parentID := account.ParentID[:]
+		tlvRecords = append(tlvRecords, tlv.MakePrimitiveRecord(
+			typeParentID, &parentID,
+		))
+	}
+
+	// The fiat prices of the payments are stored in a separate record for
+	// the same reason as their timestamps.
+	paymentPrices := make(map[lntypes.Hash]lnwire.MilliSatoshi)
+	for hash, entry := range account.Payments {
+		if entry.FiatPrice != 0 {
+			paymentPrices[hash] = entry.FiatPrice
+		}
+	}
+	if len(paymentPrices) > 0 {
+		tlvRecords = append(tlvRecords, newAmountMapRecord(
+			typePaymentPrices, &paymentPrices,
+		))
+	}
+
 	tlvStream, err := tlv.NewStream(tlvRecords...)
 	if err != nil {
 		return nil, err
@@ -80,6 +228,21 @@ func deserializeAccount(content []byte) (*OffChainBalanceAccount, error) {
 		expirationDate uint64
 		invoices       map[lntypes.Hash]struct{}
 		payments       map[lntypes.Hash]*PaymentEntry
+		renewalPeriod  uint8
+		nextRenewal    uint64
+		flags          uint8
+		maxPaymentAmt  uint64
+		windowLimit    uint64
+		window         uint64
+		paymentTimes   map[lntypes.Hash]time.Time
+		paymentPrices  map[lntypes.Hash]lnwire.MilliSatoshi
+		label          []byte
+		metadata       map[string]string
+		ampInvoices    map[lntypes.Hash]lnwire.MilliSatoshi
+		fiatCurrency   []byte
+		fiatBudget     uint64
+		fiatSpent      uint64
+		parentID       []byte
 	)
 
 	tlvStream, err := tlv.NewStream(
@@ -91,6 +254,21 @@ func deserializeAccount(content []byte) (*OffChainBalanceAccount, error) {
 		tlv.MakePrimitiveRecord(typeExpirationDate, &expirationDate),
 		newHashMapRecord(typeInvoices, &invoices),
 		newPaymentEntryMapRecord(typePayments, &payments),
+		tlv.MakePrimitiveRecord(typeRenewalPeriod, &renewalPeriod),
+		tlv.MakePrimitiveRecord(typeNextRenewal, &nextRenewal),
+		tlv.MakePrimitiveRecord(typeFlags, &flags),
+		tlv.MakePrimitiveRecord(typeMaxPaymentAmt, &maxPaymentAmt),
+		tlv.MakePrimitiveRecord(typeWindowLimit, &windowLimit),
+		tlv.MakePrimitiveRecord(typeWindow, &window),
+		newTimestampMapRecord(typePaymentTimes, &paymentTimes),
+		tlv.MakePrimitiveRecord(typeLabel, &label),
+		newStringMapRecord(typeMetadata, &metadata),
+		newAmountMapRecord(typeAmpInvoices, &ampInvoices),
+		tlv.MakePrimitiveRecord(typeFiatCurrency, &fiatCurrency),
+		tlv.MakePrimitiveRecord(typeFiatBudget, &fiatBudget),
+		tlv.MakePrimitiveRecord(typeFiatSpent, &fiatSpent),
+		tlv.MakePrimitiveRecord(typeParentID, &parentID),
+		newAmountMapRecord(typePaymentPrices, &paymentPrices),
 	)
 	if err != nil {
 		return nil, err
@@ -108,16 +286,237 @@ func deserializeAccount(content []byte) (*OffChainBalanceAccount, error) {
 		LastUpdate:     time.Unix(0, int64(lastUpdate)),
 		Invoices:       invoices,
 		Payments:       payments,
+		Flags:          AccountFlags(flags),
+		SpendLimits: SpendLimits{
+			MaxPaymentAmount: lnwire.MilliSatoshi(maxPaymentAmt),
+			WindowLimit:      lnwire.MilliSatoshi(windowLimit),
+			Window:           time.Duration(window),
+		},
+		Label:       string(label),
+		Metadata:    metadata,
+		AmpInvoices: ampInvoices,
 	}
 	copy(account.ID[:], id)
+	copy(account.ParentID[:], parentID)
 
 	if t, ok := parsedTypes[typeExpirationDate]; ok && t == nil {
 		account.ExpirationDate = time.Unix(0, int64(expirationDate))
 	}
 
+	if t, ok := parsedTypes[typeRenewalPeriod]; ok && t == nil {
+		account.RenewalPeriod = RenewalPeriod(renewalPeriod)
+	}
+
+	if t, ok := parsedTypes[typeNextRenewal]; ok && t == nil {
+		account.NextRenewal = time.Unix(0, int64(nextRenewal))
+	}
+
+	if t, ok := parsedTypes[typeFiatCurrency]; ok && t == nil {
+		account.FiatBudget = &FiatBudget{
+			Currency: string(fiatCurrency),
+			Budget:   int64(fiatBudget),
+			Spent:    int64(fiatSpent),
+		}
+	}
+
+	for hash, timestamp := range paymentTimes {
+		if entry, ok := account.Payments[hash]; ok {
+			entry.Timestamp = timestamp
+		}
+	}
+
+	for hash, price := range paymentPrices {
+		if entry, ok := account.Payments[hash]; ok {
+			entry.FiatPrice = price
+		}
+	}
+
 	return account, nil
 }
 
+// serializeLedgerEntry encodes the given ledger entry as a TLV stream. The
+// fiat and released amounts are only written if they are set.
+func serializeLedgerEntry(entry *LedgerEntry) ([]byte, error) {
+	if entry == nil {
+		return nil, fmt.Errorf("ledger entry cannot be nil")
+	}
+	var (
+		buf       bytes.Buffer
+		entryType = uint8(entry.Type)
+		hash      = [32]byte(entry.Hash)
+		amount    = uint64(entry.Amount)
+		fee       = uint64(entry.Fee)
+		timestamp = uint64(entry.Timestamp.UnixNano())
+		balance   = uint64(entry.Balance)
+	)
+
+	tlvRecords := []tlv.Record{
+		tlv.MakePrimitiveRecord(typeEntryIndex, &entry.Index),
+		tlv.MakePrimitiveRecord(typeEntryType, &entryType),
+		tlv.MakePrimitiveRecord(typeEntryHash, &hash),
+		tlv.MakePrimitiveRecord(typeEntryAmount, &amount),
+		tlv.MakePrimitiveRecord(typeEntryFee, &fee),
+		tlv.MakePrimitiveRecord(typeEntryTimestamp, &timestamp),
+		tlv.MakePrimitiveRecord(typeEntryBalance, &balance),
+	}
+
+	if entry.FiatPrice != 0 {
+		var (
+			fiatPrice = uint64(entry.FiatPrice)
+			fiatAmt   = uint64(entry.FiatAmount)
+		)
+		tlvRecords = append(
+			tlvRecords,
+			tlv.MakePrimitiveRecord(typeEntryFiatPrice, &fiatPrice),
+			tlv.MakePrimitiveRecord(typeEntryFiatAmt, &fiatAmt),
+		)
+	}
+
+	if entry.Released != 0 {
+		released := uint64(entry.Released)
+		tlvRecords = append(
+			tlvRecords,
+			tlv.MakePrimitiveRecord(typeEntryReleased, &released),
+		)
+	}
+
+	tlvStream, err := tlv.NewStream(tlvRecords...)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tlvStream.Encode(&buf); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+// deserializeLedgerEntry decodes a ledger entry from the given TLV stream.
+func deserializeLedgerEntry(content []byte) (*LedgerEntry, error) {
+	var (
+		r         = bytes.NewReader(content)
+		index     uint64
+		entryType uint8
+		hash      [32]byte
+		amount    uint64
+		fee       uint64
+		timestamp uint64
+		balance   uint64
+		fiatPrice uint64
+		fiatAmt   uint64
+		released  uint64
+	)
+
+	tlvStream, err := tlv.NewStream(
+		tlv.MakePrimitiveRecord(typeEntryIndex, &index),
+		tlv.MakePrimitiveRecord(typeEntryType, &entryType),
+		tlv.MakePrimitiveRecord(typeEntryHash, &hash),
+		tlv.MakePrimitiveRecord(typeEntryAmount, &amount),
+		tlv.MakePrimitiveRecord(typeEntryFee, &fee),
+		tlv.MakePrimitiveRecord(typeEntryTimestamp, &timestamp),
+		tlv.MakePrimitiveRecord(typeEntryBalance, &balance),
+		tlv.MakePrimitiveRecord(typeEntryFiatPrice, &fiatPrice),
+		tlv.MakePrimitiveRecord(typeEntryFiatAmt, &fiatAmt),
+		tlv.MakePrimitiveRecord(typeEntryReleased, &released),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tlvStream.Decode(r); err != nil {
+		return nil, err
+	}
+
+	return &LedgerEntry{
+		Index:      index,
+		Type:       LedgerEntryType(entryType),
+		Hash:       hash,
+		Amount:     int64(amount),
+		Fee:        lnwire.MilliSatoshi(fee),
+		Timestamp:  time.Unix(0, int64(timestamp)),
+		Balance:    int64(balance),
+		FiatPrice:  lnwire.MilliSatoshi(fiatPrice),
+		FiatAmount: int64(fiatAmt),
+		Released:   lnwire.MilliSatoshi(released),
+	}, nil
+}
+
+// serializeExpiryAction encodes the given expiry action audit record as a TLV
+// stream.
+func serializeExpiryAction(entry *ExpiryActionEntry) ([]byte, error) {
+	if entry == nil {
+		return nil, fmt.Errorf("expiry action cannot be nil")
+	}
+	var (
+		buf       bytes.Buffer
+		accountID = entry.AccountID[:]
+		action    = uint8(entry.Action)
+		amount    = uint64(entry.Amount)
+		treasury  = entry.Treasury[:]
+		timestamp = uint64(entry.Timestamp.UnixNano())
+	)
+
+	tlvStream, err := tlv.NewStream(
+		tlv.MakePrimitiveRecord(typeActionIndex, &entry.Index),
+		tlv.MakePrimitiveRecord(typeActionAccountID, &accountID),
+		tlv.MakePrimitiveRecord(typeActionType, &action),
+		tlv.MakePrimitiveRecord(typeActionAmount, &amount),
+		tlv.MakePrimitiveRecord(typeActionTreasury, &treasury),
+		tlv.MakePrimitiveRecord(typeActionTimestamp, &timestamp),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tlvStream.Encode(&buf); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+// deserializeExpiryAction decodes an expiry action audit record from the given
+// TLV stream.
+func deserializeExpiryAction(content []byte) (*ExpiryActionEntry, error) {
+	var (
+		r         = bytes.NewReader(content)
+		index     uint64
+		accountID []byte
+		action    uint8
+		amount    uint64
+		treasury  []byte
+		timestamp uint64
+	)
+
+	tlvStream, err := tlv.NewStream(
+		tlv.MakePrimitiveRecord(typeActionIndex, &index),
+		tlv.MakePrimitiveRecord(typeActionAccountID, &accountID),
+		tlv.MakePrimitiveRecord(typeActionType, &action),
+		tlv.MakePrimitiveRecord(typeActionAmount, &amount),
+		tlv.MakePrimitiveRecord(typeActionTreasury, &treasury),
+		tlv.MakePrimitiveRecord(typeActionTimestamp, &timestamp),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tlvStream.Decode(r); err != nil {
+		return nil, err
+	}
+
+	entry := &ExpiryActionEntry{
+		Index:     index,
+		Action:    ExpiryActionType(action),
+		Amount:    int64(amount),
+		Timestamp: time.Unix(0, int64(timestamp)),
+	}
+	copy(entry.AccountID[:], accountID)
+	copy(entry.Treasury[:], treasury)
+
+	return entry, nil
+}
+
 // newHashMapRecord returns a new TLV record for encoding the given map of
 // hashes.
 func newHashMapRecord(tlvType tlv.Type,
@@ -258,3 +657,258 @@ func PaymentEntryMapDecoder(r io.Reader, val any, buf *[8]byte, _ uint64) error
 		val, "*map[lntypes.Hash]*PaymentEntry",
 	)
 }
+
+// newTimestampMapRecord returns a new TLV record for encoding the given map of
+// hashes to timestamps.
+func newTimestampMapRecord(tlvType tlv.Type,
+	timeMap *map[lntypes.Hash]time.Time) tlv.Record {
+
+	recordSize := func() uint64 {
+		// We have the number of entries followed by a 32-byte hash and
+		// 8 bytes for the timestamp for each entry.
+		numEntries := uint64(len(*timeMap))
+		return tlv.VarIntSize(numEntries) +
+			numEntries*(lntypes.HashSize+8)
+	}
+	return tlv.MakeDynamicRecord(
+		tlvType, timeMap, recordSize, TimestampMapEncoder,
+		TimestampMapDecoder,
+	)
+}
+
+// TimestampMapEncoder encodes a map of hashes to timestamps.
+func TimestampMapEncoder(w io.Writer, val any, buf *[8]byte) error {
+	if t, ok := val.(*map[lntypes.Hash]time.Time); ok {
+		if err := tlv.WriteVarInt(w, uint64(len(*t)), buf); err != nil {
+			return err
+		}
+		for hash, timestamp := range *t {
+			hash := [32]byte(hash)
+
+			if err := tlv.EBytes32(w, &hash, buf); err != nil {
+				return err
+			}
+
+			nanos := uint64(timestamp.UnixNano())
+			if err := tlv.EUint64T(w, nanos, buf); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	return tlv.NewTypeForEncodingErr(val, "*map[lntypes.Hash]time.Time")
+}
+
+// TimestampMapDecoder decodes a map of hashes to timestamps.
+func TimestampMapDecoder(r io.Reader, val any, buf *[8]byte, _ uint64) error {
+	if typ, ok := val.(*map[lntypes.Hash]time.Time); ok {
+		numItems, err := tlv.ReadVarInt(r, buf)
+		if err != nil {
+			return err
+		}
+
+		timestamps := make(map[lntypes.Hash]time.Time, numItems)
+		for i := uint64(0); i < numItems; i++ {
+			var item [32]byte
+			if err := tlv.DBytes32(r, &item, buf, 32); err != nil {
+				return err
+			}
+
+			var timestamp uint64
+			err := tlv.DUint64(r, &timestamp, buf, 8)
+			if err != nil {
+				return err
+			}
+
+			timestamps[item] = time.Unix(0, int64(timestamp))
+		}
+		*typ = timestamps
+		return nil
+	}
+	return tlv.NewTypeForEncodingErr(val, "*map[lntypes.Hash]time.Time")
+}
+
+// newAmountMapRecord returns a new TLV record for encoding the given map of
+// hashes to amounts.
+func newAmountMapRecord(tlvType tlv.Type,
+	amountMap *map[lntypes.Hash]lnwire.MilliSatoshi) tlv.Record {
+
+	recordSize := func() uint64 {
+		// We have the number of entries followed by a 32-byte hash and
+		// 8 bytes for the amount for each entry.
+		numEntries := uint64(len(*amountMap))
+		return tlv.VarIntSize(numEntries) +
+			numEntries*(lntypes.HashSize+8)
+	}
+	return tlv.MakeDynamicRecord(
+		tlvType, amountMap, recordSize, AmountMapEncoder,
+		AmountMapDecoder,
+	)
+}
+
+// AmountMapEncoder encodes a map of hashes to amounts.
+func AmountMapEncoder(w io.Writer, val any, buf *[8]byte) error {
+	if t, ok := val.(*map[lntypes.Hash]lnwire.MilliSatoshi); ok {
+		if err := tlv.WriteVarInt(w, uint64(len(*t)), buf); err != nil {
+			return err
+		}
+		for hash, amount := range *t {
+			hash := [32]byte(hash)
+
+			if err := tlv.EBytes32(w, &hash, buf); err != nil {
+				return err
+			}
+
+			err := tlv.EUint64T(w, uint64(amount), buf)
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	return tlv.NewTypeForEncodingErr(
+		val, "*map[lntypes.Hash]lnwire.MilliSatoshi",
+	)
+}
+
+// AmountMapDecoder decodes a map of hashes to amounts.
+func AmountMapDecoder(r io.Reader, val any, buf *[8]byte, _ uint64) error {
+	if typ, ok := val.(*map[lntypes.Hash]lnwire.MilliSatoshi); ok {
+		numItems, err := tlv.ReadVarInt(r, buf)
+		if err != nil {
+			return err
+		}
+
+		amounts := make(map[lntypes.Hash]lnwire.MilliSatoshi, numItems)
+		for i := uint64(0); i < numItems; i++ {
+			var item [32]byte
+			if err := tlv.DBytes32(r, &item, buf, 32); err != nil {
+				return err
+			}
+
+			var amount uint64
+			if err := tlv.DUint64(r, &amount, buf, 8); err != nil {
+				return err
+			}
+
+			amounts[item] = lnwire.MilliSatoshi(amount)
+		}
+		*typ = amounts
+		return nil
+	}
+	return tlv.NewTypeForEncodingErr(
+		val, "*map[lntypes.Hash]lnwire.MilliSatoshi",
+	)
+}
+
+// newStringMapRecord returns a new TLV record for encoding the given map of
+// strings.
+func newStringMapRecord(tlvType tlv.Type,
+	stringMap *map[string]string) tlv.Record {
+
+	recordSize := func() uint64 {
+		// We have the number of entries followed by the length and the
+		// bytes of the key and value of each entry.
+		size := tlv.VarIntSize(uint64(len(*stringMap)))
+		for key, value := range *stringMap {
+			size += tlv.VarIntSize(uint64(len(key))) +
+				uint64(len(key))
+			size += tlv.VarIntSize(uint64(len(value))) +
+				uint64(len(value))
+		}
+
+		return size
+	}
+	return tlv.MakeDynamicRecord(
+		tlvType, stringMap, recordSize, StringMapEncoder,
+		StringMapDecoder,
+	)
+}
+
+// StringMapEncoder encodes a map of strings. The entries are encoded in the
+// order of their keys so the encoding is deterministic.
+func StringMapEncoder(w io.Writer, val any, buf *[8]byte) error {
+	if t, ok := val.(*map[string]string); ok {
+		if err := tlv.WriteVarInt(w, uint64(len(*t)), buf); err != nil {
+			return err
+		}
+
+		keys := make([]string, 0, len(*t))
+		for key := range *t {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+
+		for _, key := range keys {
+			if err := writeString(w, key, buf); err != nil {
+				return err
+			}
+
+			if err := writeString(w, (*t)[key], buf); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	return tlv.NewTypeForEncodingErr(val, "*map[string]string")
+}
+
+// StringMapDecoder decodes a map of strings.
+func StringMapDecoder(r io.Reader, val any, buf *[8]byte, _ uint64) error {
+	if typ, ok := val.(*map[string]string); ok {
+		numItems, err := tlv.ReadVarInt(r, buf)
+		if err != nil {
+			return err
+		}
+
+		entries := make(map[string]string, numItems)
+		for i := uint64(0); i < numItems; i++ {
+			key, err := readString(r, buf)
+			if err != nil {
+				return err
+			}
+
+			value, err := readString(r, buf)
+			if err != nil {
+				return err
+			}
+
+			entries[key] = value
+		}
+		*typ = entries
+		return nil
+	}
+	return tlv.NewTypeForEncodingErr(val, "*map[string]string")
+}
+
+// writeString writes the length of the given string followed by its bytes.
+func writeString(w io.Writer, str string, buf *[8]byte) error {
+	if err := tlv.WriteVarInt(w, uint64(len(str)), buf); err != nil {
+		return err
+	}
+
+	_, err := w.Write([]byte(str))
+	return err
+}
+
+// readString reads a string that was written by writeString.
+func readString(r io.Reader, buf *[8]byte) (string, error) {
+	length, err := tlv.ReadVarInt(r, buf)
+	if err != nil {
+		return "", err
+	}
+
+	// We don't allow any string to be longer than the maximum metadata
+	// value, so we can reject invalid lengths before allocating.
+	if length > MaxMetadataValueLength {
+		return "", fmt.Errorf("string length %d exceeds maximum of %d",
+			length, MaxMetadataValueLength)
+	}
+
+	str := make([]byte, length)
+	if _, err := io.ReadFull(r, str); err != nil {
+		return "", err
+	}
+
+	return string(str), nil
+}
@@ -2,11 +2,14 @@ package main
 
 import (
 	"context"
-	"encoding/hex"
 	"fmt"
+	"io"
 	"os"
+	"path/filepath"
 	"strconv"
+	"strings"
 
+	"github.com/lightninglabs/lightning-terminal/accounts"
 	"github.com/lightninglabs/lightning-terminal/litrpc"
 	"github.com/lightningnetwork/lnd/lncfg"
 	"github.com/urfave/cli"
@@ -23,6 +26,12 @@ var accountsCommands = []cli.Command{
 			updateAccountCommand,
 			listAccountsCommand,
 			removeAccountCommand,
+			accountHistoryCommand,
+			transferCommand,
+			subscribeAccountsCommand,
+			expiryActionsCommand,
+			exportAccountsCommand,
+			importAccountsCommand,
 		},
 	},
 }
@@ -44,6 +53,23 @@ var createAccountCommand = cli.Command{
 	Accounts only assert a maximum amount spendable. Having a certain
 	account balance does not guarantee that the node has the channel
 	liquidity to actually spend that amount.
+
+	If a renewal period is set, the account balance is topped back up to
+	the initial balance at the start of every period (e.g. a monthly
+	allowance). Balance left over from the previous period is not carried
+	over.
+
+	An account can be restricted to be send-only (no invoices can be
+	created) or receive-only (no payments can be sent) by setting the
+	mode.
+
+	Optional spend limits can be set to restrict the amount of a single
+	payment and the total amount spent within a rolling time window
+	(e.g. 50000 satoshis per 24h).
+
+	If allow_delegation is set, the macaroon of the new account can also be
+	used to call this command to create child accounts. The balance of a
+	child account is carved out of the balance of its parent account.
 	`,
 	Flags: []cli.Flag{
 		cli.Uint64Flag{
@@ -56,6 +82,61 @@ var createAccountCommand = cli.Command{
 				"in seconds since the unix epoch. 0 means " +
 				"it does not expire",
 		},
+		cli.StringFlag{
+			Name: "renewal_period",
+			Usage: "the interval at which the account balance " +
+				"is topped back up to the initial balance. " +
+				"Options include none|daily|weekly|monthly",
+			Value: "none",
+		},
+		cli.StringFlag{
+			Name: "mode",
+			Usage: "the capabilities of the account. Options " +
+				"include send_receive|send_only|receive_only",
+			Value: "send_receive",
+		},
+		cli.Uint64Flag{
+			Name: "max_payment_amount",
+			Usage: "the maximum amount in satoshis a single " +
+				"payment can send, excluding routing fees; " +
+				"0 means no limit",
+		},
+		cli.Uint64Flag{
+			Name: "spend_limit",
+			Usage: "the maximum amount in satoshis, including " +
+				"routing fees, that can be spent within the " +
+				"spend_limit_window; 0 means no limit",
+		},
+		cli.DurationFlag{
+			Name: "spend_limit_window",
+			Usage: "the duration of the rolling window the " +
+				"spend_limit applies to (e.g. 24h)",
+		},
+		cli.StringFlag{
+			Name: "label",
+			Usage: "an optional unique label of the account that " +
+				"can be used instead of its ID",
+		},
+		cli.StringSliceFlag{
+			Name: "metadata",
+			Usage: "a key=value metadata entry to attach to the " +
+				"account; can be specified multiple times",
+		},
+		cli.StringFlag{
+			Name: "fiat_currency",
+			Usage: "the ISO 4217 code of a fiat currency (e.g. " +
+				"EUR) the account has a budget in",
+		},
+		cli.Int64Flag{
+			Name: "fiat_budget",
+			Usage: "the budget of the account in the minor unit " +
+				"of the fiat_currency (e.g. cents)",
+		},
+		cli.BoolFlag{
+			Name: "allow_delegation",
+			Usage: "allow the account macaroon to create child " +
+				"accounts funded by the account's balance",
+		},
 		cli.StringFlag{
 			Name: "save_to",
 			Usage: "store the account macaroon created for the " +
@@ -108,9 +189,37 @@ func createAccount(ctx *cli.Context) error {
 		return fmt.Errorf("initial balance cannot be smaller than 1")
 	}
 
+	renewalPeriod, err := parseRenewalPeriod(ctx.String("renewal_period"))
+	if err != nil {
+		return err
+	}
+
+	sendOnly, receiveOnly, err := parseAccountMode(ctx.String("mode"))
+	if err != nil {
+		return err
+	}
+
+	metadata, err := parseMetadata(ctx.StringSlice("metadata"))
+	if err != nil {
+		return err
+	}
+
 	req := &litrpc.CreateAccountRequest{
-		AccountBalance: initialBalance,
-		ExpirationDate: expirationDate,
+		AccountBalance:   initialBalance,
+		ExpirationDate:   expirationDate,
+		RenewalPeriod:    renewalPeriod,
+		SendOnly:         sendOnly,
+		ReceiveOnly:      receiveOnly,
+		MaxPaymentAmount: ctx.Uint64("max_payment_amount"),
+		SpendLimit:       ctx.Uint64("spend_limit"),
+		SpendLimitWindow: uint64(
+			ctx.Duration("spend_limit_window").Seconds(),
+		),
+		Label:           ctx.String("label"),
+		Metadata:        metadata,
+		FiatCurrency:    ctx.String("fiat_currency"),
+		FiatBudget:      ctx.Int64("fiat_budget"),
+		AllowDelegation: ctx.Bool("allow_delegation"),
 	}
 	resp, err := client.CreateAccount(ctxb, req)
 	if err != nil {
@@ -135,6 +244,104 @@ func createAccount(ctx *cli.Context) error {
 	return nil
 }
 
+func parseRenewalPeriod(period string) (litrpc.RenewalPeriod, error) {
+	switch period {
+	case "none":
+		return litrpc.RenewalPeriod_RENEWAL_NONE, nil
+	case "daily":
+		return litrpc.RenewalPeriod_RENEWAL_DAILY, nil
+	case "weekly":
+		return litrpc.RenewalPeriod_RENEWAL_WEEKLY, nil
+	case "monthly":
+		return litrpc.RenewalPeriod_RENEWAL_MONTHLY, nil
+	default:
+		return 0, fmt.Errorf("unsupported renewal period %s", period)
+	}
+}
+
+// parseMetadata parses the given list of key=value pairs into a metadata map.
+func parseMetadata(entries []string) (map[string]string, error) {
+	if len(entries) == 0 {
+		return nil, nil
+	}
+
+	metadata := make(map[string]string, len(entries))
+	for _, entry := range entries {
+		key, value, ok := strings.Cut(entry, "=")
+		if !ok {
+			return nil, fmt.Errorf("invalid metadata entry %s, "+
+				"expected key=value", entry)
+		}
+
+		metadata[key] = value
+	}
+
+	return metadata, nil
+}
+
+// resolveAccountID returns the ID of the account that is identified by the
+// given string, which is either an account ID or the label of an account.
+func resolveAccountID(ctx context.Context, client litrpc.AccountsClient,
+	idOrLabel string) (string, error) {
+
+	if len(idOrLabel) == 0 {
+		return "", fmt.Errorf("id or label argument missing")
+	}
+
+	// Labels can never be valid account IDs, so there is no ambiguity.
+	if _, err := accounts.ParseAccountID(idOrLabel); err == nil {
+		return idOrLabel, nil
+	}
+
+	resp, err := client.ListAccounts(ctx, &litrpc.ListAccountsRequest{
+		Label: idOrLabel,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	if len(resp.Accounts) == 0 {
+		return "", fmt.Errorf("no account with ID or label %s found",
+			idOrLabel)
+	}
+
+	return resp.Accounts[0].Id, nil
+}
+
+// fetchAccount returns the account with the given ID.
+func fetchAccount(ctx context.Context, client litrpc.AccountsClient,
+	id string) (*litrpc.Account, error) {
+
+	resp, err := client.ListAccounts(ctx, &litrpc.ListAccountsRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, account := range resp.Accounts {
+		if account.Id == id {
+			return account, nil
+		}
+	}
+
+	return nil, fmt.Errorf("no account with ID %s found", id)
+}
+
+// parseAccountMode parses the given account mode into the send-only and
+// receive-only capability flags of an account.
+func parseAccountMode(mode string) (bool, bool, error) {
+	switch mode {
+	case "send_receive":
+		return false, false, nil
+	case "send_only":
+		return true, false, nil
+	case "receive_only":
+		return false, true, nil
+	default:
+		return false, false, fmt.Errorf("unsupported account mode %s",
+			mode)
+	}
+}
+
 var updateAccountCommand = cli.Command{
 	Name:      "update",
 	ShortName: "u",
@@ -142,12 +349,21 @@ var updateAccountCommand = cli.Command{
 	ArgsUsage: "id new_balance [new_expiration_date] [--save_to=]",
 	Description: `
 	Updates an existing off-chain account and sets either a new balance or
-	new expiration date or both.
+	new expiration date or both. The capabilities of the account can be
+	changed by setting a new mode. The account can be identified by its ID
+	or its label.
+
+	Metadata entries set with --metadata are merged into the existing
+	metadata of the account. An entry with an empty value (e.g. key=)
+	removes the key.
+
+	If any of the new spend limit flags is set, all spend limits of the
+	account are replaced, meaning limits that are not set are removed.
 	`,
 	Flags: []cli.Flag{
 		cli.StringFlag{
 			Name:  "id",
-			Usage: "the ID of the account to update",
+			Usage: "the ID or label of the account to update",
 		},
 		cli.Int64Flag{
 			Name: "new_balance",
@@ -163,6 +379,50 @@ var updateAccountCommand = cli.Command{
 				"0 means it does not expire",
 			Value: -1,
 		},
+		cli.StringFlag{
+			Name: "new_mode",
+			Usage: "the new capabilities of the account. " +
+				"Options include send_receive|send_only|" +
+				"receive_only; if not set, the capabilities " +
+				"are not updated",
+		},
+		cli.StringFlag{
+			Name: "new_delegation",
+			Usage: "whether the account can create child " +
+				"accounts. Options include allow|deny; if " +
+				"not set, delegation is not updated",
+		},
+		cli.Uint64Flag{
+			Name: "new_max_payment_amount",
+			Usage: "the new maximum amount in satoshis a single " +
+				"payment can send, excluding routing fees; " +
+				"0 means no limit",
+		},
+		cli.Uint64Flag{
+			Name: "new_spend_limit",
+			Usage: "the new maximum amount in satoshis, " +
+				"including routing fees, that can be spent " +
+				"within the new_spend_limit_window; 0 means " +
+				"no limit",
+		},
+		cli.DurationFlag{
+			Name: "new_spend_limit_window",
+			Usage: "the new duration of the rolling window the " +
+				"spend limit applies to (e.g. 24h)",
+		},
+		cli.StringFlag{
+			Name:  "new_label",
+			Usage: "the new unique label of the account",
+		},
+		cli.BoolFlag{
+			Name:  "clear_label",
+			Usage: "remove the label of the account",
+		},
+		cli.StringSliceFlag{
+			Name: "metadata",
+			Usage: "a key=value metadata entry to set on the " +
+				"account; can be specified multiple times",
+		},
 	},
 	Action: updateAccount,
 }
@@ -177,26 +437,27 @@ func updateAccount(ctx *cli.Context) error {
 	client := litrpc.NewAccountsClient(clientConn)
 
 	var (
-		id             []byte
+		idOrLabel      string
 		newBalance     int64
 		expirationDate int64
 	)
 	args := ctx.Args()
 
-	// We parse the ID as hex even though we're supposed to send it as a hex
-	// encoded string over the RPC. But that way we can verify it's actually
-	// the id.
 	switch {
 	case ctx.IsSet("id"):
-		id, err = hex.DecodeString(ctx.String("id"))
+		idOrLabel = ctx.String("id")
 	case args.Present():
-		id, err = hex.DecodeString(args.First())
+		idOrLabel = args.First()
 		args = args.Tail()
 	default:
 		return fmt.Errorf("id is missing")
 	}
+
+	// The account can also be identified by its label, in which case we
+	// need to look up its ID first.
+	id, err := resolveAccountID(ctxb, client, idOrLabel)
 	if err != nil {
-		return fmt.Errorf("error decoding id: %v", err)
+		return err
 	}
 
 	switch {
@@ -223,11 +484,67 @@ func updateAccount(ctx *cli.Context) error {
 		args = args.Tail()
 	}
 
+	metadata, err := parseMetadata(ctx.StringSlice("metadata"))
+	if err != nil {
+		return err
+	}
+
 	req := &litrpc.UpdateAccountRequest{
-		Id:             hex.EncodeToString(id),
+		Id:             id,
 		AccountBalance: newBalance,
 		ExpirationDate: expirationDate,
+		Label:          ctx.String("new_label"),
+		ClearLabel:     ctx.Bool("clear_label"),
+		Metadata:       metadata,
 	}
+
+	// The capabilities are replaced as a whole, so we start with the
+	// current ones and only change what was asked for.
+	if ctx.IsSet("new_mode") || ctx.IsSet("new_delegation") {
+		current, err := fetchAccount(ctxb, client, id)
+		if err != nil {
+			return err
+		}
+
+		req.UpdateCapabilities = true
+		req.SendOnly = current.SendOnly
+		req.ReceiveOnly = current.ReceiveOnly
+		req.AllowDelegation = current.AllowDelegation
+	}
+
+	if ctx.IsSet("new_mode") {
+		req.SendOnly, req.ReceiveOnly, err = parseAccountMode(
+			ctx.String("new_mode"),
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	if ctx.IsSet("new_delegation") {
+		switch ctx.String("new_delegation") {
+		case "allow":
+			req.AllowDelegation = true
+		case "deny":
+			req.AllowDelegation = false
+		default:
+			return fmt.Errorf("unsupported delegation %s",
+				ctx.String("new_delegation"))
+		}
+	}
+
+	if ctx.IsSet("new_max_payment_amount") ||
+		ctx.IsSet("new_spend_limit") ||
+		ctx.IsSet("new_spend_limit_window") {
+
+		req.UpdateSpendLimits = true
+		req.MaxPaymentAmount = ctx.Uint64("new_max_payment_amount")
+		req.SpendLimit = ctx.Uint64("new_spend_limit")
+		req.SpendLimitWindow = uint64(
+			ctx.Duration("new_spend_limit_window").Seconds(),
+		)
+	}
+
 	resp, err := client.UpdateAccount(ctxb, req)
 	if err != nil {
 		return err
@@ -243,8 +560,28 @@ var listAccountsCommand = cli.Command{
 	Usage:     "Lists all off-chain accounts.",
 	Description: `
 	Returns all accounts that are currently stored in the account
-	database.
+	database. The accounts can be filtered by label or by metadata, in
+	which case only accounts that have all given metadata entries are
+	returned. With the parent flag, only the child accounts of the given
+	parent account are listed.
 	`,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  "label",
+			Usage: "only list the account with the given label",
+		},
+		cli.StringSliceFlag{
+			Name: "metadata",
+			Usage: "only list accounts with the given key=value " +
+				"metadata entry; can be specified multiple " +
+				"times",
+		},
+		cli.StringFlag{
+			Name: "parent",
+			Usage: "only list the child accounts of the parent " +
+				"account with the given ID or label",
+		},
+	},
 	Action: listAccounts,
 }
 
@@ -257,7 +594,25 @@ func listAccounts(ctx *cli.Context) error {
 	defer cleanup()
 	client := litrpc.NewAccountsClient(clientConn)
 
-	req := &litrpc.ListAccountsRequest{}
+	metadata, err := parseMetadata(ctx.StringSlice("metadata"))
+	if err != nil {
+		return err
+	}
+
+	req := &litrpc.ListAccountsRequest{
+		Label:    ctx.String("label"),
+		Metadata: metadata,
+	}
+
+	if ctx.IsSet("parent") {
+		req.ParentId, err = resolveAccountID(
+			ctxb, client, ctx.String("parent"),
+		)
+		if err != nil {
+			return err
+		}
+	}
+
 	resp, err := client.ListAccounts(ctxb, req)
 	if err != nil {
 		return err
@@ -273,12 +628,13 @@ var removeAccountCommand = cli.Command{
 	Usage:     "Removes an off-chain account from the database.",
 	ArgsUsage: "id",
 	Description: `
-	Removes an account entry from the account database.
+	Removes an account entry from the account database. The account can be
+	identified by its ID or its label.
 	`,
 	Flags: []cli.Flag{
 		cli.StringFlag{
 			Name:  "id",
-			Usage: "the ID of the account",
+			Usage: "the ID or label of the account",
 		},
 	},
 	Action: removeAccount,
@@ -306,10 +662,8 @@ func removeAccount(ctx *cli.Context) error {
 		return fmt.Errorf("id argument missing")
 	}
 
-	if len(accountID) == 0 {
-		return fmt.Errorf("id argument missing")
-	}
-	if _, err := hex.DecodeString(accountID); err != nil {
+	accountID, err = resolveAccountID(ctxb, client, accountID)
+	if err != nil {
 		return err
 	}
 
@@ -319,3 +673,467 @@ func removeAccount(ctx *cli.Context) error {
 	_, err = client.RemoveAccount(ctxb, req)
 	return err
 }
+
+var accountHistoryCommand = cli.Command{
+	Name:      "history",
+	ShortName: "h",
+	Usage:     "Lists the transaction history of an off-chain account.",
+	ArgsUsage: "id",
+	Description: `
+	Lists the entries of the transaction ledger of an account. Every change
+	to the account balance is recorded in the ledger, such as settled
+	invoices, succeeded or failed payments and updates by the node
+	operator.
+
+	The account can be identified by its ID or its label. The results can
+	be paginated by setting --index_offset to the last_index_offset of the
+	previous response.
+	`,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  "id",
+			Usage: "the ID or label of the account",
+		},
+		cli.Uint64Flag{
+			Name: "index_offset",
+			Usage: "the index of the transaction after which to " +
+				"start listing",
+		},
+		cli.Uint64Flag{
+			Name: "max_transactions",
+			Usage: "the maximum number of transactions to " +
+				"return, 0 means no limit",
+			Value: 100,
+		},
+	},
+	Action: accountHistory,
+}
+
+func accountHistory(ctx *cli.Context) error {
+	ctxb := context.Background()
+	clientConn, cleanup, err := connectClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+	client := litrpc.NewAccountsClient(clientConn)
+
+	var accountID string
+	args := ctx.Args()
+
+	switch {
+	case ctx.IsSet("id"):
+		accountID = ctx.String("id")
+	case args.Present():
+		accountID = args.First()
+	default:
+		return fmt.Errorf("id argument missing")
+	}
+
+	accountID, err = resolveAccountID(ctxb, client, accountID)
+	if err != nil {
+		return err
+	}
+
+	req := &litrpc.ListAccountTransactionsRequest{
+		Id:              accountID,
+		IndexOffset:     ctx.Uint64("index_offset"),
+		MaxTransactions: ctx.Uint64("max_transactions"),
+	}
+	resp, err := client.ListAccountTransactions(ctxb, req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+var transferCommand = cli.Command{
+	Name:      "transfer",
+	ShortName: "t",
+	Usage: "Moves an amount between two off-chain accounts without a " +
+		"network payment.",
+	ArgsUsage: "from_id to_id amount",
+	Description: `
+	Moves the given amount in satoshis from one account to another account
+	of this node. The transfer is instant, doesn't incur any routing fees
+	and doesn't touch the network. The transfer is recorded in the
+	transaction history of both accounts. The accounts can be identified
+	by their ID or their label.
+	`,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name: "from_id",
+			Usage: "the ID or label of the account to move the " +
+				"amount from",
+		},
+		cli.StringFlag{
+			Name: "to_id",
+			Usage: "the ID or label of the account to move the " +
+				"amount to",
+		},
+		cli.Uint64Flag{
+			Name:  "amount",
+			Usage: "the amount in satoshis to move",
+		},
+	},
+	Action: transfer,
+}
+
+func transfer(ctx *cli.Context) error {
+	ctxb := context.Background()
+	clientConn, cleanup, err := connectClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+	client := litrpc.NewAccountsClient(clientConn)
+
+	var (
+		fromID, toID string
+		amount       uint64
+	)
+	args := ctx.Args()
+
+	switch {
+	case ctx.IsSet("from_id"):
+		fromID = ctx.String("from_id")
+	case args.Present():
+		fromID = args.First()
+		args = args.Tail()
+	default:
+		return fmt.Errorf("from_id argument missing")
+	}
+
+	switch {
+	case ctx.IsSet("to_id"):
+		toID = ctx.String("to_id")
+	case args.Present():
+		toID = args.First()
+		args = args.Tail()
+	default:
+		return fmt.Errorf("to_id argument missing")
+	}
+
+	switch {
+	case ctx.IsSet("amount"):
+		amount = ctx.Uint64("amount")
+	case args.Present():
+		amount, err = strconv.ParseUint(args.First(), 10, 64)
+		if err != nil {
+			return fmt.Errorf("unable to decode amount %v", err)
+		}
+	default:
+		return fmt.Errorf("amount argument missing")
+	}
+
+	fromID, err = resolveAccountID(ctxb, client, fromID)
+	if err != nil {
+		return err
+	}
+
+	toID, err = resolveAccountID(ctxb, client, toID)
+	if err != nil {
+		return err
+	}
+
+	req := &litrpc.TransferBetweenAccountsRequest{
+		FromId: fromID,
+		ToId:   toID,
+		Amount: amount,
+	}
+	resp, err := client.TransferBetweenAccounts(ctxb, req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+var subscribeAccountsCommand = cli.Command{
+	Name:      "subscribe",
+	ShortName: "s",
+	Usage:     "Streams updates of off-chain accounts.",
+	ArgsUsage: "[id...]",
+	Description: `
+	Prints an update every time an account changes, for example because
+	its balance changed, one of its payments changed state, it expired or
+	it was removed. If one or more accounts are given, only updates of
+	those accounts are printed. The accounts can be identified by their ID
+	or their label.
+	`,
+	Action: subscribeAccounts,
+}
+
+func subscribeAccounts(ctx *cli.Context) error {
+	ctxb := context.Background()
+	clientConn, cleanup, err := connectClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+	client := litrpc.NewAccountsClient(clientConn)
+
+	accountIDs := make([]string, 0, ctx.NArg())
+	for _, idOrLabel := range ctx.Args() {
+		accountID, err := resolveAccountID(ctxb, client, idOrLabel)
+		if err != nil {
+			return err
+		}
+
+		accountIDs = append(accountIDs, accountID)
+	}
+
+	req := &litrpc.SubscribeAccountUpdatesRequest{
+		AccountIds: accountIDs,
+	}
+	stream, err := client.SubscribeAccountUpdates(ctxb, req)
+	if err != nil {
+		return err
+	}
+
+	for {
+		update, err := stream.Recv()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		printRespJSON(update)
+	}
+}
+
+var expiryActionsCommand = cli.Command{
+	Name:      "expiryactions",
+	ShortName: "e",
+	Usage:     "Lists the actions taken on expired off-chain accounts.",
+	Description: `
+	Lists the audit log of actions that were taken on expired accounts
+	according to the configured --accounts.expiry-action, such as sweeping
+	their remaining balance to the treasury account or removing them.
+
+	The results can be paginated by setting --index_offset to the
+	last_index_offset of the previous response.
+	`,
+	Flags: []cli.Flag{
+		cli.Uint64Flag{
+			Name: "index_offset",
+			Usage: "the index of the action after which to start " +
+				"listing",
+		},
+		cli.Uint64Flag{
+			Name: "max_actions",
+			Usage: "the maximum number of actions to return, 0 " +
+				"means no limit",
+			Value: 100,
+		},
+	},
+	Action: expiryActions,
+}
+
+func expiryActions(ctx *cli.Context) error {
+	ctxb := context.Background()
+	clientConn, cleanup, err := connectClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+	client := litrpc.NewAccountsClient(clientConn)
+
+	req := &litrpc.ListExpiryActionsRequest{
+		IndexOffset: ctx.Uint64("index_offset"),
+		MaxActions:  ctx.Uint64("max_actions"),
+	}
+	resp, err := client.ListExpiryActions(ctxb, req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+var exportAccountsCommand = cli.Command{
+	Name:      "export",
+	ShortName: "x",
+	Usage:     "Export off-chain accounts to a file.",
+	ArgsUsage: "--output=file [id...]",
+	Description: `
+	Exports the given accounts, including their invoice and payment
+	associations and their transaction ledgers, to a versioned file that
+	can be imported into another litd instance with 'litcli accounts
+	import'. The accounts can be identified by their ID or their label. All
+	accounts are exported if no account is given.
+
+	The file contains a checksum that detects accidental corruption, but
+	it is not protected against deliberate modification and should be
+	stored and transferred securely.
+
+	Accounts with payments that are still in flight cannot be exported.
+	The exported accounts are not removed from this node, they should be
+	removed manually once the import was successful.
+	`,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  "output",
+			Usage: "the file to write the exported accounts to",
+		},
+	},
+	Action: exportAccounts,
+}
+
+func exportAccounts(ctx *cli.Context) error {
+	ctxb := context.Background()
+	clientConn, cleanup, err := connectClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+	client := litrpc.NewAccountsClient(clientConn)
+
+	if !ctx.IsSet("output") {
+		return fmt.Errorf("output file must be set")
+	}
+
+	accountIDs := make([]string, 0, ctx.NArg())
+	for _, idOrLabel := range ctx.Args() {
+		accountID, err := resolveAccountID(ctxb, client, idOrLabel)
+		if err != nil {
+			return err
+		}
+
+		accountIDs = append(accountIDs, accountID)
+	}
+
+	req := &litrpc.ExportAccountsRequest{
+		AccountIds: accountIDs,
+	}
+	resp, err := client.ExportAccounts(ctxb, req)
+	if err != nil {
+		return err
+	}
+
+	fileName := lncfg.CleanAndExpandPath(ctx.String("output"))
+	if err := os.WriteFile(fileName, resp.Export, 0600); err != nil {
+		return fmt.Errorf("error writing account export to %s: %v",
+			fileName, err)
+	}
+
+	fmt.Printf("Exported %d account(s) to %s\n", resp.NumAccounts,
+		fileName)
+
+	return nil
+}
+
+var importAccountsCommand = cli.Command{
+	Name:      "import",
+	ShortName: "i",
+	Usage:     "Import off-chain accounts from a file.",
+	ArgsUsage: "--input=file [--on_collision=] [--save_to_dir=]",
+	Description: `
+	Imports the accounts of a file created with 'litcli accounts export'.
+	A new macaroon is baked for every imported account, the macaroons of
+	the node the accounts were exported from are not valid on this node.
+
+	The --on_collision flag defines how imported accounts with the ID of an
+	existing account are handled: 'fail' aborts the import, 'skip' skips
+	these accounts and 'new_id' imports them with a new random ID.
+	`,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  "input",
+			Usage: "the file to read the exported accounts from",
+		},
+		cli.StringFlag{
+			Name: "on_collision",
+			Usage: "how to handle accounts with an existing ID, " +
+				"either 'fail', 'skip' or 'new_id'",
+			Value: "fail",
+		},
+		cli.StringFlag{
+			Name: "save_to_dir",
+			Usage: "store the macaroons baked for the imported " +
+				"accounts as <id>.macaroon in the given " +
+				"directory",
+		},
+	},
+	Action: importAccounts,
+}
+
+func importAccounts(ctx *cli.Context) error {
+	ctxb := context.Background()
+	clientConn, cleanup, err := connectClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+	client := litrpc.NewAccountsClient(clientConn)
+
+	if !ctx.IsSet("input") {
+		return fmt.Errorf("input file must be set")
+	}
+
+	var policy litrpc.ImportCollisionPolicy
+	switch ctx.String("on_collision") {
+	case "fail":
+		policy = litrpc.ImportCollisionPolicy_IMPORT_FAIL
+
+	case "skip":
+		policy = litrpc.ImportCollisionPolicy_IMPORT_SKIP
+
+	case "new_id":
+		policy = litrpc.ImportCollisionPolicy_IMPORT_NEW_ID
+
+	default:
+		return fmt.Errorf("unknown collision policy %q",
+			ctx.String("on_collision"))
+	}
+
+	fileName := lncfg.CleanAndExpandPath(ctx.String("input"))
+	export, err := os.ReadFile(fileName)
+	if err != nil {
+		return fmt.Errorf("error reading account export from %s: %v",
+			fileName, err)
+	}
+
+	req := &litrpc.ImportAccountsRequest{
+		Export:          export,
+		CollisionPolicy: policy,
+	}
+	resp, err := client.ImportAccounts(ctxb, req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+
+	// User requested to store the newly baked account macaroons to files
+	// in addition to printing them to the console.
+	if !ctx.IsSet("save_to_dir") {
+		return nil
+	}
+
+	dir := lncfg.CleanAndExpandPath(ctx.String("save_to_dir"))
+	for _, imported := range resp.Accounts {
+		if imported.Skipped {
+			continue
+		}
+
+		macFile := filepath.Join(
+			dir, fmt.Sprintf("%s.macaroon", imported.Account.Id),
+		)
+		err := os.WriteFile(macFile, imported.Macaroon, 0644)
+		if err != nil {
+			return fmt.Errorf("error writing account macaroon "+
+				"to %s: %v", macFile, err)
+		}
+
+		fmt.Printf("Account macaroon saved to %s\n", macFile)
+	}
+
+	return nil
+}
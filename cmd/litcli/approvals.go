@@ -0,0 +1,129 @@
+package main
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/lightninglabs/lightning-terminal/litrpc"
+	"github.com/urfave/cli"
+)
+
+var approvalsCommands = cli.Command{
+	Name:     "approvals",
+	Usage:    "manage requests that are waiting for an approval",
+	Category: "Autopilot",
+	Subcommands: []cli.Command{
+		listPendingApprovalsCmd,
+		resolveApprovalCmd,
+	},
+}
+
+var listPendingApprovalsCmd = cli.Command{
+	Name:      "list",
+	ShortName: "l",
+	Usage:     "List the requests that are waiting for an approval.",
+	Description: `
+	List the requests of autopilot sessions that were parked by an
+	approval-required rule and are waiting for an approval.
+	`,
+	Action: listPendingApprovals,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name: "session_id",
+			Usage: "The session ID to filter the requests by. If " +
+				"left empty, then the requests of all " +
+				"sessions will be returned.",
+		},
+	},
+}
+
+func listPendingApprovals(ctx *cli.Context) error {
+	ctxb := context.Background()
+	clientConn, cleanup, err := connectClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+	client := litrpc.NewFirewallClient(clientConn)
+
+	var sessionID []byte
+	if ctx.String("session_id") != "" {
+		sessionID, err = hex.DecodeString(ctx.String("session_id"))
+		if err != nil {
+			return err
+		}
+	}
+
+	resp, err := client.ListPendingApprovals(
+		ctxb, &litrpc.ListPendingApprovalsRequest{
+			SessionId: sessionID,
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+
+	return nil
+}
+
+var resolveApprovalCmd = cli.Command{
+	Name:      "resolve",
+	ShortName: "r",
+	Usage:     "Approve or deny a request.",
+	Description: `
+	Approve or deny a request that is waiting for an approval. An approved
+	request is let through once the autopilot retries it, a denied one is
+	rejected.
+	`,
+	Action: resolveApproval,
+	Flags: []cli.Flag{
+		cli.Uint64Flag{
+			Name: "id",
+			Usage: "The ID of the approval to resolve. It is " +
+				"also the retry token that the request was " +
+				"rejected with.",
+			Required: true,
+		},
+		cli.BoolFlag{
+			Name:  "approve",
+			Usage: "Approve the request.",
+		},
+		cli.BoolFlag{
+			Name:  "deny",
+			Usage: "Deny the request.",
+		},
+	},
+}
+
+func resolveApproval(ctx *cli.Context) error {
+	ctxb := context.Background()
+	clientConn, cleanup, err := connectClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+	client := litrpc.NewFirewallClient(clientConn)
+
+	approve, deny := ctx.Bool("approve"), ctx.Bool("deny")
+	if approve == deny {
+		return fmt.Errorf("exactly one of --approve and --deny must " +
+			"be set")
+	}
+
+	resp, err := client.ResolveApproval(
+		ctxb, &litrpc.ResolveApprovalRequest{
+			Id:      ctx.Uint64("id"),
+			Approve: approve,
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+
+	return nil
+}
@@ -3,6 +3,7 @@ package main
 import (
 	"context"
 	"encoding/hex"
+	"fmt"
 	"strconv"
 	"strings"
 	"time"
@@ -47,6 +48,7 @@ var addAutopilotSessionCmd = cli.Command{
 		expiryFlag,
 		mailboxServerAddrFlag,
 		devserver,
+		idleTimeoutFlag,
 		cli.StringSliceFlag{
 			Name:     "feature",
 			Required: true,
@@ -65,6 +67,124 @@ var addAutopilotSessionCmd = cli.Command{
 				"perform actions on. In the " +
 				"form of: peerID1,peerID2,...",
 		},
+		cli.Uint64Flag{
+			Name: "off-chain-budget-msat",
+			Usage: "the maximum amount in msat that the " +
+				"Autopilot server can send off-chain, " +
+				"excluding fees",
+		},
+		cli.Uint64Flag{
+			Name: "off-chain-fees-msat",
+			Usage: "the maximum amount in msat that the " +
+				"Autopilot server can spend on the fees " +
+				"of off-chain payments. Only used if " +
+				"off-chain-budget-msat is set",
+		},
+		cli.DurationFlag{
+			Name: "off-chain-budget-window",
+			Usage: "the rolling window that the off-chain " +
+				"budget applies to, for example 24h. If " +
+				"not set, the budget applies to the " +
+				"whole lifetime of the session",
+		},
+		cli.Uint64Flag{
+			Name: "on-chain-budget-sats",
+			Usage: "the maximum amount in sats that the " +
+				"Autopilot server can spend on-chain " +
+				"over the lifetime of the session, " +
+				"including fees",
+		},
+		cli.Uint64Flag{
+			Name: "on-chain-period-budget-sats",
+			Usage: "the maximum amount in sats that the " +
+				"Autopilot server can spend on-chain " +
+				"within each on-chain-budget-period. " +
+				"Only used if on-chain-budget-sats is set",
+		},
+		cli.DurationFlag{
+			Name: "on-chain-budget-period",
+			Usage: "the rolling period that the " +
+				"on-chain-period-budget-sats applies " +
+				"to, for example 24h",
+		},
+		cli.Uint64Flag{
+			Name: "on-chain-max-sat-per-vbyte",
+			Usage: "the maximum fee rate in sat/vByte of " +
+				"on-chain transactions. Only used if " +
+				"on-chain-budget-sats is set",
+		},
+		cli.Uint64Flag{
+			Name: "channel-open-min-sats",
+			Usage: "the minimum capacity in sats of channels " +
+				"that the Autopilot server can open",
+		},
+		cli.Uint64Flag{
+			Name: "channel-open-max-sats",
+			Usage: "the maximum capacity in sats of channels " +
+				"that the Autopilot server can open",
+		},
+		cli.UintFlag{
+			Name: "channel-open-max-pending",
+			Usage: "the maximum number of channels that can " +
+				"be pending open at the same time",
+		},
+		cli.StringFlag{
+			Name: "channel-open-allow-list",
+			Usage: "list of peer IDs that the Autopilot " +
+				"server can open channels with. In the " +
+				"form of: peerID1,peerID2,...",
+		},
+		cli.StringFlag{
+			Name: "channel-open-deny-list",
+			Usage: "list of peer IDs that the Autopilot " +
+				"server can not open channels with. In " +
+				"the form of: peerID1,peerID2,...",
+		},
+		cli.BoolFlag{
+			Name: "channel-open-allow-push",
+			Usage: "allow the Autopilot server to push an " +
+				"amount to the peer when opening a channel",
+		},
+		cli.StringFlag{
+			Name: "channel-open-visibility",
+			Usage: "whether the channels opened by the " +
+				"Autopilot server must be 'private' or " +
+				"'public'",
+		},
+		cli.StringSliceFlag{
+			Name: "time-window-read",
+			Usage: "a time window within which the Autopilot " +
+				"server can make read-only calls, in the " +
+				"form of HH:MM-HH:MM[@weekdays], for " +
+				"example 08:00-18:00@mon-fri or " +
+				"22:00-02:00@sat,sun. Can be specified " +
+				"multiple times",
+		},
+		cli.StringSliceFlag{
+			Name: "time-window-write",
+			Usage: "a time window within which the Autopilot " +
+				"server can make write calls, in the same " +
+				"form as time-window-read. Can be " +
+				"specified multiple times",
+		},
+		cli.StringFlag{
+			Name: "time-window-timezone",
+			Usage: "the IANA name of the timezone that the " +
+				"time windows refer to, for example " +
+				"Europe/Zurich. Defaults to UTC",
+		},
+		cli.UintFlag{
+			Name: "approval-fee-rate-threshold-ppm",
+			Usage: "the fee rate in ppm above which channel " +
+				"policy updates of the Autopilot server " +
+				"must be approved with 'litcli approvals'",
+		},
+		cli.Uint64Flag{
+			Name: "approval-payment-threshold-sats",
+			Usage: "the amount in sats above which payments of " +
+				"the Autopilot server must be approved with " +
+				"'litcli approvals'",
+		},
 	},
 }
 
@@ -216,6 +336,103 @@ func initAutopilotSession(ctx *cli.Context) error {
 		}
 	}
 
+	if ctx.IsSet("off-chain-budget-msat") {
+		window := ctx.Duration("off-chain-budget-window")
+
+		ruleMap.Rules[rules.OffChainBudgetName] = &litrpc.RuleValue{
+			Value: &litrpc.RuleValue_OffChainBudget{
+				OffChainBudget: &litrpc.OffChainBudget{
+					MaxAmtMsat: ctx.Uint64(
+						"off-chain-budget-msat",
+					),
+					MaxFeesMsat: ctx.Uint64(
+						"off-chain-fees-msat",
+					),
+					WindowSeconds: uint64(window.Seconds()),
+				},
+			},
+		}
+	}
+
+	if ctx.IsSet("on-chain-budget-sats") {
+		period := ctx.Duration("on-chain-budget-period")
+
+		ruleMap.Rules[rules.OnChainBudgetName] = &litrpc.RuleValue{
+			Value: &litrpc.RuleValue_OnChainBudget{
+				OnChainBudget: &litrpc.OnChainBudget{
+					AbsoluteAmtSats: ctx.Uint64(
+						"on-chain-budget-sats",
+					),
+					PeriodAmtSats: ctx.Uint64(
+						"on-chain-period-budget-sats",
+					),
+					PeriodSeconds: uint64(period.Seconds()),
+					MaxSatPerVByte: ctx.Uint64(
+						"on-chain-max-sat-per-vbyte",
+					),
+				},
+			},
+		}
+	}
+
+	channelOpenPolicy, err := parseChannelOpenPolicy(ctx)
+	if err != nil {
+		return err
+	}
+	if channelOpenPolicy != nil {
+		ruleMap.Rules[rules.ChannelOpenPolicyName] = &litrpc.RuleValue{
+			Value: &litrpc.RuleValue_ChannelOpenPolicy{
+				ChannelOpenPolicy: channelOpenPolicy,
+			},
+		}
+	}
+
+	if ctx.IsSet("time-window-read") || ctx.IsSet("time-window-write") {
+		readWindows, err := parseWeekdayWindows(
+			ctx.StringSlice("time-window-read"),
+		)
+		if err != nil {
+			return err
+		}
+
+		writeWindows, err := parseWeekdayWindows(
+			ctx.StringSlice("time-window-write"),
+		)
+		if err != nil {
+			return err
+		}
+
+		ruleMap.Rules[rules.TimeWindowName] = &litrpc.RuleValue{
+			Value: &litrpc.RuleValue_TimeWindow{
+				TimeWindow: &litrpc.TimeWindow{
+					ReadWindows:  readWindows,
+					WriteWindows: writeWindows,
+					Timezone: ctx.String(
+						"time-window-timezone",
+					),
+				},
+			},
+		}
+	}
+
+	if ctx.IsSet("approval-fee-rate-threshold-ppm") ||
+		ctx.IsSet("approval-payment-threshold-sats") {
+
+		var (
+			feeRate = ctx.Uint("approval-fee-rate-threshold-ppm")
+			amt     = ctx.Uint64("approval-payment-threshold-sats")
+		)
+
+		ruleMap.Rules[rules.ApprovalRequiredName] = &litrpc.RuleValue{
+			Value: &litrpc.RuleValue_ApprovalRequired{
+				ApprovalRequired: &litrpc.ApprovalRequired{
+					FeeRateThresholdPpm:  uint32(feeRate),
+					PaymentThresholdSats: amt,
+				},
+			},
+		}
+	}
+
 	featureMap := make(map[string]*litrpc.FeatureConfig)
 	for _, feature := range ctx.StringSlice("feature") {
 		featureMap[feature] = &litrpc.FeatureConfig{
@@ -231,6 +448,7 @@ func initAutopilotSession(ctx *cli.Context) error {
 			MailboxServerAddr:      ctx.String("mailboxserveraddr"),
 			DevServer:              ctx.Bool("devserver"),
 			Features:               featureMap,
+			IdleTimeoutSeconds:     ctx.Uint64("idletimeout"),
 		},
 	)
 	if err != nil {
@@ -241,3 +459,121 @@ func initAutopilotSession(ctx *cli.Context) error {
 
 	return nil
 }
+
+// parseChannelOpenPolicy parses the channel open policy flags into a
+// ChannelOpenPolicy rule value. It returns nil if none of the flags are set.
+func parseChannelOpenPolicy(ctx *cli.Context) (*litrpc.ChannelOpenPolicy,
+	error) {
+
+	flags := []string{
+		"channel-open-min-sats", "channel-open-max-sats",
+		"channel-open-max-pending", "channel-open-allow-list",
+		"channel-open-deny-list", "channel-open-allow-push",
+		"channel-open-visibility",
+	}
+
+	var isSet bool
+	for _, flag := range flags {
+		isSet = isSet || ctx.IsSet(flag)
+	}
+	if !isSet {
+		return nil, nil
+	}
+
+	policy := &litrpc.ChannelOpenPolicy{
+		MinCapacitySats: ctx.Uint64("channel-open-min-sats"),
+		MaxCapacitySats: ctx.Uint64("channel-open-max-sats"),
+		MaxPendingOpens: uint32(ctx.Uint("channel-open-max-pending")),
+		AllowPushAmt:    ctx.Bool("channel-open-allow-push"),
+	}
+
+	if allowList := ctx.String("channel-open-allow-list"); allowList != "" {
+		policy.AllowedPeerIds = strings.Split(allowList, ",")
+	}
+
+	if denyList := ctx.String("channel-open-deny-list"); denyList != "" {
+		policy.DeniedPeerIds = strings.Split(denyList, ",")
+	}
+
+	switch ctx.String("channel-open-visibility") {
+	// If no visibility is given, channels can be both private and public.
+	case "":
+
+	case "private":
+		policy.Visibility =
+			litrpc.ChannelVisibility_CHANNEL_VISIBILITY_PRIVATE
+
+	case "public":
+		policy.Visibility =
+			litrpc.ChannelVisibility_CHANNEL_VISIBILITY_PUBLIC
+
+	default:
+		return nil, fmt.Errorf("unknown channel visibility %q, "+
+			"expected 'private' or 'public'",
+			ctx.String("channel-open-visibility"))
+	}
+
+	return policy, nil
+}
+
+// weekdays maps the abbreviated names of the weekdays to their number, where 0
+// is Sunday.
+var weekdays = map[string]uint32{
+	"sun": 0, "mon": 1, "tue": 2, "wed": 3, "thu": 4, "fri": 5, "sat": 6,
+}
+
+// parseWeekdayWindows parses time windows in the form of
+// HH:MM-HH:MM[@weekdays], where weekdays is a comma separated list of
+// abbreviated weekday names or ranges of them, for example mon-fri.
+func parseWeekdayWindows(windows []string) ([]*litrpc.WeekdayWindow, error) {
+	parsed := make([]*litrpc.WeekdayWindow, 0, len(windows))
+	for _, window := range windows {
+		times, days, hasDays := strings.Cut(window, "@")
+		start, end, ok := strings.Cut(times, "-")
+		if !ok {
+			return nil, fmt.Errorf("invalid time window %s, "+
+				"expected HH:MM-HH:MM[@weekdays]", window)
+		}
+
+		w := &litrpc.WeekdayWindow{
+			Start: start,
+			End:   end,
+		}
+		if !hasDays {
+			parsed = append(parsed, w)
+			continue
+		}
+
+		for _, dayRange := range strings.Split(days, ",") {
+			first, last, isRange := strings.Cut(dayRange, "-")
+			if !isRange {
+				last = first
+			}
+
+			from, ok := weekdays[strings.ToLower(first)]
+			if !ok {
+				return nil, fmt.Errorf("invalid weekday %s in "+
+					"time window %s", first, window)
+			}
+
+			to, ok := weekdays[strings.ToLower(last)]
+			if !ok {
+				return nil, fmt.Errorf("invalid weekday %s in "+
+					"time window %s", last, window)
+			}
+
+			// A range such as fri-mon wraps around the end of the
+			// week.
+			for day := from; ; day = (day + 1) % 7 {
+				w.Weekdays = append(w.Weekdays, day)
+				if day == to {
+					break
+				}
+			}
+		}
+
+		parsed = append(parsed, w)
+	}
+
+	return parsed, nil
+}
@@ -74,6 +74,7 @@ func main() {
 	app.Commands = append(app.Commands, accountsCommands...)
 	app.Commands = append(app.Commands, listActionsCommand)
 	app.Commands = append(app.Commands, privacyMapCommands)
+	app.Commands = append(app.Commands, approvalsCommands)
 	app.Commands = append(app.Commands, autopilotCommands)
 	app.Commands = append(app.Commands, litCommands...)
 	app.Commands = append(app.Commands, helperCommands)
@@ -33,7 +33,7 @@ var litCommands = []cli.Command{
 			"permissions.",
 		Category: "LiT",
 		Action:   bakeSuperMacaroon,
-		Flags: []cli.Flag{
+		Flags: append([]cli.Flag{
 			cli.StringFlag{
 				Name: "root_key_suffix",
 				Usage: "A 4-byte suffix to use in the " +
@@ -48,7 +48,7 @@ var litCommands = []cli.Command{
 				Usage: "save returned admin macaroon to " +
 					"this file",
 			},
-		},
+		}, restrictionFlags...),
 	},
 }
 
@@ -109,6 +109,11 @@ func bakeSuperMacaroon(ctx *cli.Context) error {
 	}
 	suffix := binary.BigEndian.Uint32(suffixBytes[:])
 
+	restrictions, err := parseMacaroonRestrictions(ctx)
+	if err != nil {
+		return err
+	}
+
 	clientConn, cleanup, err := connectClient(ctx)
 	if err != nil {
 		return err
@@ -120,6 +125,7 @@ func bakeSuperMacaroon(ctx *cli.Context) error {
 	resp, err := client.BakeSuperMacaroon(
 		ctxb, &litrpc.BakeSuperMacaroonRequest{
 			RootKeyIdSuffix: suffix,
+			Restrictions:    restrictions,
 		},
 	)
 	if err != nil {
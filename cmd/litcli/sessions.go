@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/hex"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/lightninglabs/lightning-terminal/litrpc"
@@ -37,6 +38,40 @@ var (
 		Usage: "set to true to skip verification of the " +
 			"server's tls cert.",
 	}
+	idleTimeoutFlag = cli.Uint64Flag{
+		Name: "idletimeout",
+		Usage: "number of seconds after which the session is " +
+			"revoked if it is in use but doesn't have any " +
+			"traffic. If not set, the globally configured " +
+			"--session.idle-timeout of litd is used",
+	}
+
+	// restrictionFlags are the flags that add restrictions to a
+	// macaroon.
+	restrictionFlags = []cli.Flag{
+		cli.StringSliceFlag{
+			Name: "time_window",
+			Usage: "A time-of-day window in the format " +
+				"HH:MM-HH:MM[@timezone] in which the " +
+				"macaroon can be used, for example " +
+				"'09:00-17:00@Europe/Zurich'. The timezone " +
+				"defaults to UTC. This flag can be specified " +
+				"multiple times, the macaroon can then be " +
+				"used within any of the windows.",
+		},
+		cli.StringSliceFlag{
+			Name: "allowed_ip",
+			Usage: "An IP address or CIDR range the macaroon " +
+				"can be used from when it is used directly " +
+				"over gRPC. This flag can be specified " +
+				"multiple times.",
+		},
+		cli.Uint64Flag{
+			Name: "max_calls",
+			Usage: "The maximum number of calls that can be " +
+				"made with the macaroon.",
+		},
+	}
 )
 
 var sessionCommands = []cli.Command{
@@ -49,6 +84,11 @@ var sessionCommands = []cli.Command{
 			addSessionCommand,
 			listSessionCommand,
 			revokeSessionCommand,
+			rotateSessionCommand,
+			pauseSessionCommand,
+			resumeSessionCommand,
+			sessionDeviceCommands,
+			sessionTemplateCommands,
 		},
 	},
 }
@@ -59,7 +99,7 @@ var addSessionCommand = cli.Command{
 	Usage:       "create a new Terminal Web session",
 	Description: "Add a new active session.",
 	Action:      addSession,
-	Flags: []cli.Flag{
+	Flags: append([]cli.Flag{
 		labelFlag,
 		expiryFlag,
 		mailboxServerAddrFlag,
@@ -92,7 +132,16 @@ var addSessionCommand = cli.Command{
 				"will only be used if the 'type' flag is " +
 				"set to 'account'.",
 		},
-	},
+		idleTimeoutFlag,
+		cli.StringFlag{
+			Name: "template",
+			Usage: "The name of the session template to create " +
+				"the session from. The type and permissions " +
+				"of the template are used, as well as its " +
+				"expiry and mailbox server address unless " +
+				"the respective flags are set.",
+		},
+	}, restrictionFlags...),
 }
 
 func addSession(ctx *cli.Context) error {
@@ -103,12 +152,60 @@ func addSession(ctx *cli.Context) error {
 	defer cleanup()
 	client := litrpc.NewSessionsClient(clientConn)
 
-	sessTypeStr := ctx.String("type")
-	sessType, err := parseSessionType(sessTypeStr)
+	req := &litrpc.AddSessionRequest{
+		Label:              ctx.String("label"),
+		DevServer:          ctx.Bool("devserver"),
+		AccountId:          ctx.String("account_id"),
+		Template:           ctx.String("template"),
+		IdleTimeoutSeconds: ctx.Uint64("idletimeout"),
+	}
+
+	req.MacaroonRestrictions, err = parseMacaroonRestrictions(ctx)
 	if err != nil {
 		return err
 	}
 
+	// When creating a session from a template, we only overwrite the
+	// expiry and mailbox server of the template if they were set
+	// explicitly.
+	if req.Template == "" || ctx.IsSet("expiry") {
+		sessionLength := time.Second *
+			time.Duration(ctx.Uint64("expiry"))
+		sessionExpiry := time.Now().Add(sessionLength).Unix()
+		req.ExpiryTimestampSeconds = uint64(sessionExpiry)
+	}
+	if req.Template == "" || ctx.IsSet("mailboxserveraddr") {
+		req.MailboxServerAddr = ctx.String("mailboxserveraddr")
+	}
+
+	if req.Template != "" {
+		if ctx.IsSet("type") || ctx.IsSet("uri") {
+			return fmt.Errorf("the type and uri flags cannot be " +
+				"used together with a template")
+		}
+	} else {
+		sessionType, err := parseSessionType(ctx.String("type"))
+		if err != nil {
+			return err
+		}
+		req.SessionType = &sessionType
+		req.MacaroonCustomPermissions = parseURIPermissions(ctx)
+	}
+
+	ctxb := context.Background()
+	resp, err := client.AddSession(ctxb, req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+
+	return nil
+}
+
+// parseURIPermissions turns the values of the uri flag into custom macaroon
+// permissions.
+func parseURIPermissions(ctx *cli.Context) []*litrpc.MacaroonPermission {
 	var macPerms []*litrpc.MacaroonPermission
 	for _, uri := range ctx.StringSlice("uri") {
 		macPerms = append(macPerms, &litrpc.MacaroonPermission{
@@ -117,28 +214,42 @@ func addSession(ctx *cli.Context) error {
 		})
 	}
 
-	sessionLength := time.Second * time.Duration(ctx.Uint64("expiry"))
-	sessionExpiry := time.Now().Add(sessionLength).Unix()
+	return macPerms
+}
 
-	ctxb := context.Background()
-	resp, err := client.AddSession(
-		ctxb, &litrpc.AddSessionRequest{
-			Label:                     ctx.String("label"),
-			SessionType:               sessType,
-			ExpiryTimestampSeconds:    uint64(sessionExpiry),
-			MailboxServerAddr:         ctx.String("mailboxserveraddr"),
-			DevServer:                 ctx.Bool("devserver"),
-			MacaroonCustomPermissions: macPerms,
-			AccountId:                 ctx.String("account_id"),
-		},
-	)
-	if err != nil {
-		return err
+// parseMacaroonRestrictions turns the values of the restriction flags into
+// macaroon restrictions. If none of the flags is set, nil is returned.
+func parseMacaroonRestrictions(ctx *cli.Context) (*litrpc.MacaroonRestrictions,
+	error) {
+
+	if !ctx.IsSet("time_window") && !ctx.IsSet("allowed_ip") &&
+		!ctx.IsSet("max_calls") {
+
+		return nil, nil
 	}
 
-	printRespJSON(resp)
+	restrictions := &litrpc.MacaroonRestrictions{
+		AllowedIps: ctx.StringSlice("allowed_ip"),
+		MaxCalls:   ctx.Uint64("max_calls"),
+	}
+	for _, window := range ctx.StringSlice("time_window") {
+		times, timezone, _ := strings.Cut(window, "@")
+		start, end, ok := strings.Cut(times, "-")
+		if !ok {
+			return nil, fmt.Errorf("invalid time window %s, "+
+				"expected HH:MM-HH:MM[@timezone]", window)
+		}
 
-	return nil
+		restrictions.TimeWindows = append(
+			restrictions.TimeWindows, &litrpc.TimeOfDayWindow{
+				Start:    start,
+				End:      end,
+				Timezone: timezone,
+			},
+		)
+	}
+
+	return restrictions, nil
 }
 
 func parseSessionType(sessionType string) (litrpc.SessionType, error) {
@@ -167,6 +278,40 @@ var listSessionCommand = cli.Command{
 		listInUseSessions,
 		listExpiredSessions,
 		listCreatedSessions,
+		listPausedSessions,
+	},
+}
+
+var listSessionFlags = []cli.Flag{
+	cli.StringSliceFlag{
+		Name: "type",
+		Usage: "only list sessions of the given type. Options " +
+			"include readonly|admin|account|custom. Can be " +
+			"specified multiple times",
+	},
+	cli.StringFlag{
+		Name: "label",
+		Usage: "only list sessions that contain the given string " +
+			"in their label, ignoring the case",
+	},
+	cli.Uint64Flag{
+		Name: "created_after",
+		Usage: "only list sessions that were created at or after " +
+			"the given unix timestamp",
+	},
+	cli.Uint64Flag{
+		Name: "created_before",
+		Usage: "only list sessions that were created before the " +
+			"given unix timestamp",
+	},
+	cli.Uint64Flag{
+		Name:  "offset",
+		Usage: "the number of matching sessions to skip",
+	},
+	cli.Uint64Flag{
+		Name: "limit",
+		Usage: "the maximum number of sessions to list, 0 lists " +
+			"all matching sessions",
 	},
 }
 
@@ -175,7 +320,8 @@ var listAllSessionsCommand = cli.Command{
 	ShortName:   "a",
 	Usage:       "list all Terminal Web sessions",
 	Description: "List all sessions.",
-	Action:      listSessions(sessionFilterAll),
+	Flags:       listSessionFlags,
+	Action:      listSessions(),
 }
 
 var listRevokedSessions = cli.Command{
@@ -183,7 +329,8 @@ var listRevokedSessions = cli.Command{
 	ShortName:   "r",
 	Usage:       "list revoked Terminal Web sessions",
 	Description: "List revoked sessions.",
-	Action:      listSessions(sessionFilterRevoked),
+	Flags:       listSessionFlags,
+	Action:      listSessions(litrpc.SessionState_STATE_REVOKED),
 }
 
 var listInUseSessions = cli.Command{
@@ -191,7 +338,8 @@ var listInUseSessions = cli.Command{
 	ShortName:   "u",
 	Usage:       "list in-use Terminal Web sessions",
 	Description: "List in-use sessions.",
-	Action:      listSessions(sessionFilterInUse),
+	Flags:       listSessionFlags,
+	Action:      listSessions(litrpc.SessionState_STATE_IN_USE),
 }
 
 var listExpiredSessions = cli.Command{
@@ -199,7 +347,8 @@ var listExpiredSessions = cli.Command{
 	ShortName:   "e",
 	Usage:       "list expired Terminal Web sessions",
 	Description: "List expired sessions.",
-	Action:      listSessions(sessionFilterExpired),
+	Flags:       listSessionFlags,
+	Action:      listSessions(litrpc.SessionState_STATE_EXPIRED),
 }
 
 var listCreatedSessions = cli.Command{
@@ -207,27 +356,24 @@ var listCreatedSessions = cli.Command{
 	ShortName:   "c",
 	Usage:       "list created Terminal Web sessions",
 	Description: "List created sessions.",
-	Action:      listSessions(sessionFilterCreated),
+	Flags:       listSessionFlags,
+	Action:      listSessions(litrpc.SessionState_STATE_CREATED),
 }
 
-type sessionFilter uint32
-
-const (
-	sessionFilterAll sessionFilter = iota
-	sessionFilterExpired
-	sessionFilterInUse
-	sessionFilterRevoked
-	sessionFilterCreated
-)
-
-var sessionStateMap = map[litrpc.SessionState]sessionFilter{
-	litrpc.SessionState_STATE_CREATED: sessionFilterCreated,
-	litrpc.SessionState_STATE_EXPIRED: sessionFilterExpired,
-	litrpc.SessionState_STATE_IN_USE:  sessionFilterInUse,
-	litrpc.SessionState_STATE_REVOKED: sessionFilterRevoked,
+var listPausedSessions = cli.Command{
+	Name:        "paused",
+	ShortName:   "p",
+	Usage:       "list paused Terminal Web sessions",
+	Description: "List paused sessions.",
+	Flags:       listSessionFlags,
+	Action:      listSessions(litrpc.SessionState_STATE_PAUSED),
 }
 
-func listSessions(filter sessionFilter) func(ctx *cli.Context) error {
+// listSessions returns a command action that lists the sessions in the given
+// states, or all sessions if no state is given, that match the list flags.
+func listSessions(
+	states ...litrpc.SessionState) func(ctx *cli.Context) error {
+
 	return func(ctx *cli.Context) error {
 		clientConn, cleanup, err := connectClient(ctx)
 		if err != nil {
@@ -236,29 +382,29 @@ func listSessions(filter sessionFilter) func(ctx *cli.Context) error {
 		defer cleanup()
 		client := litrpc.NewSessionsClient(clientConn)
 
-		ctxb := context.Background()
-		resp, err := client.ListSessions(
-			ctxb, &litrpc.ListSessionsRequest{},
-		)
-		if err != nil {
-			return err
-		}
-
-		if filter == sessionFilterAll {
-			printRespJSON(resp)
-			return nil
+		req := &litrpc.ListSessionsRequest{
+			States:        states,
+			LabelContains: ctx.String("label"),
+			CreatedAfter:  ctx.Uint64("created_after"),
+			CreatedBefore: ctx.Uint64("created_before"),
+			Offset:        ctx.Uint64("offset"),
+			Limit:         ctx.Uint64("limit"),
 		}
-
-		var sessions []*litrpc.Session
-		for _, session := range resp.Sessions {
-			if sessionStateMap[session.SessionState] != filter {
-				continue
+		for _, t := range ctx.StringSlice("type") {
+			sessionType, err := parseSessionType(t)
+			if err != nil {
+				return err
 			}
+			req.Types = append(req.Types, sessionType)
+		}
 
-			sessions = append(sessions, session)
+		ctxb := context.Background()
+		resp, err := client.ListSessions(ctxb, req)
+		if err != nil {
+			return err
 		}
 
-		printRespJSON(&litrpc.ListSessionsResponse{Sessions: sessions})
+		printRespJSON(resp)
 		return nil
 	}
 }
@@ -305,3 +451,367 @@ func revokeSession(ctx *cli.Context) error {
 
 	return nil
 }
+
+var rotateSessionCommand = cli.Command{
+	Name:      "rotate",
+	ShortName: "ro",
+	Usage:     "rotate the macaroon of a Terminal Web session",
+	Description: "Issue a new macaroon for an active session and extend " +
+		"its expiry. The old macaroon of the session is invalidated " +
+		"but the connected app doesn't need to pair again.",
+	Action: rotateSession,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:     "localpubkey",
+			Usage:    "local pubkey of the session to rotate",
+			Required: true,
+		},
+		expiryFlag,
+	},
+}
+
+func rotateSession(ctx *cli.Context) error {
+	clientConn, cleanup, err := connectClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+	client := litrpc.NewSessionsClient(clientConn)
+
+	pubkey, err := hex.DecodeString(ctx.String("localpubkey"))
+	if err != nil {
+		return err
+	}
+
+	sessionLength := time.Second * time.Duration(ctx.Uint64("expiry"))
+	sessionExpiry := time.Now().Add(sessionLength).Unix()
+
+	ctxb := context.Background()
+	resp, err := client.RotateSession(
+		ctxb, &litrpc.RotateSessionRequest{
+			LocalPublicKey:         pubkey,
+			ExpiryTimestampSeconds: uint64(sessionExpiry),
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+
+	return nil
+}
+
+var pauseSessionCommand = cli.Command{
+	Name:      "pause",
+	ShortName: "p",
+	Usage:     "pause a Terminal Web session",
+	Description: "Pause an active session. The macaroon of a paused " +
+		"session is rejected until the session is resumed.",
+	Action: pauseSession,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:     "localpubkey",
+			Usage:    "local pubkey of the session to pause",
+			Required: true,
+		},
+	},
+}
+
+func pauseSession(ctx *cli.Context) error {
+	clientConn, cleanup, err := connectClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+	client := litrpc.NewSessionsClient(clientConn)
+
+	pubkey, err := hex.DecodeString(ctx.String("localpubkey"))
+	if err != nil {
+		return err
+	}
+
+	ctxb := context.Background()
+	resp, err := client.PauseSession(
+		ctxb, &litrpc.PauseSessionRequest{
+			LocalPublicKey: pubkey,
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+
+	return nil
+}
+
+var resumeSessionCommand = cli.Command{
+	Name:        "resume",
+	ShortName:   "re",
+	Usage:       "resume a paused Terminal Web session",
+	Description: "Resume a paused session",
+	Action:      resumeSession,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:     "localpubkey",
+			Usage:    "local pubkey of the session to resume",
+			Required: true,
+		},
+	},
+}
+
+func resumeSession(ctx *cli.Context) error {
+	clientConn, cleanup, err := connectClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+	client := litrpc.NewSessionsClient(clientConn)
+
+	pubkey, err := hex.DecodeString(ctx.String("localpubkey"))
+	if err != nil {
+		return err
+	}
+
+	ctxb := context.Background()
+	resp, err := client.ResumeSession(
+		ctxb, &litrpc.ResumeSessionRequest{
+			LocalPublicKey: pubkey,
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+
+	return nil
+}
+
+var sessionDeviceCommands = cli.Command{
+	Name:      "devices",
+	ShortName: "d",
+	Usage:     "manage the devices of a Terminal Web session",
+	Description: "Manage additional devices that share the macaroon " +
+		"and rules of a session.",
+	Subcommands: []cli.Command{
+		addSessionDeviceCommand,
+		revokeSessionDeviceCommand,
+	},
+}
+
+var addSessionDeviceCommand = cli.Command{
+	Name:      "add",
+	ShortName: "a",
+	Usage:     "add a device to a Terminal Web session",
+	Description: "Add a device to an existing session. The device is " +
+		"paired with its own pairing phrase and shares the " +
+		"macaroon and rules of the session.",
+	Action: addSessionDevice,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:     "localpubkey",
+			Usage:    "local pubkey of the session",
+			Required: true,
+		},
+		cli.StringFlag{
+			Name:  "label",
+			Usage: "device label",
+		},
+	},
+}
+
+func addSessionDevice(ctx *cli.Context) error {
+	clientConn, cleanup, err := connectClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+	client := litrpc.NewSessionsClient(clientConn)
+
+	pubkey, err := hex.DecodeString(ctx.String("localpubkey"))
+	if err != nil {
+		return err
+	}
+
+	ctxb := context.Background()
+	resp, err := client.AddSessionDevice(
+		ctxb, &litrpc.AddSessionDeviceRequest{
+			LocalPublicKey: pubkey,
+			Label:          ctx.String("label"),
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+
+	return nil
+}
+
+var revokeSessionDeviceCommand = cli.Command{
+	Name:      "revoke",
+	ShortName: "r",
+	Usage:     "revoke a device of a Terminal Web session",
+	Description: "Revoke a single device of a session. The session and " +
+		"its other devices stay usable.",
+	Action: revokeSessionDevice,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:     "localpubkey",
+			Usage:    "local pubkey of the session",
+			Required: true,
+		},
+		cli.StringFlag{
+			Name:     "devicepubkey",
+			Usage:    "local pubkey of the device to revoke",
+			Required: true,
+		},
+	},
+}
+
+func revokeSessionDevice(ctx *cli.Context) error {
+	clientConn, cleanup, err := connectClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+	client := litrpc.NewSessionsClient(clientConn)
+
+	pubkey, err := hex.DecodeString(ctx.String("localpubkey"))
+	if err != nil {
+		return err
+	}
+
+	devicePubkey, err := hex.DecodeString(ctx.String("devicepubkey"))
+	if err != nil {
+		return err
+	}
+
+	ctxb := context.Background()
+	resp, err := client.RevokeSessionDevice(
+		ctxb, &litrpc.RevokeSessionDeviceRequest{
+			LocalPublicKey:  pubkey,
+			DevicePublicKey: devicePubkey,
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+
+	return nil
+}
+
+var sessionTemplateCommands = cli.Command{
+	Name:        "templates",
+	ShortName:   "t",
+	Usage:       "manage session templates",
+	Description: "Manage named templates to create new sessions from.",
+	Subcommands: []cli.Command{
+		addSessionTemplateCommand,
+		listSessionTemplatesCommand,
+	},
+}
+
+var addSessionTemplateCommand = cli.Command{
+	Name:      "add",
+	ShortName: "a",
+	Usage:     "add a session template",
+	Description: "Add a named session template that stores the session " +
+		"type, permissions, expiry and mailbox server to use for new " +
+		"sessions. Sessions can then be created from the template " +
+		"with 'litcli sessions add --template'. An existing template " +
+		"with the same name is replaced.",
+	Action: addSessionTemplate,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:     "name",
+			Usage:    "the unique name of the template",
+			Required: true,
+		},
+		expiryFlag,
+		mailboxServerAddrFlag,
+		devserver,
+		cli.StringFlag{
+			Name: "type",
+			Usage: "type of the sessions created from the " +
+				"template. Options include " +
+				"readonly|admin|account|custom",
+			Value: "readonly",
+		},
+		cli.StringSliceFlag{
+			Name: "uri",
+			Usage: "A URI that should be included in the " +
+				"macaroons of sessions created from a custom " +
+				"template. This flag can be specified " +
+				"multiple times and also accepts a regex, " +
+				"see 'litcli sessions add'.",
+		},
+	},
+}
+
+func addSessionTemplate(ctx *cli.Context) error {
+	clientConn, cleanup, err := connectClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+	client := litrpc.NewSessionsClient(clientConn)
+
+	sessType, err := parseSessionType(ctx.String("type"))
+	if err != nil {
+		return err
+	}
+
+	ctxb := context.Background()
+	resp, err := client.AddSessionTemplate(
+		ctxb, &litrpc.AddSessionTemplateRequest{
+			Name:                      ctx.String("name"),
+			SessionType:               sessType,
+			ExpirySeconds:             ctx.Uint64("expiry"),
+			MailboxServerAddr:         ctx.String("mailboxserveraddr"),
+			DevServer:                 ctx.Bool("devserver"),
+			MacaroonCustomPermissions: parseURIPermissions(ctx),
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+
+	return nil
+}
+
+var listSessionTemplatesCommand = cli.Command{
+	Name:        "list",
+	ShortName:   "l",
+	Usage:       "list session templates",
+	Description: "List all session templates.",
+	Action:      listSessionTemplates,
+}
+
+func listSessionTemplates(ctx *cli.Context) error {
+	clientConn, cleanup, err := connectClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+	client := litrpc.NewSessionsClient(clientConn)
+
+	ctxb := context.Background()
+	resp, err := client.ListSessionTemplates(
+		ctxb, &litrpc.ListSessionTemplatesRequest{},
+	)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+
+	return nil
+}
@@ -17,9 +17,11 @@ import (
 	"github.com/lightninglabs/faraday"
 	"github.com/lightninglabs/faraday/chain"
 	"github.com/lightninglabs/faraday/frdrpcserver"
+	"github.com/lightninglabs/lightning-terminal/accounts"
 	"github.com/lightninglabs/lightning-terminal/autopilotserver"
 	"github.com/lightninglabs/lightning-terminal/firewall"
 	mid "github.com/lightninglabs/lightning-terminal/rpcmiddleware"
+	"github.com/lightninglabs/lightning-terminal/session"
 	"github.com/lightninglabs/lightning-terminal/subservers"
 	"github.com/lightninglabs/lndclient"
 	"github.com/lightninglabs/loop/loopd"
@@ -205,6 +207,10 @@ type Config struct {
 
 	Firewall *firewall.Config `group:"Firewall options" namespace:"firewall"`
 
+	Accounts *accounts.Config `group:"Accounts options" namespace:"accounts"`
+
+	Session *session.Config `group:"Session options" namespace:"session"`
+
 	// faradayRpcConfig is a subset of faraday's full configuration that is
 	// passed into faraday's RPC server.
 	faradayRpcConfig *frdrpcserver.Config
@@ -320,6 +326,8 @@ func defaultConfig() *Config {
 			PingCadence: time.Hour,
 		},
 		Firewall: firewall.DefaultConfig(),
+		Accounts: accounts.DefaultConfig(),
+		Session:  session.DefaultConfig(),
 	}
 }
 
@@ -456,6 +464,14 @@ func loadAndValidateConfig(interceptor signal.Interceptor) (*Config, error) {
 			"at a time")
 	}
 
+	if err := cfg.Accounts.Validate(); err != nil {
+		return nil, err
+	}
+
+	if err := cfg.Session.Validate(); err != nil {
+		return nil, err
+	}
+
 	// Some of the subservers' configuration options won't have any effect
 	// (like the log or lnd options) as they will be taken from lnd's config
 	// struct. Others we want to force to be the same as lnd so the user
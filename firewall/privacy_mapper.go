@@ -8,6 +8,7 @@ import (
 	"math/big"
 	"time"
 
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
 	"github.com/lightninglabs/lightning-terminal/firewalldb"
 	mid "github.com/lightninglabs/lightning-terminal/rpcmiddleware"
 	"github.com/lightninglabs/lightning-terminal/session"
@@ -248,6 +249,20 @@ func (p *PrivacyMapper) checkers(
 			handleUpdatePolicyResponse(db),
 			mid.PassThroughErrorHandler,
 		),
+		"/lnrpc.Lightning/OpenChannelSync": mid.NewFullRewriter(
+			&lnrpc.OpenChannelRequest{},
+			&lnrpc.ChannelPoint{},
+			handleOpenChannelRequest(db),
+			handleOpenChannelResponse(db),
+			mid.PassThroughErrorHandler,
+		),
+		"/lnrpc.Lightning/BatchOpenChannel": mid.NewFullRewriter(
+			&lnrpc.BatchOpenChannelRequest{},
+			&lnrpc.BatchOpenChannelResponse{},
+			handleBatchOpenChannelRequest(db),
+			handleBatchOpenChannelResponse(db),
+			mid.PassThroughErrorHandler,
+		),
 	}
 }
 
@@ -722,6 +737,170 @@ func handleUpdatePolicyResponse(db firewalldb.PrivacyMapDB) func(
 	}
 }
 
+func handleOpenChannelRequest(db firewalldb.PrivacyMapDB) func(
+	ctx context.Context, r *lnrpc.OpenChannelRequest) (proto.Message,
+	error) {
+
+	return func(ctx context.Context, r *lnrpc.OpenChannelRequest) (
+		proto.Message, error) {
+
+		err := db.View(func(tx firewalldb.PrivacyMapTx) error {
+			peer, err := revealPeer(tx, r.NodePubkey)
+			if err != nil {
+				return err
+			}
+			r.NodePubkey = peer
+
+			if r.NodePubkeyString == "" {
+				return nil
+			}
+
+			peerStr, err := firewalldb.RevealString(
+				tx, r.NodePubkeyString,
+			)
+			switch {
+			case err == nil:
+				r.NodePubkeyString = peerStr
+
+			case !errors.Is(err, firewalldb.ErrNoSuchKeyFound):
+				return err
+			}
+
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		return r, nil
+	}
+}
+
+func handleOpenChannelResponse(db firewalldb.PrivacyMapDB) func(
+	ctx context.Context, r *lnrpc.ChannelPoint) (proto.Message, error) {
+
+	return func(ctx context.Context, r *lnrpc.ChannelPoint) (proto.Message,
+		error) {
+
+		txid, err := lnrpc.GetChanPointFundingTxid(r)
+		if err != nil {
+			return nil, err
+		}
+
+		var (
+			newTxid  string
+			newIndex uint32
+		)
+		err = db.Update(func(tx firewalldb.PrivacyMapTx) error {
+			var err error
+			newTxid, newIndex, err = firewalldb.HideChanPoint(
+				tx, txid.String(), r.OutputIndex,
+			)
+			return err
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		return &lnrpc.ChannelPoint{
+			FundingTxid: &lnrpc.ChannelPoint_FundingTxidStr{
+				FundingTxidStr: newTxid,
+			},
+			OutputIndex: newIndex,
+		}, nil
+	}
+}
+
+func handleBatchOpenChannelRequest(db firewalldb.PrivacyMapDB) func(
+	ctx context.Context, r *lnrpc.BatchOpenChannelRequest) (proto.Message,
+	error) {
+
+	return func(ctx context.Context, r *lnrpc.BatchOpenChannelRequest) (
+		proto.Message, error) {
+
+		err := db.View(func(tx firewalldb.PrivacyMapTx) error {
+			for _, c := range r.Channels {
+				peer, err := revealPeer(tx, c.NodePubkey)
+				if err != nil {
+					return err
+				}
+
+				c.NodePubkey = peer
+			}
+
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		return r, nil
+	}
+}
+
+func handleBatchOpenChannelResponse(db firewalldb.PrivacyMapDB) func(
+	ctx context.Context, r *lnrpc.BatchOpenChannelResponse) (proto.Message,
+	error) {
+
+	return func(ctx context.Context, r *lnrpc.BatchOpenChannelResponse) (
+		proto.Message, error) {
+
+		pendingChannels := make(
+			[]*lnrpc.PendingUpdate, len(r.PendingChannels),
+		)
+
+		err := db.Update(func(tx firewalldb.PrivacyMapTx) error {
+			for i, c := range r.PendingChannels {
+				txid, err := chainhash.NewHash(c.Txid)
+				if err != nil {
+					return err
+				}
+
+				txidStr, idx, err := firewalldb.HideChanPoint(
+					tx, txid.String(), c.OutputIndex,
+				)
+				if err != nil {
+					return err
+				}
+
+				newTxid, err := chainhash.NewHashFromStr(
+					txidStr,
+				)
+				if err != nil {
+					return err
+				}
+
+				pendingChannels[i] = &lnrpc.PendingUpdate{
+					Txid:        newTxid[:],
+					OutputIndex: idx,
+				}
+			}
+
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		return &lnrpc.BatchOpenChannelResponse{
+			PendingChannels: pendingChannels,
+		}, nil
+	}
+}
+
+// revealPeer returns the real pubkey of the given pseudo peer pubkey. Channels
+// can also be opened with peers that the autopilot didn't learn about through
+// the privacy mapper, so a pubkey that has no real counterpart is returned
+// unchanged.
+func revealPeer(tx firewalldb.PrivacyMapTx, pubKey []byte) ([]byte, error) {
+	peer, err := firewalldb.RevealBytes(tx, pubKey)
+	if errors.Is(err, firewalldb.ErrNoSuchKeyFound) {
+		return pubKey, nil
+	}
+
+	return peer, err
+}
+
 // hideAmount symmetrically randomizes an amount around a given relative
 // variation interval. relativeVariation should be between 0 and 1.
 func hideAmount(randIntn func(n int) (int, error), relativeVariation float64,
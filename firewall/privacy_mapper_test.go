@@ -256,6 +256,84 @@ func TestPrivacyMapper(t *testing.T) {
 				},
 			},
 		},
+		{
+			name:    "OpenChannelSync Request",
+			uri:     "/lnrpc.Lightning/OpenChannelSync",
+			msgType: rpcperms.TypeRequest,
+			msg: &lnrpc.OpenChannelRequest{
+				NodePubkey:         []byte{200, 19, 68, 149},
+				LocalFundingAmount: 1_000_000,
+			},
+			expectedReplacement: &lnrpc.OpenChannelRequest{
+				NodePubkey:         []byte{1, 2, 3, 4},
+				LocalFundingAmount: 1_000_000,
+			},
+		},
+		{
+			name:    "OpenChannelSync Response",
+			uri:     "/lnrpc.Lightning/OpenChannelSync",
+			msgType: rpcperms.TypeResponse,
+			msg: &lnrpc.ChannelPoint{
+				FundingTxid: &lnrpc.ChannelPoint_FundingTxidStr{
+					FundingTxidStr: "abcdefabcdefabcdefabcdefabcdefabcdefabcdefabcdefabcdefabcdefabcd",
+				},
+				OutputIndex: 0,
+			},
+			expectedReplacement: &lnrpc.ChannelPoint{
+				FundingTxid: &lnrpc.ChannelPoint_FundingTxidStr{
+					FundingTxidStr: "097ef666a61919ff3413b3b701eae3a5cbac08f70c0ca567806e1fa6acbfe384",
+				},
+				OutputIndex: 2161781494,
+			},
+		},
+		{
+			name:    "BatchOpenChannel Request",
+			uri:     "/lnrpc.Lightning/BatchOpenChannel",
+			msgType: rpcperms.TypeRequest,
+			msg: &lnrpc.BatchOpenChannelRequest{
+				Channels: []*lnrpc.BatchOpenChannel{
+					{
+						NodePubkey: []byte{200, 19, 68, 149},
+					},
+					{
+						// Peers that are unknown to the
+						// privacy mapper are kept.
+						NodePubkey: []byte{5, 6, 7, 8},
+					},
+				},
+			},
+			expectedReplacement: &lnrpc.BatchOpenChannelRequest{
+				Channels: []*lnrpc.BatchOpenChannel{
+					{
+						NodePubkey: []byte{1, 2, 3, 4},
+					},
+					{
+						NodePubkey: []byte{5, 6, 7, 8},
+					},
+				},
+			},
+		},
+		{
+			name:    "BatchOpenChannel Response",
+			uri:     "/lnrpc.Lightning/BatchOpenChannel",
+			msgType: rpcperms.TypeResponse,
+			msg: &lnrpc.BatchOpenChannelResponse{
+				PendingChannels: []*lnrpc.PendingUpdate{
+					{
+						Txid:        []byte{205, 171, 239, 205, 171, 239, 205, 171, 239, 205, 171, 239, 205, 171, 239, 205, 171, 239, 205, 171, 239, 205, 171, 239, 205, 171, 239, 205, 171, 239, 205, 171},
+						OutputIndex: 0,
+					},
+				},
+			},
+			expectedReplacement: &lnrpc.BatchOpenChannelResponse{
+				PendingChannels: []*lnrpc.PendingUpdate{
+					{
+						Txid:        []byte{132, 227, 191, 172, 166, 31, 110, 128, 103, 165, 12, 12, 247, 8, 172, 203, 165, 227, 234, 1, 183, 179, 19, 52, 255, 25, 25, 166, 102, 246, 126, 9},
+						OutputIndex: 2161781494,
+					},
+				},
+			},
+		},
 	}
 
 	decodedID := &lnrpc.MacaroonId{
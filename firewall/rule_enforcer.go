@@ -3,6 +3,7 @@ package firewall
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/lightninglabs/lightning-terminal/firewalldb"
 	"github.com/lightninglabs/lightning-terminal/perms"
@@ -30,6 +31,7 @@ var _ mid.RequestInterceptor = (*RuleEnforcer)(nil)
 type RuleEnforcer struct {
 	ruleDB            firewalldb.RulesDB
 	actionsDB         firewalldb.ActionReadDBGetter
+	approvalsDB       firewalldb.ApprovalsDBGetter
 	markActionErrored func(reqID uint64, reason string) error
 	newPrivMap        firewalldb.NewPrivacyMapDB
 
@@ -42,6 +44,10 @@ type RuleEnforcer struct {
 	lndClient    lndclient.LightningClient
 
 	ruleMgrs rules.ManagerSet
+
+	// approvalTimeout is the maximum time a rule may hold back a request
+	// while it waits for the operator to approve or deny it.
+	approvalTimeout time.Duration
 }
 
 // featurePerms defines the signature of a function that can be used to fetch
@@ -55,11 +61,14 @@ func NewRuleEnforcer(ruleDB firewalldb.RulesDB,
 	routerClient lndclient.RouterClient,
 	lndClient lndclient.LightningClient, ruleMgrs rules.ManagerSet,
 	markActionErrored func(reqID uint64, reason string) error,
-	privMap firewalldb.NewPrivacyMapDB) *RuleEnforcer {
+	privMap firewalldb.NewPrivacyMapDB,
+	approvalsDB firewalldb.ApprovalsDBGetter,
+	interceptTimeout time.Duration) *RuleEnforcer {
 
 	return &RuleEnforcer{
 		ruleDB:            ruleDB,
 		actionsDB:         actionsDB,
+		approvalsDB:       approvalsDB,
 		permsMgr:          permsMgr,
 		getFeaturePerms:   getFeaturePerms,
 		nodeID:            nodeID,
@@ -68,6 +77,11 @@ func NewRuleEnforcer(ruleDB firewalldb.RulesDB,
 		ruleMgrs:          ruleMgrs,
 		markActionErrored: markActionErrored,
 		newPrivMap:        privMap,
+
+		// Rules only wait for an approval for half of the intercept
+		// timeout so that there is enough time left to reject the
+		// request before lnd gives up on the interceptor.
+		approvalTimeout: interceptTimeout / 2,
 	}
 }
 
@@ -233,9 +247,25 @@ func (r *RuleEnforcer) handleRequest(ctx context.Context,
 		return nil, fmt.Errorf("error parsing proto: %v", err)
 	}
 
-	for _, rule := range rules {
+	for i, rule := range rules {
 		newRequest, err := rule.HandleRequest(ctx, ri.URI, msg)
 		if err != nil {
+			// The request won't reach lnd, so the rules that
+			// already accepted it won't see a response for it.
+			// They are given the rejection as an error response
+			// instead, so that they can release anything they
+			// reserved for the request.
+			for _, accepted := range rules[:i] {
+				_, releaseErr := accepted.HandleErrorResponse(
+					ctx, ri.URI, err,
+				)
+				if releaseErr != nil {
+					log.Errorf("Error releasing request "+
+						"%d: %v", ri.RequestID,
+						releaseErr)
+				}
+			}
+
 			st := status.Errorf(
 				codes.ResourceExhausted, "rule violation: %v",
 				err,
@@ -365,20 +395,24 @@ func (r *RuleEnforcer) initRule(reqID uint64, name string, value []byte,
 	allActionsDB := r.actionsDB.GetActionsReadDB(sessionID, featureName)
 	actionsDB := allActionsDB.FeatureActionsDB()
 	rulesDB := r.ruleDB.GetKVStores(name, sessionID, featureName)
+	approvalsDB := r.approvalsDB.GetApprovalsDB(sessionID, featureName)
 
 	if sessionRule {
 		actionsDB = allActionsDB.SessionActionsDB()
 		rulesDB = r.ruleDB.GetKVStores(name, sessionID, "")
+		approvalsDB = r.approvalsDB.GetApprovalsDB(sessionID, "")
 	}
 
 	cfg := &rules.ConfigImpl{
-		Stores:       rulesDB,
-		ActionsDB:    actionsDB,
-		MethodPerms:  r.permsMgr.URIPermissions,
-		NodeID:       r.nodeID,
-		RouterClient: r.routerClient,
-		LndClient:    r.lndClient,
-		ReqID:        int64(reqID),
+		Stores:          rulesDB,
+		ActionsDB:       actionsDB,
+		MethodPerms:     r.permsMgr.URIPermissions,
+		NodeID:          r.nodeID,
+		RouterClient:    r.routerClient,
+		LndClient:       r.lndClient,
+		ReqID:           int64(reqID),
+		ApprovalsDB:     approvalsDB,
+		ApprovalTimeout: r.approvalTimeout,
 	}
 
 	return r.ruleMgrs.InitEnforcer(cfg, name, ruleValues)
@@ -0,0 +1,399 @@
+package firewalldb
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/lightninglabs/lightning-terminal/session"
+	"github.com/lightningnetwork/lnd/tlv"
+	"go.etcd.io/bbolt"
+)
+
+const (
+	typeApprovalSessionID     tlv.Type = 1
+	typeApprovalFeature       tlv.Type = 2
+	typeApprovalRPCMethod     tlv.Type = 3
+	typeApprovalRPCParamsJson tlv.Type = 4
+	typeApprovalRequestHash   tlv.Type = 5
+	typeApprovalReason        tlv.Type = 6
+	typeApprovalCreatedAt     tlv.Type = 7
+	typeApprovalState         tlv.Type = 8
+)
+
+/*
+	The Approvals are stored in the following structure in the KV db:
+
+	approvals-bucket -> <approval-id> -> serialised approval
+*/
+
+var (
+	// approvalsBucketKey is the key that will be used for the main
+	// Approvals bucket.
+	approvalsBucketKey = []byte("approvals-bucket")
+)
+
+// ApprovalState represents the state of an Approval.
+type ApprovalState uint8
+
+const (
+	// ApprovalStateUnknown means that the approval's state was never
+	// initialised. This should never be the case.
+	ApprovalStateUnknown ApprovalState = 0
+
+	// ApprovalStatePending means that the request is waiting for the
+	// operator to approve or deny it.
+	ApprovalStatePending ApprovalState = 1
+
+	// ApprovalStateApproved means that the operator approved the request
+	// but that it has not been let through yet.
+	ApprovalStateApproved ApprovalState = 2
+
+	// ApprovalStateDenied means that the operator denied the request.
+	ApprovalStateDenied ApprovalState = 3
+
+	// ApprovalStateReleased means that the approved request has been let
+	// through. The approval can't be used again.
+	ApprovalStateReleased ApprovalState = 4
+)
+
+// Approval represents a request that was parked by a rule until the operator
+// approves or denies it.
+type Approval struct {
+	// ID is the unique ID of the approval. Note that this is not
+	// serialized on persistence since the approval is stored under its ID.
+	ID uint64
+
+	// SessionID is the ID of the session under which the request was made.
+	SessionID session.ID
+
+	// FeatureName is the name of the feature that made the request.
+	FeatureName string
+
+	// RPCMethod is the URI that was called.
+	RPCMethod string
+
+	// RPCParamsJson is the method parameters of the request in JSON form.
+	RPCParamsJson []byte
+
+	// RequestHash is a hash of the request that is used to recognise the
+	// request when it is retried.
+	RequestHash [32]byte
+
+	// Reason is the human-readable reason for why the request requires an
+	// approval.
+	Reason string
+
+	// CreatedAt is the time at which the request was parked.
+	CreatedAt time.Time
+
+	// State represents the state of the Approval.
+	State ApprovalState
+}
+
+// ListApprovals returns all the Approvals that are in the given state. If the
+// state is ApprovalStateUnknown, the Approvals of any state are returned.
+func (db *DB) ListApprovals(state ApprovalState) ([]*Approval, error) {
+	var approvals []*Approval
+	err := db.View(func(tx *bbolt.Tx) error {
+		approvalsBucket, err := getBucket(tx, approvalsBucketKey)
+		if err != nil {
+			return err
+		}
+
+		return approvalsBucket.ForEach(func(k, v []byte) error {
+			approval, err := DeserializeApproval(
+				bytes.NewReader(v), byteOrder.Uint64(k),
+			)
+			if err != nil {
+				return err
+			}
+
+			if state != ApprovalStateUnknown &&
+				approval.State != state {
+
+				return nil
+			}
+
+			approvals = append(approvals, approval)
+
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return approvals, nil
+}
+
+// ResolveApproval approves or denies the pending Approval with the given ID.
+func (db *DB) ResolveApproval(id uint64, approve bool) error {
+	return db.Update(func(tx *bbolt.Tx) error {
+		approvalsBucket, err := getBucket(tx, approvalsBucketKey)
+		if err != nil {
+			return err
+		}
+
+		var key [8]byte
+		byteOrder.PutUint64(key[:], id)
+
+		approvalBytes := approvalsBucket.Get(key[:])
+		if approvalBytes == nil {
+			return ErrNoSuchKeyFound
+		}
+
+		approval, err := DeserializeApproval(
+			bytes.NewReader(approvalBytes), id,
+		)
+		if err != nil {
+			return err
+		}
+
+		if approval.State != ApprovalStatePending {
+			return fmt.Errorf("approval %d is not pending", id)
+		}
+
+		approval.State = ApprovalStateDenied
+		if approve {
+			approval.State = ApprovalStateApproved
+		}
+
+		return putApproval(approvalsBucket, approval)
+	})
+}
+
+// putApproval serialises the given Approval and stores it under its ID.
+func putApproval(approvalsBucket *bbolt.Bucket, approval *Approval) error {
+	var buf bytes.Buffer
+	if err := SerializeApproval(&buf, approval); err != nil {
+		return err
+	}
+
+	var key [8]byte
+	byteOrder.PutUint64(key[:], approval.ID)
+
+	return approvalsBucket.Put(key[:], buf.Bytes())
+}
+
+// SerializeApproval binary serializes the given approval to the writer using
+// the tlv format.
+func SerializeApproval(w io.Writer, approval *Approval) error {
+	if approval == nil {
+		return fmt.Errorf("approval cannot be nil")
+	}
+
+	var (
+		sessionID = approval.SessionID[:]
+		feature   = []byte(approval.FeatureName)
+		rpcMethod = []byte(approval.RPCMethod)
+		params    = approval.RPCParamsJson
+		reqHash   = approval.RequestHash
+		reason    = []byte(approval.Reason)
+		createdAt = uint64(approval.CreatedAt.Unix())
+		state     = uint8(approval.State)
+	)
+
+	tlvStream, err := tlv.NewStream(
+		tlv.MakePrimitiveRecord(typeApprovalSessionID, &sessionID),
+		tlv.MakePrimitiveRecord(typeApprovalFeature, &feature),
+		tlv.MakePrimitiveRecord(typeApprovalRPCMethod, &rpcMethod),
+		tlv.MakePrimitiveRecord(typeApprovalRPCParamsJson, &params),
+		tlv.MakePrimitiveRecord(typeApprovalRequestHash, &reqHash),
+		tlv.MakePrimitiveRecord(typeApprovalReason, &reason),
+		tlv.MakePrimitiveRecord(typeApprovalCreatedAt, &createdAt),
+		tlv.MakePrimitiveRecord(typeApprovalState, &state),
+	)
+	if err != nil {
+		return err
+	}
+
+	return tlvStream.Encode(w)
+}
+
+// DeserializeApproval deserializes an approval from the given reader,
+// expecting the data to be encoded in the tlv format.
+func DeserializeApproval(r io.Reader, id uint64) (*Approval, error) {
+	var (
+		approval                  = Approval{ID: id}
+		sessionID, featureName    []byte
+		rpcMethod, params, reason []byte
+		reqHash                   [32]byte
+		createdAt                 uint64
+		state                     uint8
+	)
+	tlvStream, err := tlv.NewStream(
+		tlv.MakePrimitiveRecord(typeApprovalSessionID, &sessionID),
+		tlv.MakePrimitiveRecord(typeApprovalFeature, &featureName),
+		tlv.MakePrimitiveRecord(typeApprovalRPCMethod, &rpcMethod),
+		tlv.MakePrimitiveRecord(typeApprovalRPCParamsJson, &params),
+		tlv.MakePrimitiveRecord(typeApprovalRequestHash, &reqHash),
+		tlv.MakePrimitiveRecord(typeApprovalReason, &reason),
+		tlv.MakePrimitiveRecord(typeApprovalCreatedAt, &createdAt),
+		tlv.MakePrimitiveRecord(typeApprovalState, &state),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = tlvStream.DecodeWithParsedTypes(r)
+	if err != nil {
+		return nil, err
+	}
+
+	approval.SessionID, err = session.IDFromBytes(sessionID)
+	if err != nil {
+		return nil, err
+	}
+
+	approval.FeatureName = string(featureName)
+	approval.RPCMethod = string(rpcMethod)
+	approval.RPCParamsJson = params
+	approval.RequestHash = reqHash
+	approval.Reason = string(reason)
+	approval.CreatedAt = time.Unix(int64(createdAt), 0)
+	approval.State = ApprovalState(state)
+
+	return &approval, nil
+}
+
+// ApprovalsDB gives a rule access to the Approvals of a specific session and
+// feature.
+type ApprovalsDB interface {
+	// AddApproval parks the given request until the operator approves or
+	// denies it. The ID of the new Approval is returned.
+	AddApproval(ctx context.Context, uri string, paramsJson []byte,
+		reqHash [32]byte, reason string) (uint64, error)
+
+	// ClaimApproval returns the latest Approval of the request with the
+	// given hash that has not been released yet. If the Approval was
+	// approved, it is returned in the released state so that it can't be
+	// used again. If there is no such Approval, ErrNoSuchKeyFound is
+	// returned.
+	ClaimApproval(ctx context.Context, reqHash [32]byte) (*Approval,
+		error)
+}
+
+// ApprovalsDBGetter represents a function that can be used to construct an
+// ApprovalsDB.
+type ApprovalsDBGetter interface {
+	GetApprovalsDB(sessionID session.ID, featureName string) ApprovalsDB
+}
+
+// GetApprovalsDB is a method on DB that constructs an ApprovalsDB.
+func (db *DB) GetApprovalsDB(sessionID session.ID,
+	featureName string) ApprovalsDB {
+
+	return &approvalsDB{
+		db:          db,
+		sessionID:   sessionID,
+		featureName: featureName,
+	}
+}
+
+// approvalsDB is an implementation of the ApprovalsDB.
+type approvalsDB struct {
+	db          *DB
+	sessionID   session.ID
+	featureName string
+}
+
+var _ ApprovalsDB = (*approvalsDB)(nil)
+
+// AddApproval parks the given request until the operator approves or denies
+// it. The ID of the new Approval is returned.
+//
+// NOTE: this is part of the ApprovalsDB interface.
+func (a *approvalsDB) AddApproval(_ context.Context, uri string,
+	paramsJson []byte, reqHash [32]byte, reason string) (uint64, error) {
+
+	approval := &Approval{
+		SessionID:     a.sessionID,
+		FeatureName:   a.featureName,
+		RPCMethod:     uri,
+		RPCParamsJson: paramsJson,
+		RequestHash:   reqHash,
+		Reason:        reason,
+		CreatedAt:     time.Now(),
+		State:         ApprovalStatePending,
+	}
+
+	err := a.db.Update(func(tx *bbolt.Tx) error {
+		approvalsBucket, err := getBucket(tx, approvalsBucketKey)
+		if err != nil {
+			return err
+		}
+
+		approval.ID, err = approvalsBucket.NextSequence()
+		if err != nil {
+			return err
+		}
+
+		return putApproval(approvalsBucket, approval)
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return approval.ID, nil
+}
+
+// ClaimApproval returns the latest Approval of the request with the given hash
+// that has not been released yet. If the Approval was approved, it is returned
+// in the released state so that it can't be used again. If there is no such
+// Approval, ErrNoSuchKeyFound is returned.
+//
+// NOTE: this is part of the ApprovalsDB interface.
+func (a *approvalsDB) ClaimApproval(_ context.Context,
+	reqHash [32]byte) (*Approval, error) {
+
+	var approval *Approval
+	err := a.db.Update(func(tx *bbolt.Tx) error {
+		approvalsBucket, err := getBucket(tx, approvalsBucketKey)
+		if err != nil {
+			return err
+		}
+
+		// Iterate backwards so that the latest matching Approval is
+		// found first.
+		c := approvalsBucket.Cursor()
+		for k, v := c.Last(); k != nil; k, v = c.Prev() {
+			candidate, err := DeserializeApproval(
+				bytes.NewReader(v), byteOrder.Uint64(k),
+			)
+			if err != nil {
+				return err
+			}
+
+			if candidate.SessionID != a.sessionID ||
+				candidate.FeatureName != a.featureName ||
+				candidate.RequestHash != reqHash ||
+				candidate.State == ApprovalStateReleased {
+
+				continue
+			}
+
+			approval = candidate
+			break
+		}
+
+		if approval == nil {
+			return ErrNoSuchKeyFound
+		}
+
+		if approval.State != ApprovalStateApproved {
+			return nil
+		}
+
+		approval.State = ApprovalStateReleased
+
+		return putApproval(approvalsBucket, approval)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return approval, nil
+}
@@ -0,0 +1,94 @@
+package firewalldb
+
+import (
+	"context"
+	"crypto/sha256"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// TestApprovalStorage tests that Approvals can be added, resolved and claimed.
+func TestApprovalStorage(t *testing.T) {
+	ctx := context.Background()
+	tmpDir := t.TempDir()
+
+	db, err := NewDB(tmpDir, "test.db")
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_ = db.Close()
+	})
+
+	sessionID1 := [4]byte{1, 1, 1, 1}
+	sessionID2 := [4]byte{2, 2, 2, 2}
+	approvals1 := db.GetApprovalsDB(sessionID1, "auto-fees")
+	approvals2 := db.GetApprovalsDB(sessionID2, "auto-fees")
+
+	reqHash := sha256.Sum256([]byte("request"))
+
+	// There is nothing to claim before the request is parked.
+	_, err = approvals1.ClaimApproval(ctx, reqHash)
+	require.ErrorIs(t, err, ErrNoSuchKeyFound)
+
+	id1, err := approvals1.AddApproval(
+		ctx, "UpdateChanPolicy", []byte("new fee"), reqHash,
+		"fee rate too high",
+	)
+	require.NoError(t, err)
+
+	id2, err := approvals2.AddApproval(
+		ctx, "UpdateChanPolicy", []byte("new fee"), reqHash,
+		"fee rate too high",
+	)
+	require.NoError(t, err)
+
+	pending, err := db.ListApprovals(ApprovalStatePending)
+	require.NoError(t, err)
+	require.Len(t, pending, 2)
+	require.Equal(t, id1, pending[0].ID)
+	require.Equal(t, sessionID1, pending[0].SessionID)
+	require.Equal(t, "auto-fees", pending[0].FeatureName)
+	require.Equal(t, "UpdateChanPolicy", pending[0].RPCMethod)
+	require.Equal(t, []byte("new fee"), pending[0].RPCParamsJson)
+	require.Equal(t, reqHash, pending[0].RequestHash)
+	require.Equal(t, "fee rate too high", pending[0].Reason)
+
+	// A pending approval is returned unchanged when it is claimed.
+	approval, err := approvals1.ClaimApproval(ctx, reqHash)
+	require.NoError(t, err)
+	require.Equal(t, id1, approval.ID)
+	require.Equal(t, ApprovalStatePending, approval.State)
+
+	// Once approved, the approval is released when it is claimed and it
+	// can't be claimed again.
+	require.NoError(t, db.ResolveApproval(id1, true))
+	require.ErrorContains(
+		t, db.ResolveApproval(id1, false), "is not pending",
+	)
+
+	approval, err = approvals1.ClaimApproval(ctx, reqHash)
+	require.NoError(t, err)
+	require.Equal(t, id1, approval.ID)
+	require.Equal(t, ApprovalStateReleased, approval.State)
+
+	_, err = approvals1.ClaimApproval(ctx, reqHash)
+	require.ErrorIs(t, err, ErrNoSuchKeyFound)
+
+	// A denied approval stays denied.
+	require.NoError(t, db.ResolveApproval(id2, false))
+
+	approval, err = approvals2.ClaimApproval(ctx, reqHash)
+	require.NoError(t, err)
+	require.Equal(t, id2, approval.ID)
+	require.Equal(t, ApprovalStateDenied, approval.State)
+
+	pending, err = db.ListApprovals(ApprovalStatePending)
+	require.NoError(t, err)
+	require.Empty(t, pending)
+
+	all, err := db.ListApprovals(ApprovalStateUnknown)
+	require.NoError(t, err)
+	require.Len(t, all, 2)
+
+	require.ErrorIs(t, db.ResolveApproval(3, true), ErrNoSuchKeyFound)
+}
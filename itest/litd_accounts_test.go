@@ -169,9 +169,10 @@ func testAccountRestrictionsLNC(ctxm context.Context, t *harnessTest,
 	// We first need to create an LNC session that we can use to connect.
 	// We use the UI password to create the session.
 	litClient := litrpc.NewSessionsClient(rawConn)
+	sessionType := litrpc.SessionType_TYPE_MACAROON_ACCOUNT
 	sessResp, err := litClient.AddSession(ctxm, &litrpc.AddSessionRequest{
 		Label:       "integration-test",
-		SessionType: litrpc.SessionType_TYPE_MACAROON_ACCOUNT,
+		SessionType: &sessionType,
 		ExpiryTimestampSeconds: uint64(
 			time.Now().Add(5 * time.Minute).Unix(),
 		),
@@ -669,7 +669,7 @@ func setUpLNCConn(ctx context.Context, t *testing.T, hostPort, tlsCertPath,
 	litClient := litrpc.NewSessionsClient(rawConn)
 	sessResp, err := litClient.AddSession(ctxm, &litrpc.AddSessionRequest{
 		Label:       "integration-test",
-		SessionType: sessType,
+		SessionType: &sessType,
 		ExpiryTimestampSeconds: uint64(
 			time.Now().Add(5 * time.Minute).Unix(),
 		),
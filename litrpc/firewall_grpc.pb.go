@@ -34,6 +34,15 @@ type FirewallClient interface {
 	// PrivacyMapConversion can be used map real values to their pseudo
 	// counterpart and vice versa.
 	PrivacyMapConversion(ctx context.Context, in *PrivacyMapConversionRequest, opts ...grpc.CallOption) (*PrivacyMapConversionResponse, error)
+	// litcli: `approvals list`
+	// ListPendingApprovals returns the requests that were parked by an
+	// approval-required rule and are waiting for the operator to approve or deny
+	// them.
+	ListPendingApprovals(ctx context.Context, in *ListPendingApprovalsRequest, opts ...grpc.CallOption) (*ListPendingApprovalsResponse, error)
+	// litcli: `approvals resolve`
+	// ResolveApproval approves or denies a pending request. An approved request
+	// is let through once it is retried, a denied one is rejected.
+	ResolveApproval(ctx context.Context, in *ResolveApprovalRequest, opts ...grpc.CallOption) (*ResolveApprovalResponse, error)
 }
 
 type firewallClient struct {
@@ -62,6 +71,24 @@ func (c *firewallClient) PrivacyMapConversion(ctx context.Context, in *PrivacyMa
 	return out, nil
 }
 
+func (c *firewallClient) ListPendingApprovals(ctx context.Context, in *ListPendingApprovalsRequest, opts ...grpc.CallOption) (*ListPendingApprovalsResponse, error) {
+	out := new(ListPendingApprovalsResponse)
+	err := c.cc.Invoke(ctx, "/litrpc.Firewall/ListPendingApprovals", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *firewallClient) ResolveApproval(ctx context.Context, in *ResolveApprovalRequest, opts ...grpc.CallOption) (*ResolveApprovalResponse, error) {
+	out := new(ResolveApprovalResponse)
+	err := c.cc.Invoke(ctx, "/litrpc.Firewall/ResolveApproval", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // FirewallServer is the server API for Firewall service.
 // All implementations must embed UnimplementedFirewallServer
 // for forward compatibility
@@ -82,6 +109,15 @@ type FirewallServer interface {
 	// PrivacyMapConversion can be used map real values to their pseudo
 	// counterpart and vice versa.
 	PrivacyMapConversion(context.Context, *PrivacyMapConversionRequest) (*PrivacyMapConversionResponse, error)
+	// litcli: `approvals list`
+	// ListPendingApprovals returns the requests that were parked by an
+	// approval-required rule and are waiting for the operator to approve or deny
+	// them.
+	ListPendingApprovals(context.Context, *ListPendingApprovalsRequest) (*ListPendingApprovalsResponse, error)
+	// litcli: `approvals resolve`
+	// ResolveApproval approves or denies a pending request. An approved request
+	// is let through once it is retried, a denied one is rejected.
+	ResolveApproval(context.Context, *ResolveApprovalRequest) (*ResolveApprovalResponse, error)
 	mustEmbedUnimplementedFirewallServer()
 }
 
@@ -95,6 +131,12 @@ func (UnimplementedFirewallServer) ListActions(context.Context, *ListActionsRequ
 func (UnimplementedFirewallServer) PrivacyMapConversion(context.Context, *PrivacyMapConversionRequest) (*PrivacyMapConversionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PrivacyMapConversion not implemented")
 }
+func (UnimplementedFirewallServer) ListPendingApprovals(context.Context, *ListPendingApprovalsRequest) (*ListPendingApprovalsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPendingApprovals not implemented")
+}
+func (UnimplementedFirewallServer) ResolveApproval(context.Context, *ResolveApprovalRequest) (*ResolveApprovalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResolveApproval not implemented")
+}
 func (UnimplementedFirewallServer) mustEmbedUnimplementedFirewallServer() {}
 
 // UnsafeFirewallServer may be embedded to opt out of forward compatibility for this service.
@@ -144,6 +186,42 @@ func _Firewall_PrivacyMapConversion_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Firewall_ListPendingApprovals_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPendingApprovalsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FirewallServer).ListPendingApprovals(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/litrpc.Firewall/ListPendingApprovals",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FirewallServer).ListPendingApprovals(ctx, req.(*ListPendingApprovalsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Firewall_ResolveApproval_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResolveApprovalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FirewallServer).ResolveApproval(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/litrpc.Firewall/ResolveApproval",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FirewallServer).ResolveApproval(ctx, req.(*ResolveApprovalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Firewall_ServiceDesc is the grpc.ServiceDesc for Firewall service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -159,6 +237,14 @@ var Firewall_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "PrivacyMapConversion",
 			Handler:    _Firewall_PrivacyMapConversion_Handler,
 		},
+		{
+			MethodName: "ListPendingApprovals",
+			Handler:    _Firewall_ListPendingApprovals_Handler,
+		},
+		{
+			MethodName: "ResolveApproval",
+			Handler:    _Firewall_ResolveApproval_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "firewall.proto",
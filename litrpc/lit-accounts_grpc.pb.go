@@ -41,6 +41,41 @@ type AccountsClient interface {
 	// litcli: `accounts remove`
 	// RemoveAccount removes the given account from the account database.
 	RemoveAccount(ctx context.Context, in *RemoveAccountRequest, opts ...grpc.CallOption) (*RemoveAccountResponse, error)
+	// litcli: `accounts history`
+	// ListAccountTransactions returns the transaction ledger of an account which
+	// records every change to the account's balance, such as settled invoices,
+	// succeeded or failed payments and updates by the node operator. The results
+	// can be paginated by using the index offset of the last returned entry.
+	ListAccountTransactions(ctx context.Context, in *ListAccountTransactionsRequest, opts ...grpc.CallOption) (*ListAccountTransactionsResponse, error)
+	// litcli: `accounts transfer`
+	// TransferBetweenAccounts moves an amount from one account to another account
+	// in the account database without sending a payment through the network. The
+	// transfer is instant and doesn't incur any routing fees.
+	TransferBetweenAccounts(ctx context.Context, in *TransferBetweenAccountsRequest, opts ...grpc.CallOption) (*TransferBetweenAccountsResponse, error)
+	// litcli: `accounts subscribe`
+	// SubscribeAccountUpdates returns a stream of events for changes of accounts,
+	// such as balance changes, payment state transitions, expiry and removal. The
+	// stream can be restricted to a set of accounts.
+	SubscribeAccountUpdates(ctx context.Context, in *SubscribeAccountUpdatesRequest, opts ...grpc.CallOption) (Accounts_SubscribeAccountUpdatesClient, error)
+	// litcli: `accounts expiryactions`
+	// ListExpiryActions returns the audit log of actions that were taken on
+	// expired accounts, such as sweeping their remaining balance to the treasury
+	// account or removing them. The results can be paginated by using the index
+	// offset of the last returned entry.
+	ListExpiryActions(ctx context.Context, in *ListExpiryActionsRequest, opts ...grpc.CallOption) (*ListExpiryActionsResponse, error)
+	// litcli: `accounts export`
+	// ExportAccounts serializes accounts, including their invoice and payment
+	// associations and their transaction ledgers, into a versioned export that
+	// can be imported into another litd instance with ImportAccounts. The export
+	// contains a checksum that detects accidental corruption, but it is not
+	// protected against deliberate modification. Accounts with payments that are
+	// still in flight cannot be exported.
+	ExportAccounts(ctx context.Context, in *ExportAccountsRequest, opts ...grpc.CallOption) (*ExportAccountsResponse, error)
+	// litcli: `accounts import`
+	// ImportAccounts imports the accounts of an export created by ExportAccounts.
+	// A new macaroon is baked for every imported account with the root key of
+	// this node.
+	ImportAccounts(ctx context.Context, in *ImportAccountsRequest, opts ...grpc.CallOption) (*ImportAccountsResponse, error)
 }
 
 type accountsClient struct {
@@ -87,6 +122,83 @@ func (c *accountsClient) RemoveAccount(ctx context.Context, in *RemoveAccountReq
 	return out, nil
 }
 
+func (c *accountsClient) ListAccountTransactions(ctx context.Context, in *ListAccountTransactionsRequest, opts ...grpc.CallOption) (*ListAccountTransactionsResponse, error) {
+	out := new(ListAccountTransactionsResponse)
+	err := c.cc.Invoke(ctx, "/litrpc.Accounts/ListAccountTransactions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountsClient) TransferBetweenAccounts(ctx context.Context, in *TransferBetweenAccountsRequest, opts ...grpc.CallOption) (*TransferBetweenAccountsResponse, error) {
+	out := new(TransferBetweenAccountsResponse)
+	err := c.cc.Invoke(ctx, "/litrpc.Accounts/TransferBetweenAccounts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountsClient) SubscribeAccountUpdates(ctx context.Context, in *SubscribeAccountUpdatesRequest, opts ...grpc.CallOption) (Accounts_SubscribeAccountUpdatesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Accounts_ServiceDesc.Streams[0], "/litrpc.Accounts/SubscribeAccountUpdates", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &accountsSubscribeAccountUpdatesClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Accounts_SubscribeAccountUpdatesClient interface {
+	Recv() (*AccountUpdate, error)
+	grpc.ClientStream
+}
+
+type accountsSubscribeAccountUpdatesClient struct {
+	grpc.ClientStream
+}
+
+func (x *accountsSubscribeAccountUpdatesClient) Recv() (*AccountUpdate, error) {
+	m := new(AccountUpdate)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *accountsClient) ListExpiryActions(ctx context.Context, in *ListExpiryActionsRequest, opts ...grpc.CallOption) (*ListExpiryActionsResponse, error) {
+	out := new(ListExpiryActionsResponse)
+	err := c.cc.Invoke(ctx, "/litrpc.Accounts/ListExpiryActions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountsClient) ExportAccounts(ctx context.Context, in *ExportAccountsRequest, opts ...grpc.CallOption) (*ExportAccountsResponse, error) {
+	out := new(ExportAccountsResponse)
+	err := c.cc.Invoke(ctx, "/litrpc.Accounts/ExportAccounts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountsClient) ImportAccounts(ctx context.Context, in *ImportAccountsRequest, opts ...grpc.CallOption) (*ImportAccountsResponse, error) {
+	out := new(ImportAccountsResponse)
+	err := c.cc.Invoke(ctx, "/litrpc.Accounts/ImportAccounts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // AccountsServer is the server API for Accounts service.
 // All implementations must embed UnimplementedAccountsServer
 // for forward compatibility
@@ -114,6 +226,41 @@ type AccountsServer interface {
 	// litcli: `accounts remove`
 	// RemoveAccount removes the given account from the account database.
 	RemoveAccount(context.Context, *RemoveAccountRequest) (*RemoveAccountResponse, error)
+	// litcli: `accounts history`
+	// ListAccountTransactions returns the transaction ledger of an account which
+	// records every change to the account's balance, such as settled invoices,
+	// succeeded or failed payments and updates by the node operator. The results
+	// can be paginated by using the index offset of the last returned entry.
+	ListAccountTransactions(context.Context, *ListAccountTransactionsRequest) (*ListAccountTransactionsResponse, error)
+	// litcli: `accounts transfer`
+	// TransferBetweenAccounts moves an amount from one account to another account
+	// in the account database without sending a payment through the network. The
+	// transfer is instant and doesn't incur any routing fees.
+	TransferBetweenAccounts(context.Context, *TransferBetweenAccountsRequest) (*TransferBetweenAccountsResponse, error)
+	// litcli: `accounts subscribe`
+	// SubscribeAccountUpdates returns a stream of events for changes of accounts,
+	// such as balance changes, payment state transitions, expiry and removal. The
+	// stream can be restricted to a set of accounts.
+	SubscribeAccountUpdates(*SubscribeAccountUpdatesRequest, Accounts_SubscribeAccountUpdatesServer) error
+	// litcli: `accounts expiryactions`
+	// ListExpiryActions returns the audit log of actions that were taken on
+	// expired accounts, such as sweeping their remaining balance to the treasury
+	// account or removing them. The results can be paginated by using the index
+	// offset of the last returned entry.
+	ListExpiryActions(context.Context, *ListExpiryActionsRequest) (*ListExpiryActionsResponse, error)
+	// litcli: `accounts export`
+	// ExportAccounts serializes accounts, including their invoice and payment
+	// associations and their transaction ledgers, into a versioned export that
+	// can be imported into another litd instance with ImportAccounts. The export
+	// contains a checksum that detects accidental corruption, but it is not
+	// protected against deliberate modification. Accounts with payments that are
+	// still in flight cannot be exported.
+	ExportAccounts(context.Context, *ExportAccountsRequest) (*ExportAccountsResponse, error)
+	// litcli: `accounts import`
+	// ImportAccounts imports the accounts of an export created by ExportAccounts.
+	// A new macaroon is baked for every imported account with the root key of
+	// this node.
+	ImportAccounts(context.Context, *ImportAccountsRequest) (*ImportAccountsResponse, error)
 	mustEmbedUnimplementedAccountsServer()
 }
 
@@ -133,6 +280,24 @@ func (UnimplementedAccountsServer) ListAccounts(context.Context, *ListAccountsRe
 func (UnimplementedAccountsServer) RemoveAccount(context.Context, *RemoveAccountRequest) (*RemoveAccountResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RemoveAccount not implemented")
 }
+func (UnimplementedAccountsServer) ListAccountTransactions(context.Context, *ListAccountTransactionsRequest) (*ListAccountTransactionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAccountTransactions not implemented")
+}
+func (UnimplementedAccountsServer) TransferBetweenAccounts(context.Context, *TransferBetweenAccountsRequest) (*TransferBetweenAccountsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferBetweenAccounts not implemented")
+}
+func (UnimplementedAccountsServer) SubscribeAccountUpdates(*SubscribeAccountUpdatesRequest, Accounts_SubscribeAccountUpdatesServer) error {
+	return status.Errorf(codes.Unimplemented, "method SubscribeAccountUpdates not implemented")
+}
+func (UnimplementedAccountsServer) ListExpiryActions(context.Context, *ListExpiryActionsRequest) (*ListExpiryActionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListExpiryActions not implemented")
+}
+func (UnimplementedAccountsServer) ExportAccounts(context.Context, *ExportAccountsRequest) (*ExportAccountsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportAccounts not implemented")
+}
+func (UnimplementedAccountsServer) ImportAccounts(context.Context, *ImportAccountsRequest) (*ImportAccountsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportAccounts not implemented")
+}
 func (UnimplementedAccountsServer) mustEmbedUnimplementedAccountsServer() {}
 
 // UnsafeAccountsServer may be embedded to opt out of forward compatibility for this service.
@@ -218,6 +383,117 @@ func _Accounts_RemoveAccount_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Accounts_ListAccountTransactions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAccountTransactionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountsServer).ListAccountTransactions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/litrpc.Accounts/ListAccountTransactions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountsServer).ListAccountTransactions(ctx, req.(*ListAccountTransactionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Accounts_TransferBetweenAccounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransferBetweenAccountsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountsServer).TransferBetweenAccounts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/litrpc.Accounts/TransferBetweenAccounts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountsServer).TransferBetweenAccounts(ctx, req.(*TransferBetweenAccountsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Accounts_SubscribeAccountUpdates_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribeAccountUpdatesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(AccountsServer).SubscribeAccountUpdates(m, &accountsSubscribeAccountUpdatesServer{stream})
+}
+
+type Accounts_SubscribeAccountUpdatesServer interface {
+	Send(*AccountUpdate) error
+	grpc.ServerStream
+}
+
+type accountsSubscribeAccountUpdatesServer struct {
+	grpc.ServerStream
+}
+
+func (x *accountsSubscribeAccountUpdatesServer) Send(m *AccountUpdate) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Accounts_ListExpiryActions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListExpiryActionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountsServer).ListExpiryActions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/litrpc.Accounts/ListExpiryActions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountsServer).ListExpiryActions(ctx, req.(*ListExpiryActionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Accounts_ExportAccounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportAccountsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountsServer).ExportAccounts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/litrpc.Accounts/ExportAccounts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountsServer).ExportAccounts(ctx, req.(*ExportAccountsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Accounts_ImportAccounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportAccountsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountsServer).ImportAccounts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/litrpc.Accounts/ImportAccounts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountsServer).ImportAccounts(ctx, req.(*ImportAccountsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Accounts_ServiceDesc is the grpc.ServiceDesc for Accounts service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -241,7 +517,33 @@ var Accounts_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RemoveAccount",
 			Handler:    _Accounts_RemoveAccount_Handler,
 		},
+		{
+			MethodName: "ListAccountTransactions",
+			Handler:    _Accounts_ListAccountTransactions_Handler,
+		},
+		{
+			MethodName: "TransferBetweenAccounts",
+			Handler:    _Accounts_TransferBetweenAccounts_Handler,
+		},
+		{
+			MethodName: "ListExpiryActions",
+			Handler:    _Accounts_ListExpiryActions_Handler,
+		},
+		{
+			MethodName: "ExportAccounts",
+			Handler:    _Accounts_ExportAccounts_Handler,
+		},
+		{
+			MethodName: "ImportAccounts",
+			Handler:    _Accounts_ImportAccounts_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "SubscribeAccountUpdates",
+			Handler:       _Accounts_SubscribeAccountUpdates_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "lit-accounts.proto",
 }
@@ -22,12 +22,50 @@ type SessionsClient interface {
 	// AddSession adds and starts a new LNC session.
 	AddSession(ctx context.Context, in *AddSessionRequest, opts ...grpc.CallOption) (*AddSessionResponse, error)
 	// litcli: `sessions list`
-	// ListSessions returns all sessions known to the session store.
+	// ListSessions returns the sessions known to the session store that match the
+	// filters of the request, in the order they were created.
 	ListSessions(ctx context.Context, in *ListSessionsRequest, opts ...grpc.CallOption) (*ListSessionsResponse, error)
 	// litcli: `sessions revoke`
 	// RevokeSession revokes a single session and also stops it if it is currently
 	// active.
 	RevokeSession(ctx context.Context, in *RevokeSessionRequest, opts ...grpc.CallOption) (*RevokeSessionResponse, error)
+	// litcli: `sessions rotate`
+	// RotateSession issues a new macaroon for an active session and sets its new
+	// expiry. The old macaroon of the session is invalidated, but the paired
+	// remote public key is kept so that the connected app doesn't need to pair
+	// again. The app receives the new macaroon when it reconnects. If the session
+	// can't be started with the new macaroon, the rotation is rolled back and the
+	// old macaroon stays valid.
+	RotateSession(ctx context.Context, in *RotateSessionRequest, opts ...grpc.CallOption) (*RotateSessionResponse, error)
+	// litcli: `sessions pause`
+	// PauseSession pauses an active session by stopping its mailbox connection.
+	// The macaroon of a paused session is rejected until the session is resumed.
+	// The session keeps its identity and firewall history. A paused session is
+	// revoked once it reaches its expiry.
+	PauseSession(ctx context.Context, in *PauseSessionRequest, opts ...grpc.CallOption) (*PauseSessionResponse, error)
+	// litcli: `sessions resume`
+	// ResumeSession resumes a paused session by starting its mailbox connection
+	// again.
+	ResumeSession(ctx context.Context, in *ResumeSessionRequest, opts ...grpc.CallOption) (*ResumeSessionResponse, error)
+	// litcli: `sessions devices add`
+	// AddSessionDevice adds a device to an existing session. The device gets its
+	// own pairing phrase and connects through its own mailbox, but shares the
+	// macaroon and firewall rules of the session. A session can have up to five
+	// active devices in addition to the app it was paired with.
+	AddSessionDevice(ctx context.Context, in *AddSessionDeviceRequest, opts ...grpc.CallOption) (*AddSessionDeviceResponse, error)
+	// litcli: `sessions devices revoke`
+	// RevokeSessionDevice revokes a single device of a session and stops its
+	// mailbox connection. The session and its other devices stay usable.
+	RevokeSessionDevice(ctx context.Context, in *RevokeSessionDeviceRequest, opts ...grpc.CallOption) (*RevokeSessionDeviceResponse, error)
+	// litcli: `sessions templates add`
+	// AddSessionTemplate adds a named session template that stores the session
+	// type, permissions, lifetime and mailbox server to use for new sessions. An
+	// existing template with the same name is replaced.
+	AddSessionTemplate(ctx context.Context, in *AddSessionTemplateRequest, opts ...grpc.CallOption) (*AddSessionTemplateResponse, error)
+	// litcli: `sessions templates list`
+	// ListSessionTemplates returns all session templates known to the session
+	// store.
+	ListSessionTemplates(ctx context.Context, in *ListSessionTemplatesRequest, opts ...grpc.CallOption) (*ListSessionTemplatesResponse, error)
 }
 
 type sessionsClient struct {
@@ -65,6 +103,69 @@ func (c *sessionsClient) RevokeSession(ctx context.Context, in *RevokeSessionReq
 	return out, nil
 }
 
+func (c *sessionsClient) RotateSession(ctx context.Context, in *RotateSessionRequest, opts ...grpc.CallOption) (*RotateSessionResponse, error) {
+	out := new(RotateSessionResponse)
+	err := c.cc.Invoke(ctx, "/litrpc.Sessions/RotateSession", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sessionsClient) PauseSession(ctx context.Context, in *PauseSessionRequest, opts ...grpc.CallOption) (*PauseSessionResponse, error) {
+	out := new(PauseSessionResponse)
+	err := c.cc.Invoke(ctx, "/litrpc.Sessions/PauseSession", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sessionsClient) ResumeSession(ctx context.Context, in *ResumeSessionRequest, opts ...grpc.CallOption) (*ResumeSessionResponse, error) {
+	out := new(ResumeSessionResponse)
+	err := c.cc.Invoke(ctx, "/litrpc.Sessions/ResumeSession", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sessionsClient) AddSessionDevice(ctx context.Context, in *AddSessionDeviceRequest, opts ...grpc.CallOption) (*AddSessionDeviceResponse, error) {
+	out := new(AddSessionDeviceResponse)
+	err := c.cc.Invoke(ctx, "/litrpc.Sessions/AddSessionDevice", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sessionsClient) RevokeSessionDevice(ctx context.Context, in *RevokeSessionDeviceRequest, opts ...grpc.CallOption) (*RevokeSessionDeviceResponse, error) {
+	out := new(RevokeSessionDeviceResponse)
+	err := c.cc.Invoke(ctx, "/litrpc.Sessions/RevokeSessionDevice", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sessionsClient) AddSessionTemplate(ctx context.Context, in *AddSessionTemplateRequest, opts ...grpc.CallOption) (*AddSessionTemplateResponse, error) {
+	out := new(AddSessionTemplateResponse)
+	err := c.cc.Invoke(ctx, "/litrpc.Sessions/AddSessionTemplate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sessionsClient) ListSessionTemplates(ctx context.Context, in *ListSessionTemplatesRequest, opts ...grpc.CallOption) (*ListSessionTemplatesResponse, error) {
+	out := new(ListSessionTemplatesResponse)
+	err := c.cc.Invoke(ctx, "/litrpc.Sessions/ListSessionTemplates", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // SessionsServer is the server API for Sessions service.
 // All implementations must embed UnimplementedSessionsServer
 // for forward compatibility
@@ -73,12 +174,50 @@ type SessionsServer interface {
 	// AddSession adds and starts a new LNC session.
 	AddSession(context.Context, *AddSessionRequest) (*AddSessionResponse, error)
 	// litcli: `sessions list`
-	// ListSessions returns all sessions known to the session store.
+	// ListSessions returns the sessions known to the session store that match the
+	// filters of the request, in the order they were created.
 	ListSessions(context.Context, *ListSessionsRequest) (*ListSessionsResponse, error)
 	// litcli: `sessions revoke`
 	// RevokeSession revokes a single session and also stops it if it is currently
 	// active.
 	RevokeSession(context.Context, *RevokeSessionRequest) (*RevokeSessionResponse, error)
+	// litcli: `sessions rotate`
+	// RotateSession issues a new macaroon for an active session and sets its new
+	// expiry. The old macaroon of the session is invalidated, but the paired
+	// remote public key is kept so that the connected app doesn't need to pair
+	// again. The app receives the new macaroon when it reconnects. If the session
+	// can't be started with the new macaroon, the rotation is rolled back and the
+	// old macaroon stays valid.
+	RotateSession(context.Context, *RotateSessionRequest) (*RotateSessionResponse, error)
+	// litcli: `sessions pause`
+	// PauseSession pauses an active session by stopping its mailbox connection.
+	// The macaroon of a paused session is rejected until the session is resumed.
+	// The session keeps its identity and firewall history. A paused session is
+	// revoked once it reaches its expiry.
+	PauseSession(context.Context, *PauseSessionRequest) (*PauseSessionResponse, error)
+	// litcli: `sessions resume`
+	// ResumeSession resumes a paused session by starting its mailbox connection
+	// again.
+	ResumeSession(context.Context, *ResumeSessionRequest) (*ResumeSessionResponse, error)
+	// litcli: `sessions devices add`
+	// AddSessionDevice adds a device to an existing session. The device gets its
+	// own pairing phrase and connects through its own mailbox, but shares the
+	// macaroon and firewall rules of the session. A session can have up to five
+	// active devices in addition to the app it was paired with.
+	AddSessionDevice(context.Context, *AddSessionDeviceRequest) (*AddSessionDeviceResponse, error)
+	// litcli: `sessions devices revoke`
+	// RevokeSessionDevice revokes a single device of a session and stops its
+	// mailbox connection. The session and its other devices stay usable.
+	RevokeSessionDevice(context.Context, *RevokeSessionDeviceRequest) (*RevokeSessionDeviceResponse, error)
+	// litcli: `sessions templates add`
+	// AddSessionTemplate adds a named session template that stores the session
+	// type, permissions, lifetime and mailbox server to use for new sessions. An
+	// existing template with the same name is replaced.
+	AddSessionTemplate(context.Context, *AddSessionTemplateRequest) (*AddSessionTemplateResponse, error)
+	// litcli: `sessions templates list`
+	// ListSessionTemplates returns all session templates known to the session
+	// store.
+	ListSessionTemplates(context.Context, *ListSessionTemplatesRequest) (*ListSessionTemplatesResponse, error)
 	mustEmbedUnimplementedSessionsServer()
 }
 
@@ -95,6 +234,27 @@ func (UnimplementedSessionsServer) ListSessions(context.Context, *ListSessionsRe
 func (UnimplementedSessionsServer) RevokeSession(context.Context, *RevokeSessionRequest) (*RevokeSessionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RevokeSession not implemented")
 }
+func (UnimplementedSessionsServer) RotateSession(context.Context, *RotateSessionRequest) (*RotateSessionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RotateSession not implemented")
+}
+func (UnimplementedSessionsServer) PauseSession(context.Context, *PauseSessionRequest) (*PauseSessionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PauseSession not implemented")
+}
+func (UnimplementedSessionsServer) ResumeSession(context.Context, *ResumeSessionRequest) (*ResumeSessionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResumeSession not implemented")
+}
+func (UnimplementedSessionsServer) AddSessionDevice(context.Context, *AddSessionDeviceRequest) (*AddSessionDeviceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddSessionDevice not implemented")
+}
+func (UnimplementedSessionsServer) RevokeSessionDevice(context.Context, *RevokeSessionDeviceRequest) (*RevokeSessionDeviceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeSessionDevice not implemented")
+}
+func (UnimplementedSessionsServer) AddSessionTemplate(context.Context, *AddSessionTemplateRequest) (*AddSessionTemplateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddSessionTemplate not implemented")
+}
+func (UnimplementedSessionsServer) ListSessionTemplates(context.Context, *ListSessionTemplatesRequest) (*ListSessionTemplatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSessionTemplates not implemented")
+}
 func (UnimplementedSessionsServer) mustEmbedUnimplementedSessionsServer() {}
 
 // UnsafeSessionsServer may be embedded to opt out of forward compatibility for this service.
@@ -162,6 +322,132 @@ func _Sessions_RevokeSession_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Sessions_RotateSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RotateSessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SessionsServer).RotateSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/litrpc.Sessions/RotateSession",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SessionsServer).RotateSession(ctx, req.(*RotateSessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Sessions_PauseSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PauseSessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SessionsServer).PauseSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/litrpc.Sessions/PauseSession",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SessionsServer).PauseSession(ctx, req.(*PauseSessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Sessions_ResumeSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResumeSessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SessionsServer).ResumeSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/litrpc.Sessions/ResumeSession",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SessionsServer).ResumeSession(ctx, req.(*ResumeSessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Sessions_AddSessionDevice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddSessionDeviceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SessionsServer).AddSessionDevice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/litrpc.Sessions/AddSessionDevice",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SessionsServer).AddSessionDevice(ctx, req.(*AddSessionDeviceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Sessions_RevokeSessionDevice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeSessionDeviceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SessionsServer).RevokeSessionDevice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/litrpc.Sessions/RevokeSessionDevice",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SessionsServer).RevokeSessionDevice(ctx, req.(*RevokeSessionDeviceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Sessions_AddSessionTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddSessionTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SessionsServer).AddSessionTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/litrpc.Sessions/AddSessionTemplate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SessionsServer).AddSessionTemplate(ctx, req.(*AddSessionTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Sessions_ListSessionTemplates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSessionTemplatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SessionsServer).ListSessionTemplates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/litrpc.Sessions/ListSessionTemplates",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SessionsServer).ListSessionTemplates(ctx, req.(*ListSessionTemplatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Sessions_ServiceDesc is the grpc.ServiceDesc for Sessions service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -181,6 +467,34 @@ var Sessions_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RevokeSession",
 			Handler:    _Sessions_RevokeSession_Handler,
 		},
+		{
+			MethodName: "RotateSession",
+			Handler:    _Sessions_RotateSession_Handler,
+		},
+		{
+			MethodName: "PauseSession",
+			Handler:    _Sessions_PauseSession_Handler,
+		},
+		{
+			MethodName: "ResumeSession",
+			Handler:    _Sessions_ResumeSession_Handler,
+		},
+		{
+			MethodName: "AddSessionDevice",
+			Handler:    _Sessions_AddSessionDevice_Handler,
+		},
+		{
+			MethodName: "RevokeSessionDevice",
+			Handler:    _Sessions_RevokeSessionDevice_Handler,
+		},
+		{
+			MethodName: "AddSessionTemplate",
+			Handler:    _Sessions_AddSessionTemplate_Handler,
+		},
+		{
+			MethodName: "ListSessionTemplates",
+			Handler:    _Sessions_ListSessionTemplates_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "lit-sessions.proto",
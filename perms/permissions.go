@@ -20,6 +20,34 @@ var (
 			Entity: "sessions",
 			Action: "write",
 		}},
+		"/litrpc.Sessions/RotateSession": {{
+			Entity: "sessions",
+			Action: "write",
+		}},
+		"/litrpc.Sessions/PauseSession": {{
+			Entity: "sessions",
+			Action: "write",
+		}},
+		"/litrpc.Sessions/ResumeSession": {{
+			Entity: "sessions",
+			Action: "write",
+		}},
+		"/litrpc.Sessions/AddSessionDevice": {{
+			Entity: "sessions",
+			Action: "write",
+		}},
+		"/litrpc.Sessions/RevokeSessionDevice": {{
+			Entity: "sessions",
+			Action: "write",
+		}},
+		"/litrpc.Sessions/AddSessionTemplate": {{
+			Entity: "sessions",
+			Action: "write",
+		}},
+		"/litrpc.Sessions/ListSessionTemplates": {{
+			Entity: "sessions",
+			Action: "read",
+		}},
 		"/litrpc.Accounts/CreateAccount": {{
 			Entity: "account",
 			Action: "write",
@@ -36,6 +64,30 @@ var (
 			Entity: "account",
 			Action: "write",
 		}},
+		"/litrpc.Accounts/ListAccountTransactions": {{
+			Entity: "account",
+			Action: "read",
+		}},
+		"/litrpc.Accounts/TransferBetweenAccounts": {{
+			Entity: "account",
+			Action: "write",
+		}},
+		"/litrpc.Accounts/SubscribeAccountUpdates": {{
+			Entity: "account",
+			Action: "read",
+		}},
+		"/litrpc.Accounts/ListExpiryActions": {{
+			Entity: "account",
+			Action: "read",
+		}},
+		"/litrpc.Accounts/ExportAccounts": {{
+			Entity: "account",
+			Action: "read",
+		}},
+		"/litrpc.Accounts/ImportAccounts": {{
+			Entity: "account",
+			Action: "write",
+		}},
 		"/litrpc.Firewall/ListActions": {{
 			Entity: "actions",
 			Action: "read",
@@ -60,6 +112,14 @@ var (
 			Entity: "privacymap",
 			Action: "read",
 		}},
+		"/litrpc.Firewall/ListPendingApprovals": {{
+			Entity: "approvals",
+			Action: "read",
+		}},
+		"/litrpc.Firewall/ResolveApproval": {{
+			Entity: "approvals",
+			Action: "write",
+		}},
 		"/litrpc.Proxy/StopDaemon": {{
 			Entity: "proxy",
 			Action: "write",
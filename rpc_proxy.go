@@ -161,6 +161,7 @@ type rpcProxy struct {
 
 	macValidator      macaroons.MacaroonValidator
 	superMacValidator session.SuperMacaroonValidator
+	caveatValidator   *session.CaveatValidator
 
 	superMacaroon string
 
@@ -170,15 +171,19 @@ type rpcProxy struct {
 	grpcWebProxy *grpcweb.WrappedGrpcServer
 }
 
-// bakeSuperMac can be used to bake a new super macaroon.
-type bakeSuperMac func(ctx context.Context, rootKeyID uint32) (string, error)
+// bakeSuperMac can be used to bake a new super macaroon with the given
+// additional caveats.
+type bakeSuperMac func(ctx context.Context, rootKeyID uint32,
+	caveats []macaroon.Caveat) (string, error)
 
 // Start creates initial connection to lnd.
 func (p *rpcProxy) Start(lndConn *grpc.ClientConn,
-	bakeSuperMac bakeSuperMac) error {
+	bakeSuperMac bakeSuperMac,
+	caveatValidator *session.CaveatValidator) error {
 
 	p.lndConn = lndConn
 	p.bakeSuperMac = bakeSuperMac
+	p.caveatValidator = caveatValidator
 
 	atomic.CompareAndSwapInt32(&p.started, 0, 1)
 
@@ -235,7 +240,12 @@ func (p *rpcProxy) BakeSuperMacaroon(ctx context.Context,
 		return nil, ErrWaitingToStart
 	}
 
-	superMac, err := p.bakeSuperMac(ctx, req.RootKeyIdSuffix)
+	caveats, err := unmarshalRPCRestrictions(req.Restrictions)
+	if err != nil {
+		return nil, err
+	}
+
+	superMac, err := p.bakeSuperMac(ctx, req.RootKeyIdSuffix, caveats)
 	if err != nil {
 		return nil, err
 	}
@@ -592,11 +602,46 @@ func (p *rpcProxy) convertSuperMacaroon(ctx context.Context, macHex string,
 		return nil, err
 	}
 
+	if !p.hasStarted() {
+		return nil, ErrWaitingToStart
+	}
+
+	// The source of the request is only known here, so the restrictions
+	// of the macaroon are checked before the request is forwarded.
+	mac := &macaroon.Macaroon{}
+	if err := mac.UnmarshalBinary(macBytes); err != nil {
+		return nil, err
+	}
+	err = p.caveatValidator.CheckRestrictions(ctx, mac)
+	if err != nil {
+		return nil, err
+	}
+
 	// Is this actually a request that goes to a daemon that is running
 	// remotely?
-	handled, macBytes, err := p.subServerMgr.ReadRemoteMacaroon(fullMethod)
+	handled, remoteMac, err := p.subServerMgr.ReadRemoteMacaroon(
+		fullMethod,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// Requests for lnd and remote daemons aren't validated by LiT again,
+	// so they are counted here. Requests for lnd are also announced to
+	// the RPC middleware, which rejects restricted requests that didn't
+	// go through the proxy.
+	isLnd := p.permsMgr.IsSubServerURI(subservers.LND, fullMethod)
+	if handled || isLnd {
+		if err := p.caveatValidator.CountCall(mac); err != nil {
+			return nil, err
+		}
+	}
+	if isLnd {
+		p.caveatValidator.ExpectForwarded(macBytes, fullMethod)
+	}
+
 	if handled {
-		return macBytes, err
+		return remoteMac, nil
 	}
 
 	return nil, nil
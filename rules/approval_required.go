@@ -0,0 +1,427 @@
+package rules
+
+import (
+	"context"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/lightninglabs/lightning-terminal/firewalldb"
+	"github.com/lightninglabs/lightning-terminal/litrpc"
+	mid "github.com/lightninglabs/lightning-terminal/rpcmiddleware"
+	"github.com/lightninglabs/lndclient"
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/lightningnetwork/lnd/lnrpc/routerrpc"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+var (
+	// Compile-time checks to ensure that ApprovalRequired,
+	// ApprovalRequiredMgr and ApprovalRequiredEnforcer implement the
+	// appropriate Manager, Enforcer and Values interface.
+	_ Manager  = (*ApprovalRequiredMgr)(nil)
+	_ Enforcer = (*ApprovalRequiredEnforcer)(nil)
+	_ Values   = (*ApprovalRequired)(nil)
+)
+
+// ApprovalRequiredName is the string identifier of the ApprovalRequired rule.
+const ApprovalRequiredName = "approval-required"
+
+// approvalPollInterval is the interval at which a parked request checks if the
+// operator approved or denied it.
+var approvalPollInterval = 100 * time.Millisecond
+
+// ApprovalRequiredMgr manages the ApprovalRequired rule.
+type ApprovalRequiredMgr struct{}
+
+// Stop cleans up the resources held by the manager.
+//
+// NOTE: This is part of the Manager interface.
+func (a *ApprovalRequiredMgr) Stop() error {
+	return nil
+}
+
+// NewEnforcer constructs a new ApprovalRequired rule enforcer using the passed
+// values and config.
+//
+// NOTE: This is part of the Manager interface.
+func (a *ApprovalRequiredMgr) NewEnforcer(cfg Config, values Values) (Enforcer,
+	error) {
+
+	approvalRequired, ok := values.(*ApprovalRequired)
+	if !ok {
+		return nil, fmt.Errorf("values must be of type "+
+			"ApprovalRequired, got %T", values)
+	}
+
+	return &ApprovalRequiredEnforcer{
+		approvalRequiredConfig: cfg,
+		ApprovalRequired:       approvalRequired,
+	}, nil
+}
+
+// NewValueFromProto converts the given proto value into an ApprovalRequired
+// Value object.
+//
+// NOTE: This is part of the Manager interface.
+func (a *ApprovalRequiredMgr) NewValueFromProto(v *litrpc.RuleValue) (Values,
+	error) {
+
+	rv, ok := v.Value.(*litrpc.RuleValue_ApprovalRequired)
+	if !ok {
+		return nil, fmt.Errorf("incorrect RuleValue type")
+	}
+
+	approvalRequired := rv.ApprovalRequired
+	if approvalRequired.FeeRateThresholdPpm == 0 &&
+		approvalRequired.PaymentThresholdSats == 0 {
+
+		return nil, fmt.Errorf("approval thresholds cannot all be " +
+			"zero. If no request requires an approval then there " +
+			"is no need to add the rule")
+	}
+
+	return &ApprovalRequired{
+		FeeRateThresholdPPM:  approvalRequired.FeeRateThresholdPpm,
+		PaymentThresholdSats: approvalRequired.PaymentThresholdSats,
+	}, nil
+}
+
+// EmptyValue returns a new ApprovalRequired instance.
+//
+// NOTE: This is part of the Manager interface.
+func (a *ApprovalRequiredMgr) EmptyValue() Values {
+	return &ApprovalRequired{}
+}
+
+// approvalRequiredConfig is the config required by ApprovalRequiredMgr. It can
+// be derived from the main rules Config struct.
+type approvalRequiredConfig interface {
+	GetLndClient() lndclient.LightningClient
+	GetApprovalsDB() firewalldb.ApprovalsDB
+	GetApprovalTimeout() time.Duration
+}
+
+// ApprovalRequiredEnforcer enforces requests and responses against an
+// ApprovalRequired rule.
+//
+// A request that exceeds one of the thresholds is parked until the operator
+// approves or denies it. If the operator doesn't resolve it within the
+// approval timeout, the request is rejected with a retry token. Once the
+// operator approved it, the same request is let through when it is retried.
+type ApprovalRequiredEnforcer struct {
+	approvalRequiredConfig
+	*ApprovalRequired
+}
+
+// HandleRequest checks the validity of a request using the ApprovalRequired
+// rpcmiddleware.RoundTripCheckers. A request that exceeds one of the
+// thresholds is only accepted once the operator approved it.
+//
+// NOTE: this is part of the Enforcer interface.
+func (a *ApprovalRequiredEnforcer) HandleRequest(ctx context.Context,
+	uri string, msg proto.Message) (proto.Message, error) {
+
+	checker, ok := a.checkers()[uri]
+	if !ok {
+		return nil, nil
+	}
+
+	if !checker.HandlesRequest(msg.ProtoReflect().Type()) {
+		return nil, fmt.Errorf("invalid implementation, checker for "+
+			"URI %s does not accept request of type %v", uri,
+			msg.ProtoReflect().Type())
+	}
+
+	return checker.HandleRequest(ctx, msg)
+}
+
+// HandleResponse handles and possible alters a response. This is a noop for
+// the ApprovalRequired rule.
+//
+// NOTE: this is part of the Enforcer interface.
+func (a *ApprovalRequiredEnforcer) HandleResponse(_ context.Context,
+	_ string, _ proto.Message) (proto.Message, error) {
+
+	return nil, nil
+}
+
+// HandleErrorResponse handles and possible alters an error. This is a noop for
+// the ApprovalRequired rule.
+//
+// NOTE: this is part of the Enforcer interface.
+func (a *ApprovalRequiredEnforcer) HandleErrorResponse(_ context.Context,
+	_ string, _ error) (error, error) {
+
+	return nil, nil
+}
+
+// checkers returns a map of URI to rpcmiddleware.RoundTripChecker which define
+// how the URI should be handled.
+func (a *ApprovalRequiredEnforcer) checkers() map[string]mid.RoundTripChecker {
+	return map[string]mid.RoundTripChecker{
+		"/lnrpc.Lightning/UpdateChannelPolicy": mid.NewRequestChecker(
+			&lnrpc.PolicyUpdateRequest{},
+			&lnrpc.PolicyUpdateResponse{},
+			func(ctx context.Context,
+				r *lnrpc.PolicyUpdateRequest) error {
+
+				return a.checkPolicyUpdate(ctx, r)
+			},
+		),
+		"/routerrpc.Router/SendPaymentV2": mid.NewRequestChecker(
+			&routerrpc.SendPaymentRequest{},
+			&lnrpc.Payment{},
+			func(ctx context.Context,
+				r *routerrpc.SendPaymentRequest) error {
+
+				amt, _, err := paymentAmounts(
+					ctx, a.GetLndClient(), r,
+				)
+				if err != nil {
+					return err
+				}
+
+				return a.checkPayment(
+					ctx, "/routerrpc.Router/SendPaymentV2",
+					r, amt,
+				)
+			},
+		),
+		"/routerrpc.Router/SendToRouteV2": mid.NewRequestChecker(
+			&routerrpc.SendToRouteRequest{},
+			&lnrpc.HTLCAttempt{},
+			func(ctx context.Context,
+				r *routerrpc.SendToRouteRequest) error {
+
+				if r.Route == nil {
+					return fmt.Errorf("route is required")
+				}
+
+				amt, _ := routeAmounts(r.Route)
+
+				return a.checkPayment(
+					ctx, "/routerrpc.Router/SendToRouteV2",
+					r, amt,
+				)
+			},
+		),
+	}
+}
+
+// checkPolicyUpdate requires an approval for the given policy update if its
+// fee rate exceeds the fee rate threshold.
+func (a *ApprovalRequiredEnforcer) checkPolicyUpdate(ctx context.Context,
+	r *lnrpc.PolicyUpdateRequest) error {
+
+	feeRate := r.FeeRatePpm
+	if r.FeeRate != 0 {
+		feeRate = uint32(math.Round(r.FeeRate * 1000000))
+	}
+
+	if a.FeeRateThresholdPPM == 0 || feeRate <= a.FeeRateThresholdPPM {
+		return nil
+	}
+
+	return a.requireApproval(
+		ctx, "/lnrpc.Lightning/UpdateChannelPolicy", r,
+		fmt.Sprintf("fee rate of %d ppm exceeds the approval "+
+			"threshold of %d ppm", feeRate, a.FeeRateThresholdPPM),
+	)
+}
+
+// checkPayment requires an approval for the given payment request if its
+// amount in msat exceeds the payment threshold.
+func (a *ApprovalRequiredEnforcer) checkPayment(ctx context.Context,
+	uri string, msg proto.Message, amtMsat uint64) error {
+
+	if a.PaymentThresholdSats == 0 ||
+		amtMsat <= a.PaymentThresholdSats*1000 {
+
+		return nil
+	}
+
+	return a.requireApproval(
+		ctx, uri, msg, fmt.Sprintf("payment amount of %d sats "+
+			"exceeds the approval threshold of %d sats",
+			amtMsat/1000, a.PaymentThresholdSats),
+	)
+}
+
+// requireApproval parks the given request until the operator approves or
+// denies it. If the operator doesn't resolve it within the approval timeout,
+// an error containing the ID of the approval is returned which serves as a
+// retry token. Retrying the same request once it was approved lets it
+// through. The given reason describes why the request requires an approval.
+func (a *ApprovalRequiredEnforcer) requireApproval(ctx context.Context,
+	uri string, msg proto.Message, reason string) error {
+
+	// The request is recognised by its hash when it is retried, so the
+	// deterministic serialization is used.
+	reqBytes, err := proto.MarshalOptions{Deterministic: true}.Marshal(msg)
+	if err != nil {
+		return err
+	}
+	reqHash := sha256.Sum256(append([]byte(uri), reqBytes...))
+
+	db := a.GetApprovalsDB()
+	approval, err := db.ClaimApproval(ctx, reqHash)
+	switch {
+	case errors.Is(err, firewalldb.ErrNoSuchKeyFound):
+		paramsJson, err := protojson.Marshal(msg)
+		if err != nil {
+			return err
+		}
+
+		id, err := db.AddApproval(ctx, uri, paramsJson, reqHash, reason)
+		if err != nil {
+			return err
+		}
+
+		approval = &firewalldb.Approval{
+			ID:    id,
+			State: firewalldb.ApprovalStatePending,
+		}
+
+	case err != nil:
+		return err
+	}
+
+	timeout := time.NewTimer(a.GetApprovalTimeout())
+	defer timeout.Stop()
+
+	for {
+		switch approval.State {
+		case firewalldb.ApprovalStateReleased:
+			return nil
+
+		case firewalldb.ApprovalStateDenied:
+			return fmt.Errorf("%s and the request was denied by "+
+				"the operator (approval %d)", reason,
+				approval.ID)
+		}
+
+		select {
+		case <-time.After(approvalPollInterval):
+		case <-timeout.C:
+			return fmt.Errorf("%s and the request is waiting for "+
+				"the approval of the operator. Retry the same "+
+				"request once it was approved (retry token %d)",
+				reason, approval.ID)
+
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+
+		id := approval.ID
+		approval, err = db.ClaimApproval(ctx, reqHash)
+		if errors.Is(err, firewalldb.ErrNoSuchKeyFound) {
+			return fmt.Errorf("approval %d was used by another "+
+				"request", id)
+		} else if err != nil {
+			return err
+		}
+	}
+}
+
+// ApprovalRequired represents the approval-required rule values.
+type ApprovalRequired struct {
+	// FeeRateThresholdPPM is the fee rate in ppm above which channel policy
+	// updates must be approved by the operator. If it is zero, policy
+	// updates don't require an approval.
+	FeeRateThresholdPPM uint32 `json:"fee_rate_threshold_ppm"`
+
+	// PaymentThresholdSats is the amount in sats above which off-chain
+	// payments must be approved by the operator. If it is zero, payments
+	// don't require an approval.
+	PaymentThresholdSats uint64 `json:"payment_threshold_sats"`
+}
+
+// VerifySane checks that the value of the values is ok given the min and max
+// allowed values.
+//
+// NOTE: this is part of the Values interface.
+func (a *ApprovalRequired) VerifySane(minVal, maxVal Values) error {
+	minAR, ok := minVal.(*ApprovalRequired)
+	if !ok {
+		return fmt.Errorf("min value is not of type ApprovalRequired")
+	}
+
+	maxAR, ok := maxVal.(*ApprovalRequired)
+	if !ok {
+		return fmt.Errorf("max value is not of type ApprovalRequired")
+	}
+
+	// A zero threshold means that no approval is required, which is only
+	// allowed if the max value doesn't require one either.
+	if a.FeeRateThresholdPPM == 0 && maxAR.FeeRateThresholdPPM != 0 {
+		return fmt.Errorf("a fee rate threshold is required")
+	}
+
+	if a.FeeRateThresholdPPM != 0 && !between(
+		uint64(a.FeeRateThresholdPPM),
+		uint64(minAR.FeeRateThresholdPPM),
+		uint64(maxAR.FeeRateThresholdPPM),
+	) {
+
+		return fmt.Errorf("fee rate threshold is not between the min " +
+			"and max")
+	}
+
+	if a.PaymentThresholdSats == 0 && maxAR.PaymentThresholdSats != 0 {
+		return fmt.Errorf("a payment threshold is required")
+	}
+
+	if a.PaymentThresholdSats != 0 && !between(a.PaymentThresholdSats,
+		minAR.PaymentThresholdSats, maxAR.PaymentThresholdSats) {
+
+		return fmt.Errorf("payment threshold is not between the min " +
+			"and max")
+	}
+
+	return nil
+}
+
+// RuleName returns the name of the rule that these values are to be used with.
+//
+// NOTE: this is part of the Values interface.
+func (a *ApprovalRequired) RuleName() string {
+	return ApprovalRequiredName
+}
+
+// ToProto converts the rule Values to the litrpc counterpart.
+//
+// NOTE: this is part of the Values interface.
+func (a *ApprovalRequired) ToProto() *litrpc.RuleValue {
+	return &litrpc.RuleValue{
+		Value: &litrpc.RuleValue_ApprovalRequired{
+			ApprovalRequired: &litrpc.ApprovalRequired{
+				FeeRateThresholdPpm:  a.FeeRateThresholdPPM,
+				PaymentThresholdSats: a.PaymentThresholdSats,
+			},
+		},
+	}
+}
+
+// PseudoToReal attempts to convert any appropriate pseudo fields in the rule
+// Values to their corresponding real values. It uses the passed PrivacyMapDB to
+// find the real values. This is a no-op for the ApprovalRequired rule.
+//
+// NOTE: this is part of the Values interface.
+func (a *ApprovalRequired) PseudoToReal(_ firewalldb.PrivacyMapDB) (Values,
+	error) {
+
+	return a, nil
+}
+
+// RealToPseudo converts the rule Values to a new one that uses pseudo keys,
+// channel IDs, channel points etc. It returns a map of real to pseudo strings
+// that should be persisted. This is a no-op for the ApprovalRequired rule.
+//
+// NOTE: this is part of the Values interface.
+func (a *ApprovalRequired) RealToPseudo() (Values, map[string]string, error) {
+	return a, nil, nil
+}
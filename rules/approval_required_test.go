@@ -0,0 +1,227 @@
+package rules
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/lightninglabs/lightning-terminal/firewalldb"
+	"github.com/lightninglabs/lndclient"
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/lightningnetwork/lnd/lnrpc/routerrpc"
+	"github.com/stretchr/testify/require"
+)
+
+// TestApprovalRequiredCheckValues tests that the ApprovalRequired values
+// correctly implement the VerifySane method.
+func TestApprovalRequiredCheckValues(t *testing.T) {
+	minVal := &ApprovalRequired{
+		FeeRateThresholdPPM:  100,
+		PaymentThresholdSats: 1000,
+	}
+	maxVal := &ApprovalRequired{
+		FeeRateThresholdPPM: 5000,
+	}
+
+	tests := []struct {
+		name      string
+		values    *ApprovalRequired
+		expectErr error
+	}{
+		{
+			name: "valid",
+			values: &ApprovalRequired{
+				FeeRateThresholdPPM:  1000,
+				PaymentThresholdSats: 100000,
+			},
+		},
+		{
+			name: "fee rate threshold required",
+			values: &ApprovalRequired{
+				PaymentThresholdSats: 100000,
+			},
+			expectErr: fmt.Errorf("a fee rate threshold is " +
+				"required"),
+		},
+		{
+			name: "fee rate threshold too large",
+			values: &ApprovalRequired{
+				FeeRateThresholdPPM: 6000,
+			},
+			expectErr: fmt.Errorf("fee rate threshold is not " +
+				"between the min and max"),
+		},
+		{
+			name: "payment threshold too small",
+			values: &ApprovalRequired{
+				FeeRateThresholdPPM:  1000,
+				PaymentThresholdSats: 500,
+			},
+			expectErr: fmt.Errorf("payment threshold is not " +
+				"between the min and max"),
+		},
+	}
+
+	for _, test := range tests {
+		err := test.values.VerifySane(minVal, maxVal)
+		if test.expectErr == nil {
+			require.NoError(t, err, test.name)
+			continue
+		}
+
+		require.EqualError(t, err, test.expectErr.Error(), test.name)
+	}
+}
+
+// TestApprovalRequired tests that the ApprovalRequiredEnforcer parks requests
+// that exceed the thresholds until the operator approves or denies them.
+func TestApprovalRequired(t *testing.T) {
+	const updateChanPolicyURI = "/lnrpc.Lightning/UpdateChannelPolicy"
+
+	ctx := context.Background()
+
+	db, err := firewalldb.NewDB(t.TempDir(), "test.db")
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_ = db.Close()
+	})
+
+	pollInterval := approvalPollInterval
+	approvalPollInterval = 10 * time.Millisecond
+	t.Cleanup(func() {
+		approvalPollInterval = pollInterval
+	})
+
+	cfg := &mockApprovalRequiredCfg{
+		approvals: db.GetApprovalsDB([4]byte{1, 2, 3, 4}, "feature"),
+		timeout:   50 * time.Millisecond,
+	}
+
+	mgr := &ApprovalRequiredMgr{}
+	enf, err := mgr.NewEnforcer(cfg, &ApprovalRequired{
+		FeeRateThresholdPPM:  1000,
+		PaymentThresholdSats: 50000,
+	})
+	require.NoError(t, err)
+
+	requirePending := func(n int) []*firewalldb.Approval {
+		pending, err := db.ListApprovals(
+			firewalldb.ApprovalStatePending,
+		)
+		require.NoError(t, err)
+		require.Len(t, pending, n)
+
+		return pending
+	}
+
+	// Requests below the thresholds are accepted right away.
+	_, err = enf.HandleRequest(
+		ctx, updateChanPolicyURI, &lnrpc.PolicyUpdateRequest{
+			FeeRatePpm: 1000,
+		},
+	)
+	require.NoError(t, err)
+
+	_, err = enf.HandleRequest(
+		ctx, sendPaymentURI, &routerrpc.SendPaymentRequest{
+			Amt: 50000,
+		},
+	)
+	require.NoError(t, err)
+	requirePending(0)
+
+	// A request above a threshold is parked and fails closed with a retry
+	// token once the approval timeout is reached.
+	feeUpdate := &lnrpc.PolicyUpdateRequest{
+		FeeRate: 0.002,
+	}
+	_, err = enf.HandleRequest(ctx, updateChanPolicyURI, feeUpdate)
+	require.ErrorContains(t, err, "fee rate of 2000 ppm exceeds the "+
+		"approval threshold of 1000 ppm")
+	require.ErrorContains(t, err, "retry token 1")
+
+	pending := requirePending(1)
+	require.Equal(t, updateChanPolicyURI, pending[0].RPCMethod)
+
+	// Retrying the request before it is resolved doesn't park it again.
+	_, err = enf.HandleRequest(ctx, updateChanPolicyURI, feeUpdate)
+	require.ErrorContains(t, err, "retry token 1")
+	requirePending(1)
+
+	// Once approved, the request is let through exactly once.
+	require.NoError(t, db.ResolveApproval(1, true))
+
+	_, err = enf.HandleRequest(ctx, updateChanPolicyURI, feeUpdate)
+	require.NoError(t, err)
+
+	_, err = enf.HandleRequest(ctx, updateChanPolicyURI, feeUpdate)
+	require.ErrorContains(t, err, "retry token 2")
+
+	// A denied request is rejected.
+	require.NoError(t, db.ResolveApproval(2, false))
+
+	_, err = enf.HandleRequest(ctx, updateChanPolicyURI, feeUpdate)
+	require.ErrorContains(t, err, "denied by the operator")
+	requirePending(0)
+
+	// A request that is approved while it is parked is let through
+	// without a retry.
+	cfg.timeout = 5 * time.Second
+
+	errChan := make(chan error, 1)
+	go func() {
+		_, err := enf.HandleRequest(
+			ctx, sendToRouteURI, &routerrpc.SendToRouteRequest{
+				Route: &lnrpc.Route{
+					TotalAmtMsat:  60001000,
+					TotalFeesMsat: 1000,
+				},
+			},
+		)
+		errChan <- err
+	}()
+
+	require.Eventually(t, func() bool {
+		pending, err := db.ListApprovals(
+			firewalldb.ApprovalStatePending,
+		)
+		require.NoError(t, err)
+
+		return len(pending) == 1
+	}, time.Second, 10*time.Millisecond)
+
+	pending = requirePending(1)
+	require.Equal(t, "payment amount of 60000 sats exceeds the approval "+
+		"threshold of 50000 sats", pending[0].Reason)
+	require.NoError(t, db.ResolveApproval(pending[0].ID, true))
+
+	select {
+	case err := <-errChan:
+		require.NoError(t, err)
+
+	case <-time.After(time.Second):
+		t.Fatalf("request was not released")
+	}
+}
+
+// mockApprovalRequiredCfg is used to mock the config backend given to the
+// ApprovalRequiredEnforcer during testing.
+type mockApprovalRequiredCfg struct {
+	Config
+
+	approvals firewalldb.ApprovalsDB
+	timeout   time.Duration
+}
+
+func (m *mockApprovalRequiredCfg) GetApprovalsDB() firewalldb.ApprovalsDB {
+	return m.approvals
+}
+
+func (m *mockApprovalRequiredCfg) GetApprovalTimeout() time.Duration {
+	return m.timeout
+}
+
+func (m *mockApprovalRequiredCfg) GetLndClient() lndclient.LightningClient {
+	return nil
+}
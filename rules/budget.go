@@ -0,0 +1,228 @@
+package rules
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/lightninglabs/lightning-terminal/firewalldb"
+)
+
+// budgetSpendsKey is the key under which the spends of a budget rule are
+// stored in the local kv store of the rule.
+const budgetSpendsKey = "spends"
+
+// budgetSpend is an amount that was spent or is being spent by a single call.
+// The unit of the amounts is defined by the rule that records the spend.
+type budgetSpend struct {
+	// ReqID is the ID of the request that made the spend. It is used to
+	// find the spend again once the response for the request arrives.
+	ReqID int64 `json:"req_id"`
+
+	// Time is the time at which the spend was made. For a spend that is
+	// still in flight, this is the time of the request.
+	Time time.Time `json:"time"`
+
+	// Amt is the amount that was spent, excluding fees.
+	Amt uint64 `json:"amt"`
+
+	// Fees is the amount that was spent on fees.
+	Fees uint64 `json:"fees"`
+
+	// InFlight is true if the outcome of the call isn't known yet. The
+	// amounts of an in-flight spend are the maximum amounts the call can
+	// spend.
+	InFlight bool `json:"in_flight"`
+}
+
+// budgetLedger holds the spends of a budget rule. To keep the ledger small,
+// settled spends that were made before the window of the budget are merged
+// into one when the ledger is saved. They still count towards the lifetime
+// total of the ledger but no longer towards the total of the window.
+type budgetLedger struct {
+	spends []*budgetSpend
+}
+
+// updateBudgetLedger loads the budget ledger from the local kv store of the
+// given stores, passes it to the given function and saves it again, all within
+// a single transaction. If the function returns an error, the ledger is left
+// unchanged. The given window is the window of the budget, which is zero if
+// the budget applies to the whole lifetime of the session.
+func updateBudgetLedger(ctx context.Context, stores firewalldb.KVStores,
+	window time.Duration,
+	f func(ledger *budgetLedger, now time.Time) error) error {
+
+	return stores.Update(func(tx firewalldb.KVStoreTx) error {
+		now := time.Now()
+		ledger, err := loadBudgetLedger(ctx, tx.Local())
+		if err != nil {
+			return err
+		}
+
+		if err := f(ledger, now); err != nil {
+			return err
+		}
+
+		return ledger.save(ctx, tx.Local(), windowStart(now, window))
+	})
+}
+
+// windowStart returns the start of the window of a budget with the given
+// window duration. If the duration is zero, the budget applies to the whole
+// lifetime of the session and the zero time is returned.
+func windowStart(now time.Time, window time.Duration) time.Time {
+	if window == 0 {
+		return time.Time{}
+	}
+
+	return now.Add(-window)
+}
+
+// loadBudgetLedger loads the budget ledger from the given kv store.
+func loadBudgetLedger(ctx context.Context,
+	store firewalldb.KVStore) (*budgetLedger, error) {
+
+	ledger := &budgetLedger{}
+
+	b, err := store.Get(ctx, budgetSpendsKey)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(b) == 0 {
+		return ledger, nil
+	}
+
+	if err := json.Unmarshal(b, &ledger.spends); err != nil {
+		return nil, err
+	}
+
+	return ledger, nil
+}
+
+// save stores the budget ledger in the given kv store. All settled spends that
+// were made before the given start of the window are merged into one. If the
+// start is the zero time, all settled spends are merged.
+func (l *budgetLedger) save(ctx context.Context, store firewalldb.KVStore,
+	start time.Time) error {
+
+	var (
+		spends []*budgetSpend
+		merged *budgetSpend
+	)
+	for _, spend := range l.spends {
+		if spend.InFlight ||
+			(!start.IsZero() && !spend.Time.Before(start)) {
+
+			spends = append(spends, spend)
+			continue
+		}
+
+		if merged == nil {
+			merged = &budgetSpend{}
+			spends = append(spends, merged)
+		}
+		merged.Amt += spend.Amt
+		merged.Fees += spend.Fees
+		if spend.Time.After(merged.Time) {
+			merged.Time = spend.Time
+		}
+	}
+
+	b, err := json.Marshal(spends)
+	if err != nil {
+		return err
+	}
+
+	return store.Set(ctx, budgetSpendsKey, b)
+}
+
+// total returns the sum of the amounts and fees of all spends that were made
+// since the given time, including the ones still in flight. In-flight spends
+// always count, no matter how long ago they were made.
+func (l *budgetLedger) total(since time.Time) (uint64, uint64) {
+	var amt, fees uint64
+	for _, spend := range l.spends {
+		if !spend.InFlight && spend.Time.Before(since) {
+			continue
+		}
+
+		amt += spend.Amt
+		fees += spend.Fees
+	}
+
+	return amt, fees
+}
+
+// reserve adds an in-flight spend for the request with the given ID.
+func (l *budgetLedger) reserve(reqID int64, amt, fees uint64, now time.Time) {
+	l.spends = append(l.spends, &budgetSpend{
+		ReqID:    reqID,
+		Time:     now,
+		Amt:      amt,
+		Fees:     fees,
+		InFlight: true,
+	})
+}
+
+// settle marks the in-flight spend of the request with the given ID as
+// settled with the given final amounts. It returns false if there is no such
+// spend.
+func (l *budgetLedger) settle(reqID int64, amt, fees uint64,
+	now time.Time) bool {
+
+	spend := l.inFlight(reqID)
+	if spend == nil {
+		return false
+	}
+
+	spend.Time = now
+	spend.Amt = amt
+	spend.Fees = fees
+	spend.InFlight = false
+
+	return true
+}
+
+// confirm marks the in-flight spend of the request with the given ID as
+// settled with the amounts that were reserved for it. It returns false if
+// there is no such spend.
+func (l *budgetLedger) confirm(reqID int64, now time.Time) bool {
+	spend := l.inFlight(reqID)
+	if spend == nil {
+		return false
+	}
+
+	spend.Time = now
+	spend.InFlight = false
+
+	return true
+}
+
+// release removes the in-flight spend of the request with the given ID. It
+// returns false if there is no such spend.
+func (l *budgetLedger) release(reqID int64) bool {
+	for idx, spend := range l.spends {
+		if !spend.InFlight || spend.ReqID != reqID {
+			continue
+		}
+
+		l.spends = append(l.spends[:idx], l.spends[idx+1:]...)
+
+		return true
+	}
+
+	return false
+}
+
+// inFlight returns the in-flight spend of the request with the given ID or
+// nil if there is no such spend.
+func (l *budgetLedger) inFlight(reqID int64) *budgetSpend {
+	for _, spend := range l.spends {
+		if spend.InFlight && spend.ReqID == reqID {
+			return spend
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,546 @@
+package rules
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/lightninglabs/lightning-terminal/firewalldb"
+	"github.com/lightninglabs/lightning-terminal/litrpc"
+	mid "github.com/lightninglabs/lightning-terminal/rpcmiddleware"
+	"github.com/lightninglabs/lndclient"
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"google.golang.org/protobuf/proto"
+)
+
+var (
+	// Compile-time checks to ensure that ChannelOpenPolicy,
+	// ChannelOpenPolicyMgr and ChannelOpenPolicyEnforcer implement the
+	// appropriate Manager, Enforcer and Values interface.
+	_ Manager  = (*ChannelOpenPolicyMgr)(nil)
+	_ Enforcer = (*ChannelOpenPolicyEnforcer)(nil)
+	_ Values   = (*ChannelOpenPolicy)(nil)
+)
+
+// ChannelOpenPolicyName is the string identifier of the ChannelOpenPolicy
+// rule.
+const ChannelOpenPolicyName = "channel-open-policy"
+
+// ChannelVisibility defines whether the channels opened under a
+// ChannelOpenPolicy must be private or public.
+type ChannelVisibility uint8
+
+const (
+	// ChannelVisibilityAny allows both private and public channels.
+	ChannelVisibilityAny ChannelVisibility = iota
+
+	// ChannelVisibilityPrivate only allows private channels.
+	ChannelVisibilityPrivate
+
+	// ChannelVisibilityPublic only allows public channels.
+	ChannelVisibilityPublic
+)
+
+// ChannelOpenPolicyMgr manages the ChannelOpenPolicy rule.
+type ChannelOpenPolicyMgr struct{}
+
+// Stop cleans up the resources held by the manager.
+//
+// NOTE: This is part of the Manager interface.
+func (c *ChannelOpenPolicyMgr) Stop() error {
+	return nil
+}
+
+// NewEnforcer constructs a new ChannelOpenPolicy rule enforcer using the
+// passed values and config.
+//
+// NOTE: This is part of the Manager interface.
+func (c *ChannelOpenPolicyMgr) NewEnforcer(cfg Config, values Values) (
+	Enforcer, error) {
+
+	policy, ok := values.(*ChannelOpenPolicy)
+	if !ok {
+		return nil, fmt.Errorf("values must be of type "+
+			"ChannelOpenPolicy, got %T", values)
+	}
+
+	allowMap := make(map[string]bool, len(policy.AllowList))
+	for _, peerID := range policy.AllowList {
+		allowMap[peerID] = true
+	}
+
+	denyMap := make(map[string]bool, len(policy.DenyList))
+	for _, peerID := range policy.DenyList {
+		denyMap[peerID] = true
+	}
+
+	return &ChannelOpenPolicyEnforcer{
+		channelOpenPolicyConfig: cfg,
+		ChannelOpenPolicy:       policy,
+		allowMap:                allowMap,
+		denyMap:                 denyMap,
+	}, nil
+}
+
+// NewValueFromProto converts the given proto value into a ChannelOpenPolicy
+// Value object.
+//
+// NOTE: This is part of the Manager interface.
+func (c *ChannelOpenPolicyMgr) NewValueFromProto(v *litrpc.RuleValue) (Values,
+	error) {
+
+	rv, ok := v.Value.(*litrpc.RuleValue_ChannelOpenPolicy)
+	if !ok {
+		return nil, fmt.Errorf("incorrect RuleValue type")
+	}
+
+	policy := rv.ChannelOpenPolicy
+
+	var visibility ChannelVisibility
+	switch policy.Visibility {
+	case litrpc.ChannelVisibility_CHANNEL_VISIBILITY_ANY:
+		visibility = ChannelVisibilityAny
+
+	case litrpc.ChannelVisibility_CHANNEL_VISIBILITY_PRIVATE:
+		visibility = ChannelVisibilityPrivate
+
+	case litrpc.ChannelVisibility_CHANNEL_VISIBILITY_PUBLIC:
+		visibility = ChannelVisibilityPublic
+
+	default:
+		return nil, fmt.Errorf("unknown channel visibility %v",
+			policy.Visibility)
+	}
+
+	return &ChannelOpenPolicy{
+		MinCapacitySats: policy.MinCapacitySats,
+		MaxCapacitySats: policy.MaxCapacitySats,
+		MaxPendingOpens: policy.MaxPendingOpens,
+		AllowList:       policy.AllowedPeerIds,
+		DenyList:        policy.DeniedPeerIds,
+		AllowPushAmt:    policy.AllowPushAmt,
+		Visibility:      visibility,
+	}, nil
+}
+
+// EmptyValue returns a new ChannelOpenPolicy instance.
+//
+// NOTE: This is part of the Manager interface.
+func (c *ChannelOpenPolicyMgr) EmptyValue() Values {
+	return &ChannelOpenPolicy{}
+}
+
+// channelOpenPolicyConfig is the config required by ChannelOpenPolicyMgr. It
+// can be derived from the main rules Config struct.
+type channelOpenPolicyConfig interface {
+	GetLndClient() lndclient.LightningClient
+}
+
+// ChannelOpenPolicyEnforcer enforces requests and responses against a
+// ChannelOpenPolicy rule.
+type ChannelOpenPolicyEnforcer struct {
+	channelOpenPolicyConfig
+	*ChannelOpenPolicy
+
+	allowMap map[string]bool
+	denyMap  map[string]bool
+}
+
+// HandleRequest checks the validity of a request using the ChannelOpenPolicy
+// rpcmiddleware.RoundTripCheckers.
+//
+// NOTE: this is part of the Enforcer interface.
+func (c *ChannelOpenPolicyEnforcer) HandleRequest(ctx context.Context,
+	uri string, msg proto.Message) (proto.Message, error) {
+
+	checker, ok := c.checkers()[uri]
+	if !ok {
+		return nil, nil
+	}
+
+	if !checker.HandlesRequest(msg.ProtoReflect().Type()) {
+		return nil, fmt.Errorf("invalid implementation, checker for "+
+			"URI %s does not accept request of type %v", uri,
+			msg.ProtoReflect().Type())
+	}
+
+	return checker.HandleRequest(ctx, msg)
+}
+
+// HandleResponse handles a response using the ChannelOpenPolicy
+// rpcmiddleware.RoundTripCheckers.
+//
+// NOTE: this is part of the Enforcer interface.
+func (c *ChannelOpenPolicyEnforcer) HandleResponse(ctx context.Context,
+	uri string, msg proto.Message) (proto.Message, error) {
+
+	checker, ok := c.checkers()[uri]
+	if !ok {
+		return nil, nil
+	}
+
+	if !checker.HandlesResponse(msg.ProtoReflect().Type()) {
+		return nil, fmt.Errorf("invalid implementation, checker for "+
+			"URI %s does not accept response of type %v", uri,
+			msg.ProtoReflect().Type())
+	}
+
+	return checker.HandleResponse(ctx, msg)
+}
+
+// HandleErrorResponse handles and possible alters an error. This is a noop for
+// the ChannelOpenPolicy rule.
+//
+// NOTE: this is part of the Enforcer interface.
+func (c *ChannelOpenPolicyEnforcer) HandleErrorResponse(_ context.Context,
+	_ string, _ error) (error, error) {
+
+	return nil, nil
+}
+
+// checkers returns a map of URI to rpcmiddleware.RoundTripChecker which define
+// how the URI should be handled.
+func (c *ChannelOpenPolicyEnforcer) checkers() map[string]mid.RoundTripChecker {
+	return map[string]mid.RoundTripChecker{
+		"/lnrpc.Lightning/OpenChannel": mid.NewRequestChecker(
+			&lnrpc.OpenChannelRequest{},
+			&lnrpc.OpenStatusUpdate{},
+			c.checkOpenChannel,
+		),
+		"/lnrpc.Lightning/OpenChannelSync": mid.NewRequestChecker(
+			&lnrpc.OpenChannelRequest{},
+			&lnrpc.ChannelPoint{},
+			c.checkOpenChannel,
+		),
+		"/lnrpc.Lightning/BatchOpenChannel": mid.NewRequestChecker(
+			&lnrpc.BatchOpenChannelRequest{},
+			&lnrpc.BatchOpenChannelResponse{},
+			func(ctx context.Context,
+				r *lnrpc.BatchOpenChannelRequest) error {
+
+				for _, ch := range r.Channels {
+					err := c.checkChannel(
+						hex.EncodeToString(
+							ch.NodePubkey,
+						),
+						ch.LocalFundingAmount,
+						ch.PushSat, ch.Private,
+					)
+					if err != nil {
+						return err
+					}
+				}
+
+				return c.checkPendingOpens(
+					ctx, len(r.Channels),
+				)
+			},
+		),
+	}
+}
+
+// checkOpenChannel checks that the channel of the given open request complies
+// with the policy.
+func (c *ChannelOpenPolicyEnforcer) checkOpenChannel(ctx context.Context,
+	r *lnrpc.OpenChannelRequest) error {
+
+	// The capacity of a channel funded with the max amount is only known
+	// once lnd selected the coins, so it can't be checked against the
+	// capacity limits.
+	if r.FundMax && (c.MinCapacitySats != 0 || c.MaxCapacitySats != 0) {
+		return fmt.Errorf("channels can't be funded with the max " +
+			"amount if their capacity is limited")
+	}
+
+	peerID := r.NodePubkeyString
+	if len(r.NodePubkey) != 0 {
+		peerID = hex.EncodeToString(r.NodePubkey)
+	}
+
+	err := c.checkChannel(
+		peerID, r.LocalFundingAmount, r.PushSat, r.Private,
+	)
+	if err != nil {
+		return err
+	}
+
+	return c.checkPendingOpens(ctx, 1)
+}
+
+// checkChannel checks that a channel with the given peer, capacity, push
+// amount and visibility complies with the policy.
+func (c *ChannelOpenPolicyEnforcer) checkChannel(peerID string, capacity,
+	pushAmt int64, private bool) error {
+
+	if c.denyMap[peerID] {
+		return fmt.Errorf("illegal channel open with peer in peer " +
+			"deny list")
+	}
+
+	if len(c.allowMap) != 0 && !c.allowMap[peerID] {
+		return fmt.Errorf("illegal channel open with peer not in " +
+			"peer allow list")
+	}
+
+	if capacity < 0 || uint64(capacity) < c.MinCapacitySats {
+		return fmt.Errorf("channel capacity of %d sats is below the "+
+			"minimum of %d sats", capacity, c.MinCapacitySats)
+	}
+
+	if c.MaxCapacitySats != 0 && uint64(capacity) > c.MaxCapacitySats {
+		return fmt.Errorf("channel capacity of %d sats exceeds the "+
+			"maximum of %d sats", capacity, c.MaxCapacitySats)
+	}
+
+	if pushAmt != 0 && !c.AllowPushAmt {
+		return fmt.Errorf("pushing an amount to the peer is not " +
+			"allowed")
+	}
+
+	switch {
+	case c.Visibility == ChannelVisibilityPrivate && !private:
+		return fmt.Errorf("only private channels can be opened")
+
+	case c.Visibility == ChannelVisibilityPublic && private:
+		return fmt.Errorf("only public channels can be opened")
+	}
+
+	return nil
+}
+
+// checkPendingOpens checks that opening the given number of channels doesn't
+// exceed the maximum number of channels that are pending open at the same
+// time. The pending opens of the whole node are counted, including the ones
+// not opened through the session.
+func (c *ChannelOpenPolicyEnforcer) checkPendingOpens(ctx context.Context,
+	numOpens int) error {
+
+	if c.MaxPendingOpens == 0 {
+		return nil
+	}
+
+	pending, err := c.GetLndClient().PendingChannels(ctx)
+	if err != nil {
+		return err
+	}
+
+	if len(pending.PendingOpen)+numOpens > int(c.MaxPendingOpens) {
+		return fmt.Errorf("opening %d channel(s) would exceed the "+
+			"maximum of %d pending channel opens, %d channel(s) "+
+			"are pending open already", numOpens,
+			c.MaxPendingOpens, len(pending.PendingOpen))
+	}
+
+	return nil
+}
+
+// ChannelOpenPolicy represents the channel open policy rule values.
+type ChannelOpenPolicy struct {
+	// MinCapacitySats is the minimum capacity in sats of a channel that
+	// can be opened.
+	MinCapacitySats uint64 `json:"min_capacity_sats"`
+
+	// MaxCapacitySats is the maximum capacity in sats of a channel that
+	// can be opened. If it is zero, the capacity is not limited.
+	MaxCapacitySats uint64 `json:"max_capacity_sats"`
+
+	// MaxPendingOpens is the maximum number of channels that can be
+	// pending open at the same time. If it is zero, the number is not
+	// limited.
+	MaxPendingOpens uint32 `json:"max_pending_opens"`
+
+	// AllowList is a list of peer IDs that channels can be opened with.
+	// If it is empty, channels can be opened with any peer that is not in
+	// the DenyList.
+	AllowList []string `json:"peer_allow_list"`
+
+	// DenyList is a list of peer IDs that channels can't be opened with.
+	DenyList []string `json:"peer_deny_list"`
+
+	// AllowPushAmt is true if an amount can be pushed to the peer when
+	// opening a channel.
+	AllowPushAmt bool `json:"allow_push_amt"`
+
+	// Visibility defines whether the opened channels must be private or
+	// public.
+	Visibility ChannelVisibility `json:"visibility"`
+}
+
+// VerifySane checks that the value of the values is ok given the min and max
+// allowed values. The peer lists are not checked.
+//
+// NOTE: this is part of the Values interface.
+func (c *ChannelOpenPolicy) VerifySane(minVal, maxVal Values) error {
+	minCP, ok := minVal.(*ChannelOpenPolicy)
+	if !ok {
+		return fmt.Errorf("min value is not of type ChannelOpenPolicy")
+	}
+
+	maxCP, ok := maxVal.(*ChannelOpenPolicy)
+	if !ok {
+		return fmt.Errorf("max value is not of type ChannelOpenPolicy")
+	}
+
+	if c.MaxCapacitySats != 0 && c.MinCapacitySats > c.MaxCapacitySats {
+		return fmt.Errorf("min capacity can't be larger than the max " +
+			"capacity")
+	}
+
+	if c.MinCapacitySats < minCP.MinCapacitySats {
+		return fmt.Errorf("min capacity must be at least %d sats",
+			minCP.MinCapacitySats)
+	}
+
+	if c.MaxCapacitySats == 0 && maxCP.MaxCapacitySats != 0 {
+		return fmt.Errorf("a max capacity is required")
+	}
+
+	if c.MaxCapacitySats != 0 && !between(c.MaxCapacitySats,
+		minCP.MaxCapacitySats, maxCP.MaxCapacitySats) {
+
+		return fmt.Errorf("max capacity is not between the min and " +
+			"max")
+	}
+
+	if c.MaxPendingOpens == 0 && maxCP.MaxPendingOpens != 0 {
+		return fmt.Errorf("a max number of pending opens is required")
+	}
+
+	if maxCP.MaxPendingOpens != 0 &&
+		c.MaxPendingOpens > maxCP.MaxPendingOpens {
+
+		return fmt.Errorf("max number of pending opens must be at "+
+			"most %d", maxCP.MaxPendingOpens)
+	}
+
+	if c.AllowPushAmt && !maxCP.AllowPushAmt {
+		return fmt.Errorf("push amounts are not allowed")
+	}
+
+	if maxCP.Visibility != ChannelVisibilityAny &&
+		c.Visibility != maxCP.Visibility {
+
+		return fmt.Errorf("channel visibility is not allowed")
+	}
+
+	return nil
+}
+
+// RuleName returns the name of the rule that these values are to be used with.
+//
+// NOTE: this is part of the Values interface.
+func (c *ChannelOpenPolicy) RuleName() string {
+	return ChannelOpenPolicyName
+}
+
+// ToProto converts the rule Values to the litrpc counterpart.
+//
+// NOTE: this is part of the Values interface.
+func (c *ChannelOpenPolicy) ToProto() *litrpc.RuleValue {
+	visibility := litrpc.ChannelVisibility_CHANNEL_VISIBILITY_ANY
+	switch c.Visibility {
+	case ChannelVisibilityPrivate:
+		visibility = litrpc.ChannelVisibility_CHANNEL_VISIBILITY_PRIVATE
+
+	case ChannelVisibilityPublic:
+		visibility = litrpc.ChannelVisibility_CHANNEL_VISIBILITY_PUBLIC
+	}
+
+	return &litrpc.RuleValue{
+		Value: &litrpc.RuleValue_ChannelOpenPolicy{
+			ChannelOpenPolicy: &litrpc.ChannelOpenPolicy{
+				MinCapacitySats: c.MinCapacitySats,
+				MaxCapacitySats: c.MaxCapacitySats,
+				MaxPendingOpens: c.MaxPendingOpens,
+				AllowedPeerIds:  c.AllowList,
+				DeniedPeerIds:   c.DenyList,
+				AllowPushAmt:    c.AllowPushAmt,
+				Visibility:      visibility,
+			},
+		},
+	}
+}
+
+// PseudoToReal assumes that the peer lists contain pseudo peer IDs and uses
+// these to check the privacy map db for the corresponding real peer IDs. It
+// constructs a new ChannelOpenPolicy instance with these real peer IDs.
+//
+// NOTE: this is part of the Values interface.
+func (c *ChannelOpenPolicy) PseudoToReal(db firewalldb.PrivacyMapDB) (Values,
+	error) {
+
+	allowList := make([]string, len(c.AllowList))
+	denyList := make([]string, len(c.DenyList))
+	err := db.View(func(tx firewalldb.PrivacyMapTx) error {
+		for i, peerID := range c.AllowList {
+			real, err := firewalldb.RevealString(tx, peerID)
+			if err != nil {
+				return err
+			}
+
+			allowList[i] = real
+		}
+
+		for i, peerID := range c.DenyList {
+			real, err := firewalldb.RevealString(tx, peerID)
+			if err != nil {
+				return err
+			}
+
+			denyList[i] = real
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	policy := *c
+	policy.AllowList = allowList
+	policy.DenyList = denyList
+
+	return &policy, nil
+}
+
+// RealToPseudo converts all the real peer IDs into pseudo IDs.
+//
+// NOTE: this is part of the Values interface.
+func (c *ChannelOpenPolicy) RealToPseudo() (Values, map[string]string, error) {
+	privMapPairs := make(map[string]string)
+	hide := func(ids []string) ([]string, error) {
+		pseudoIDs := make([]string, len(ids))
+		for i, id := range ids {
+			if pseudo, ok := privMapPairs[id]; ok {
+				pseudoIDs[i] = pseudo
+				continue
+			}
+
+			pseudo, err := firewalldb.NewPseudoStr(len(id))
+			if err != nil {
+				return nil, err
+			}
+
+			privMapPairs[id] = pseudo
+			pseudoIDs[i] = pseudo
+		}
+
+		return pseudoIDs, nil
+	}
+
+	allowList, err := hide(c.AllowList)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	denyList, err := hide(c.DenyList)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	policy := *c
+	policy.AllowList = allowList
+	policy.DenyList = denyList
+
+	return &policy, privMapPairs, nil
+}
@@ -0,0 +1,311 @@
+package rules
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"testing"
+
+	"github.com/lightninglabs/lightning-terminal/firewalldb"
+	"github.com/lightninglabs/lndclient"
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/stretchr/testify/require"
+)
+
+// TestChannelOpenPolicyCheckValues tests that the ChannelOpenPolicy values
+// correctly implement the VerifySane method.
+func TestChannelOpenPolicyCheckValues(t *testing.T) {
+	minPolicy := &ChannelOpenPolicy{
+		MinCapacitySats: 20000,
+		MaxCapacitySats: 100000,
+	}
+	maxPolicy := &ChannelOpenPolicy{
+		MaxCapacitySats: 1000000,
+		MaxPendingOpens: 5,
+		Visibility:      ChannelVisibilityPrivate,
+	}
+
+	tests := []struct {
+		name      string
+		policy    *ChannelOpenPolicy
+		expectErr error
+	}{
+		{
+			name: "valid",
+			policy: &ChannelOpenPolicy{
+				MinCapacitySats: 50000,
+				MaxCapacitySats: 500000,
+				MaxPendingOpens: 2,
+				DenyList:        []string{"peer"},
+				Visibility:      ChannelVisibilityPrivate,
+			},
+		},
+		{
+			name: "min capacity larger than max capacity",
+			policy: &ChannelOpenPolicy{
+				MinCapacitySats: 500000,
+				MaxCapacitySats: 200000,
+				MaxPendingOpens: 2,
+				Visibility:      ChannelVisibilityPrivate,
+			},
+			expectErr: fmt.Errorf("min capacity can't be larger " +
+				"than the max capacity"),
+		},
+		{
+			name: "min capacity too small",
+			policy: &ChannelOpenPolicy{
+				MinCapacitySats: 10000,
+				MaxCapacitySats: 500000,
+				MaxPendingOpens: 2,
+				Visibility:      ChannelVisibilityPrivate,
+			},
+			expectErr: fmt.Errorf("min capacity must be at least " +
+				"20000 sats"),
+		},
+		{
+			name: "max capacity required",
+			policy: &ChannelOpenPolicy{
+				MinCapacitySats: 50000,
+				MaxPendingOpens: 2,
+				Visibility:      ChannelVisibilityPrivate,
+			},
+			expectErr: fmt.Errorf("a max capacity is required"),
+		},
+		{
+			name: "max capacity too large",
+			policy: &ChannelOpenPolicy{
+				MinCapacitySats: 50000,
+				MaxCapacitySats: 2000000,
+				MaxPendingOpens: 2,
+				Visibility:      ChannelVisibilityPrivate,
+			},
+			expectErr: fmt.Errorf("max capacity is not between " +
+				"the min and max"),
+		},
+		{
+			name: "too many pending opens",
+			policy: &ChannelOpenPolicy{
+				MinCapacitySats: 50000,
+				MaxCapacitySats: 500000,
+				MaxPendingOpens: 6,
+				Visibility:      ChannelVisibilityPrivate,
+			},
+			expectErr: fmt.Errorf("max number of pending opens " +
+				"must be at most 5"),
+		},
+		{
+			name: "push amounts not allowed",
+			policy: &ChannelOpenPolicy{
+				MinCapacitySats: 50000,
+				MaxCapacitySats: 500000,
+				MaxPendingOpens: 2,
+				AllowPushAmt:    true,
+				Visibility:      ChannelVisibilityPrivate,
+			},
+			expectErr: fmt.Errorf("push amounts are not allowed"),
+		},
+		{
+			name: "public channels not allowed",
+			policy: &ChannelOpenPolicy{
+				MinCapacitySats: 50000,
+				MaxCapacitySats: 500000,
+				MaxPendingOpens: 2,
+				Visibility:      ChannelVisibilityAny,
+			},
+			expectErr: fmt.Errorf("channel visibility is not " +
+				"allowed"),
+		},
+	}
+
+	for _, test := range tests {
+		err := test.policy.VerifySane(minPolicy, maxPolicy)
+		if test.expectErr == nil {
+			require.NoError(t, err, test.name)
+			continue
+		}
+
+		require.EqualError(t, err, test.expectErr.Error(), test.name)
+	}
+}
+
+// TestChannelOpenPolicy tests that the ChannelOpenPolicyEnforcer only accepts
+// channel opens that comply with the policy.
+func TestChannelOpenPolicy(t *testing.T) {
+	const (
+		openChannelURI      = "/lnrpc.Lightning/OpenChannel"
+		openChannelSyncURI  = "/lnrpc.Lightning/OpenChannelSync"
+		batchOpenChannelURI = "/lnrpc.Lightning/BatchOpenChannel"
+	)
+
+	peer1, err := firewalldb.NewPseudoStr(66)
+	require.NoError(t, err)
+
+	peer2, err := firewalldb.NewPseudoStr(66)
+	require.NoError(t, err)
+
+	peer3, err := firewalldb.NewPseudoStr(66)
+	require.NoError(t, err)
+
+	peerKey := func(peer string) []byte {
+		key, err := hex.DecodeString(peer)
+		require.NoError(t, err)
+
+		return key
+	}
+
+	ctx := context.Background()
+	cfg := &mockChannelOpenPolicyCfg{
+		pendingOpens: 1,
+	}
+
+	mgr := &ChannelOpenPolicyMgr{}
+	enf, err := mgr.NewEnforcer(cfg, &ChannelOpenPolicy{
+		MinCapacitySats: 20000,
+		MaxCapacitySats: 100000,
+		MaxPendingOpens: 3,
+		AllowList:       []string{peer1, peer2},
+		DenyList:        []string{peer2},
+		Visibility:      ChannelVisibilityPrivate,
+	})
+	require.NoError(t, err)
+
+	validReq := func() *lnrpc.OpenChannelRequest {
+		return &lnrpc.OpenChannelRequest{
+			NodePubkey:         peerKey(peer1),
+			LocalFundingAmount: 50000,
+			Private:            true,
+		}
+	}
+
+	// A valid channel open is accepted, both through the pubkey bytes and
+	// the deprecated pubkey string.
+	_, err = enf.HandleRequest(ctx, openChannelURI, validReq())
+	require.NoError(t, err)
+
+	req := validReq()
+	req.NodePubkey = nil
+	req.NodePubkeyString = peer1
+	_, err = enf.HandleRequest(ctx, openChannelSyncURI, req)
+	require.NoError(t, err)
+
+	// Peers in the deny list and peers not in the allow list are
+	// rejected.
+	req = validReq()
+	req.NodePubkey = peerKey(peer2)
+	_, err = enf.HandleRequest(ctx, openChannelURI, req)
+	require.ErrorContains(t, err, "peer in peer deny list")
+
+	req = validReq()
+	req.NodePubkey = peerKey(peer3)
+	_, err = enf.HandleRequest(ctx, openChannelURI, req)
+	require.ErrorContains(t, err, "peer not in peer allow list")
+
+	// The capacity must be within the bounds.
+	req = validReq()
+	req.LocalFundingAmount = 10000
+	_, err = enf.HandleRequest(ctx, openChannelURI, req)
+	require.ErrorContains(t, err, "below the minimum of 20000 sats")
+
+	req = validReq()
+	req.LocalFundingAmount = 200000
+	_, err = enf.HandleRequest(ctx, openChannelURI, req)
+	require.ErrorContains(t, err, "exceeds the maximum of 100000 sats")
+
+	req = validReq()
+	req.LocalFundingAmount = 0
+	req.FundMax = true
+	_, err = enf.HandleRequest(ctx, openChannelURI, req)
+	require.ErrorContains(t, err, "can't be funded with the max amount")
+
+	// Push amounts and public channels are not allowed.
+	req = validReq()
+	req.PushSat = 1000
+	_, err = enf.HandleRequest(ctx, openChannelURI, req)
+	require.ErrorContains(t, err, "pushing an amount to the peer is not "+
+		"allowed")
+
+	req = validReq()
+	req.Private = false
+	_, err = enf.HandleRequest(ctx, openChannelURI, req)
+	require.ErrorContains(t, err, "only private channels")
+
+	// A batch is checked channel by channel, and it counts all of its
+	// channels towards the max number of pending opens.
+	batchChannel := &lnrpc.BatchOpenChannel{
+		NodePubkey:         peerKey(peer1),
+		LocalFundingAmount: 50000,
+		Private:            true,
+	}
+	_, err = enf.HandleRequest(
+		ctx, batchOpenChannelURI, &lnrpc.BatchOpenChannelRequest{
+			Channels: []*lnrpc.BatchOpenChannel{
+				batchChannel, batchChannel,
+			},
+		},
+	)
+	require.NoError(t, err)
+
+	_, err = enf.HandleRequest(
+		ctx, batchOpenChannelURI, &lnrpc.BatchOpenChannelRequest{
+			Channels: []*lnrpc.BatchOpenChannel{
+				batchChannel, batchChannel, batchChannel,
+			},
+		},
+	)
+	require.ErrorContains(t, err, "maximum of 3 pending channel opens")
+
+	cfg.pendingOpens = 3
+	_, err = enf.HandleRequest(ctx, openChannelURI, validReq())
+	require.ErrorContains(t, err, "maximum of 3 pending channel opens")
+
+	// Calls that don't open channels are not affected by the rule.
+	_, err = enf.HandleRequest(
+		ctx, "/lnrpc.Lightning/GetInfo", &lnrpc.GetInfoRequest{},
+	)
+	require.NoError(t, err)
+}
+
+// TestChannelOpenPolicyRealToPseudo tests that a peer that is in both peer
+// lists is mapped to the same pseudo peer ID.
+func TestChannelOpenPolicyRealToPseudo(t *testing.T) {
+	policy := &ChannelOpenPolicy{
+		MinCapacitySats: 20000,
+		AllowList:       []string{"peer1", "peer2"},
+		DenyList:        []string{"peer2", "peer3"},
+	}
+
+	v, privMapPairs, err := policy.RealToPseudo()
+	require.NoError(t, err)
+	require.Len(t, privMapPairs, 3)
+
+	pseudo, ok := v.(*ChannelOpenPolicy)
+	require.True(t, ok)
+	require.Equal(t, policy.MinCapacitySats, pseudo.MinCapacitySats)
+	require.Equal(t, pseudo.AllowList[1], pseudo.DenyList[0])
+	require.Equal(t, privMapPairs["peer1"], pseudo.AllowList[0])
+	require.Equal(t, privMapPairs["peer3"], pseudo.DenyList[1])
+
+	// The original values are left unchanged.
+	require.Equal(t, []string{"peer1", "peer2"}, policy.AllowList)
+}
+
+// mockChannelOpenPolicyCfg is used to mock the config backend given to the
+// ChannelOpenPolicyEnforcer during testing.
+type mockChannelOpenPolicyCfg struct {
+	lndclient.LightningClient
+	Config
+
+	pendingOpens int
+}
+
+func (m *mockChannelOpenPolicyCfg) GetLndClient() lndclient.LightningClient {
+	return m
+}
+
+func (m *mockChannelOpenPolicyCfg) PendingChannels(_ context.Context) (
+	*lndclient.PendingChannels, error) {
+
+	return &lndclient.PendingChannels{
+		PendingOpen: make([]lndclient.PendingChannel, m.pendingOpens),
+	}, nil
+}
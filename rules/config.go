@@ -1,6 +1,8 @@
 package rules
 
 import (
+	"time"
+
 	"github.com/lightninglabs/lightning-terminal/firewalldb"
 	"github.com/lightninglabs/lndclient"
 	"gopkg.in/macaroon-bakery.v2/bakery"
@@ -34,6 +36,14 @@ type Config interface {
 
 	// GetLndClient returns an lnd client.
 	GetLndClient() lndclient.LightningClient
+
+	// GetApprovalsDB can be used by rules to park requests until the
+	// operator approves or denies them.
+	GetApprovalsDB() firewalldb.ApprovalsDB
+
+	// GetApprovalTimeout returns the maximum time a rule may hold back a
+	// request while it waits for the operator to approve or deny it.
+	GetApprovalTimeout() time.Duration
 }
 
 // ConfigImpl is an implementation of the Config interface.
@@ -63,6 +73,15 @@ type ConfigImpl struct {
 
 	// LndClient is a connection to the Lit node's LND node.
 	LndClient lndclient.LightningClient
+
+	// ApprovalsDB can be used by rules to park requests until the operator
+	// approves or denies them.
+	ApprovalsDB firewalldb.ApprovalsDB
+
+	// ApprovalTimeout is the maximum time a rule may hold back a request
+	// while it waits for the operator to approve or deny it. It must be
+	// below the RPC middleware intercept timeout.
+	ApprovalTimeout time.Duration
 }
 
 func (c *ConfigImpl) GetStores() firewalldb.KVStores {
@@ -100,6 +119,17 @@ func (c *ConfigImpl) GetLndClient() lndclient.LightningClient {
 	return c.LndClient
 }
 
+// GetApprovalsDB returns the DB of parked requests.
+func (c *ConfigImpl) GetApprovalsDB() firewalldb.ApprovalsDB {
+	return c.ApprovalsDB
+}
+
+// GetApprovalTimeout returns the maximum time a rule may hold back a request
+// while it waits for the operator to approve or deny it.
+func (c *ConfigImpl) GetApprovalTimeout() time.Duration {
+	return c.ApprovalTimeout
+}
+
 // A compile-time check to ensure that ConfigImpl implements the Config
 // interface.
 var _ Config = (*ConfigImpl)(nil)
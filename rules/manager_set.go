@@ -16,11 +16,16 @@ type ManagerSet map[string]Manager
 // NewRuleManagerSet creates a new map of the supported rule ManagerSet.
 func NewRuleManagerSet() ManagerSet {
 	return map[string]Manager{
-		RateLimitName:        &RateLimitMgr{},
-		ChanPolicyBoundsName: &ChanPolicyBoundsMgr{},
-		HistoryLimitName:     &HistoryLimitMgr{},
-		ChannelRestrictName:  NewChannelRestrictMgr(),
-		PeersRestrictName:    NewPeerRestrictMgr(),
+		RateLimitName:         &RateLimitMgr{},
+		ChanPolicyBoundsName:  &ChanPolicyBoundsMgr{},
+		HistoryLimitName:      &HistoryLimitMgr{},
+		ChannelRestrictName:   NewChannelRestrictMgr(),
+		PeersRestrictName:     NewPeerRestrictMgr(),
+		OffChainBudgetName:    &OffChainBudgetMgr{},
+		OnChainBudgetName:     &OnChainBudgetMgr{},
+		ChannelOpenPolicyName: &ChannelOpenPolicyMgr{},
+		TimeWindowName:        &TimeWindowMgr{},
+		ApprovalRequiredName:  &ApprovalRequiredMgr{},
 	}
 }
 
@@ -0,0 +1,508 @@
+package rules
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/lightninglabs/lightning-terminal/firewalldb"
+	"github.com/lightninglabs/lightning-terminal/litrpc"
+	mid "github.com/lightninglabs/lightning-terminal/rpcmiddleware"
+	"github.com/lightninglabs/lndclient"
+	"github.com/lightninglabs/loop/looprpc"
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/lightningnetwork/lnd/lnrpc/routerrpc"
+	"google.golang.org/protobuf/proto"
+)
+
+var (
+	// Compile-time checks to ensure that OffChainBudget,
+	// OffChainBudgetMgr and OffChainBudgetEnforcer implement the
+	// appropriate Manager, Enforcer and Values interface.
+	_ Manager  = (*OffChainBudgetMgr)(nil)
+	_ Enforcer = (*OffChainBudgetEnforcer)(nil)
+	_ Values   = (*OffChainBudget)(nil)
+)
+
+// OffChainBudgetName is the string identifier of the OffChainBudget rule.
+const OffChainBudgetName = "off-chain-budget"
+
+// OffChainBudgetMgr manages the OffChainBudget rule.
+type OffChainBudgetMgr struct{}
+
+// Stop cleans up the resources held by the manager.
+//
+// NOTE: This is part of the Manager interface.
+func (o *OffChainBudgetMgr) Stop() error {
+	return nil
+}
+
+// NewEnforcer constructs a new OffChainBudget rule enforcer using the passed
+// values and config.
+//
+// NOTE: This is part of the Manager interface.
+func (o *OffChainBudgetMgr) NewEnforcer(cfg Config, values Values) (Enforcer,
+	error) {
+
+	budget, ok := values.(*OffChainBudget)
+	if !ok {
+		return nil, fmt.Errorf("values must be of type "+
+			"OffChainBudget, got %T", values)
+	}
+
+	return &OffChainBudgetEnforcer{
+		offChainBudgetConfig: cfg,
+		OffChainBudget:       budget,
+	}, nil
+}
+
+// NewValueFromProto converts the given proto value into a OffChainBudget Value
+// object.
+//
+// NOTE: This is part of the Manager interface.
+func (o *OffChainBudgetMgr) NewValueFromProto(v *litrpc.RuleValue) (Values,
+	error) {
+
+	rv, ok := v.Value.(*litrpc.RuleValue_OffChainBudget)
+	if !ok {
+		return nil, fmt.Errorf("incorrect RuleValue type")
+	}
+
+	budget := rv.OffChainBudget
+
+	return &OffChainBudget{
+		MaxAmtMsat:  budget.MaxAmtMsat,
+		MaxFeesMsat: budget.MaxFeesMsat,
+		Window:      time.Duration(budget.WindowSeconds) * time.Second,
+	}, nil
+}
+
+// EmptyValue returns a new OffChainBudget instance.
+//
+// NOTE: This is part of the Manager interface.
+func (o *OffChainBudgetMgr) EmptyValue() Values {
+	return &OffChainBudget{}
+}
+
+// offChainBudgetConfig is the config required by OffChainBudgetMgr. It can be
+// derived from the main rules Config struct.
+type offChainBudgetConfig interface {
+	GetStores() firewalldb.KVStores
+	GetReqID() int64
+	GetLndClient() lndclient.LightningClient
+}
+
+// OffChainBudgetEnforcer enforces requests and responses against an
+// OffChainBudget rule.
+//
+// Each payment reserves the maximum amount and fees it can spend when its
+// request is accepted. The reservation is replaced with the actual amounts
+// once the payment succeeds and is released if it fails. Reservations of
+// payments whose outcome is never seen keep counting towards the budget, so
+// the budget can't be exceeded.
+type OffChainBudgetEnforcer struct {
+	offChainBudgetConfig
+	*OffChainBudget
+}
+
+// HandleRequest checks the validity of a request using the OffChainBudget
+// rpcmiddleware.RoundTripCheckers. If the request is a payment that fits into
+// the remaining budget, its amount is reserved.
+//
+// NOTE: this is part of the Enforcer interface.
+func (o *OffChainBudgetEnforcer) HandleRequest(ctx context.Context, uri string,
+	msg proto.Message) (proto.Message, error) {
+
+	checker, ok := o.checkers()[uri]
+	if !ok {
+		return nil, nil
+	}
+
+	if !checker.HandlesRequest(msg.ProtoReflect().Type()) {
+		return nil, fmt.Errorf("invalid implementation, checker for "+
+			"URI %s does not accept request of type %v", uri,
+			msg.ProtoReflect().Type())
+	}
+
+	return checker.HandleRequest(ctx, msg)
+}
+
+// HandleResponse handles a response using the OffChainBudget
+// rpcmiddleware.RoundTripCheckers. The reservation of a payment is settled or
+// released once its final outcome is known.
+//
+// NOTE: this is part of the Enforcer interface.
+func (o *OffChainBudgetEnforcer) HandleResponse(ctx context.Context,
+	uri string, msg proto.Message) (proto.Message, error) {
+
+	checker, ok := o.checkers()[uri]
+	if !ok {
+		return nil, nil
+	}
+
+	if !checker.HandlesResponse(msg.ProtoReflect().Type()) {
+		return nil, fmt.Errorf("invalid implementation, checker for "+
+			"URI %s does not accept response of type %v", uri,
+			msg.ProtoReflect().Type())
+	}
+
+	return checker.HandleResponse(ctx, msg)
+}
+
+// HandleErrorResponse releases the reservation of a payment that failed with
+// an error. The error itself is passed through unchanged.
+//
+// NOTE: this is part of the Enforcer interface.
+func (o *OffChainBudgetEnforcer) HandleErrorResponse(ctx context.Context,
+	uri string, _ error) (error, error) {
+
+	if _, ok := o.checkers()[uri]; !ok {
+		return nil, nil
+	}
+
+	return nil, o.release(ctx)
+}
+
+// checkers returns a map of URI to rpcmiddleware.RoundTripChecker which define
+// how the URI should be handled.
+func (o *OffChainBudgetEnforcer) checkers() map[string]mid.RoundTripChecker {
+	return map[string]mid.RoundTripChecker{
+		"/routerrpc.Router/SendPaymentV2": mid.NewFullChecker(
+			&routerrpc.SendPaymentRequest{},
+			&lnrpc.Payment{},
+			func(ctx context.Context,
+				r *routerrpc.SendPaymentRequest) error {
+
+				amt, fees, err := paymentAmounts(
+					ctx, o.GetLndClient(), r,
+				)
+				if err != nil {
+					return err
+				}
+
+				return o.reserve(ctx, amt, fees)
+			},
+			func(ctx context.Context,
+				r *lnrpc.Payment) (proto.Message, error) {
+
+				switch r.Status {
+				case lnrpc.Payment_SUCCEEDED:
+					return nil, o.settle(
+						ctx, uint64(r.ValueMsat),
+						uint64(r.FeeMsat),
+					)
+
+				case lnrpc.Payment_FAILED:
+					return nil, o.release(ctx)
+				}
+
+				return nil, nil
+			}, mid.PassThroughErrorHandler,
+		),
+		"/routerrpc.Router/SendToRouteV2": mid.NewFullChecker(
+			&routerrpc.SendToRouteRequest{},
+			&lnrpc.HTLCAttempt{},
+			func(ctx context.Context,
+				r *routerrpc.SendToRouteRequest) error {
+
+				if r.Route == nil {
+					return fmt.Errorf("route is required")
+				}
+
+				amt, fees := routeAmounts(r.Route)
+
+				return o.reserve(ctx, amt, fees)
+			},
+			func(ctx context.Context,
+				r *lnrpc.HTLCAttempt) (proto.Message, error) {
+
+				switch r.Status {
+				case lnrpc.HTLCAttempt_SUCCEEDED:
+					if r.Route == nil {
+						return nil, o.confirm(ctx)
+					}
+
+					amt, fees := routeAmounts(r.Route)
+
+					return nil, o.settle(ctx, amt, fees)
+
+				case lnrpc.HTLCAttempt_FAILED:
+					return nil, o.release(ctx)
+				}
+
+				return nil, nil
+			}, mid.PassThroughErrorHandler,
+		),
+		"/looprpc.SwapClient/LoopOut": mid.NewFullChecker(
+			&looprpc.LoopOutRequest{},
+			&looprpc.SwapResponse{},
+			func(ctx context.Context,
+				r *looprpc.LoopOutRequest) error {
+
+				amt, fees, err := loopOutAmounts(r)
+				if err != nil {
+					return err
+				}
+
+				return o.reserve(ctx, amt, fees)
+			},
+			// The outcome of the swap isn't known when it
+			// is initiated, so the maximum amounts it can
+			// spend are counted as spent.
+			func(ctx context.Context,
+				r *looprpc.SwapResponse) (proto.Message,
+				error) {
+
+				return nil, o.confirm(ctx)
+			}, mid.PassThroughErrorHandler,
+		),
+	}
+}
+
+// paymentAmounts returns the amount and the maximum fees in msat that the
+// given payment can spend. If the payment pays an invoice, the amount is
+// taken from the invoice unless it doesn't specify one.
+func paymentAmounts(ctx context.Context, lnd lndclient.LightningClient,
+	r *routerrpc.SendPaymentRequest) (uint64, uint64, error) {
+
+	if r.Amt < 0 || r.AmtMsat < 0 {
+		return 0, 0, fmt.Errorf("invalid payment amount")
+	}
+
+	if r.FeeLimitSat < 0 || r.FeeLimitMsat < 0 {
+		return 0, 0, fmt.Errorf("invalid fee limit")
+	}
+
+	amt, err := lnrpc.UnmarshallAmt(r.Amt, r.AmtMsat)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	if r.PaymentRequest != "" {
+		payReq, err := lnd.DecodePaymentRequest(
+			ctx, r.PaymentRequest,
+		)
+		if err != nil {
+			return 0, 0, fmt.Errorf("could not decode payment "+
+				"request: %v", err)
+		}
+
+		if payReq.Value != 0 {
+			amt = payReq.Value
+		}
+	}
+
+	// A fee limit of zero means that the payment can't spend any fees.
+	fees, err := lnrpc.UnmarshallAmt(r.FeeLimitSat, r.FeeLimitMsat)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	return uint64(amt), uint64(fees), nil
+}
+
+// routeAmounts returns the amount and the fees in msat that are spent when
+// paying along the given route.
+func routeAmounts(route *lnrpc.Route) (uint64, uint64) {
+	if route.TotalAmtMsat < route.TotalFeesMsat {
+		return 0, 0
+	}
+
+	return uint64(route.TotalAmtMsat - route.TotalFeesMsat),
+		uint64(route.TotalFeesMsat)
+}
+
+// loopOutAmounts returns the amount and the maximum fees in msat that the
+// given Loop Out swap can spend off-chain. The swap fee and the routing fees
+// of both the swap and the prepay payment count as fees. The miner fee is paid
+// on-chain by the server and is not part of the off-chain budget.
+func loopOutAmounts(r *looprpc.LoopOutRequest) (uint64, uint64, error) {
+	if r.Amt < 0 || r.MaxSwapFee < 0 || r.MaxSwapRoutingFee < 0 ||
+		r.MaxPrepayRoutingFee < 0 {
+
+		return 0, 0, fmt.Errorf("invalid loop out amounts")
+	}
+
+	fees := r.MaxSwapFee + r.MaxSwapRoutingFee + r.MaxPrepayRoutingFee
+	if r.Amt > math.MaxInt64/1000 || fees > math.MaxInt64/1000 {
+		return 0, 0, fmt.Errorf("invalid loop out amounts")
+	}
+
+	return uint64(r.Amt) * 1000, uint64(fees) * 1000, nil
+}
+
+// reserve reserves the given amount and fees in msat for the current request
+// if they fit into the remaining budget.
+func (o *OffChainBudgetEnforcer) reserve(ctx context.Context, amt,
+	fees uint64) error {
+
+	return updateBudgetLedger(
+		ctx, o.GetStores(), o.Window,
+		func(ledger *budgetLedger, now time.Time) error {
+			spentAmt, spentFees := ledger.total(
+				windowStart(now, o.Window),
+			)
+
+			if spentAmt+amt > o.MaxAmtMsat {
+				return fmt.Errorf("payment amount of %d msat "+
+					"exceeds the remaining off-chain "+
+					"budget of %d msat", amt,
+					remaining(o.MaxAmtMsat, spentAmt))
+			}
+
+			if spentFees+fees > o.MaxFeesMsat {
+				return fmt.Errorf("fee limit of %d msat "+
+					"exceeds the remaining off-chain fee "+
+					"budget of %d msat", fees,
+					remaining(o.MaxFeesMsat, spentFees))
+			}
+
+			ledger.reserve(o.GetReqID(), amt, fees, now)
+
+			return nil
+		},
+	)
+}
+
+// settle replaces the reservation of the current request with the given
+// amount and fees in msat that were actually spent.
+func (o *OffChainBudgetEnforcer) settle(ctx context.Context, amt,
+	fees uint64) error {
+
+	return updateBudgetLedger(
+		ctx, o.GetStores(), o.Window,
+		func(ledger *budgetLedger, now time.Time) error {
+			ledger.settle(o.GetReqID(), amt, fees, now)
+
+			return nil
+		},
+	)
+}
+
+// confirm marks the reservation of the current request as spent in full.
+func (o *OffChainBudgetEnforcer) confirm(ctx context.Context) error {
+	return updateBudgetLedger(
+		ctx, o.GetStores(), o.Window,
+		func(ledger *budgetLedger, now time.Time) error {
+			ledger.confirm(o.GetReqID(), now)
+
+			return nil
+		},
+	)
+}
+
+// release removes the reservation of the current request.
+func (o *OffChainBudgetEnforcer) release(ctx context.Context) error {
+	return updateBudgetLedger(
+		ctx, o.GetStores(), o.Window,
+		func(ledger *budgetLedger, _ time.Time) error {
+			ledger.release(o.GetReqID())
+
+			return nil
+		},
+	)
+}
+
+// remaining returns the part of the given budget that is left after the given
+// amount was spent.
+func remaining(budget, spent uint64) uint64 {
+	if spent >= budget {
+		return 0
+	}
+
+	return budget - spent
+}
+
+// OffChainBudget represents the off-chain budget rule values.
+type OffChainBudget struct {
+	// MaxAmtMsat is the maximum amount in msat that can be sent off-chain,
+	// excluding fees.
+	MaxAmtMsat uint64 `json:"max_amt_msat"`
+
+	// MaxFeesMsat is the maximum amount in msat that can be spent on fees
+	// of off-chain payments.
+	MaxFeesMsat uint64 `json:"max_fees_msat"`
+
+	// Window is the duration of the rolling window that the budget applies
+	// to. If it is zero, the budget applies to the whole lifetime of the
+	// session.
+	Window time.Duration `json:"window"`
+}
+
+// VerifySane checks that the value of the values is ok given the min and max
+// allowed values.
+//
+// NOTE: this is part of the Values interface.
+func (o *OffChainBudget) VerifySane(minVal, maxVal Values) error {
+	minOB, ok := minVal.(*OffChainBudget)
+	if !ok {
+		return fmt.Errorf("min value is not of type OffChainBudget")
+	}
+
+	maxOB, ok := maxVal.(*OffChainBudget)
+	if !ok {
+		return fmt.Errorf("max value is not of type OffChainBudget")
+	}
+
+	if o.MaxAmtMsat < minOB.MaxAmtMsat || o.MaxAmtMsat > maxOB.MaxAmtMsat {
+		return fmt.Errorf("max amount is not between the min and max")
+	}
+
+	if o.MaxFeesMsat < minOB.MaxFeesMsat ||
+		o.MaxFeesMsat > maxOB.MaxFeesMsat {
+
+		return fmt.Errorf("max fees are not between the min and max")
+	}
+
+	// A shorter window allows more to be spent over time, so only the
+	// minimum window is checked. A budget without a window never renews
+	// and is therefore always allowed.
+	if o.Window != 0 && o.Window < minOB.Window {
+		return fmt.Errorf("window must be at least %v", minOB.Window)
+	}
+
+	return nil
+}
+
+// RuleName returns the name of the rule that these values are to be used with.
+//
+// NOTE: this is part of the Values interface.
+func (o *OffChainBudget) RuleName() string {
+	return OffChainBudgetName
+}
+
+// ToProto converts the rule Values to the litrpc counterpart.
+//
+// NOTE: this is part of the Values interface.
+func (o *OffChainBudget) ToProto() *litrpc.RuleValue {
+	return &litrpc.RuleValue{
+		Value: &litrpc.RuleValue_OffChainBudget{
+			OffChainBudget: &litrpc.OffChainBudget{
+				MaxAmtMsat:    o.MaxAmtMsat,
+				MaxFeesMsat:   o.MaxFeesMsat,
+				WindowSeconds: uint64(o.Window.Seconds()),
+			},
+		},
+	}
+}
+
+// PseudoToReal attempts to convert any appropriate pseudo fields in the rule
+// Values to their corresponding real values. It uses the passed PrivacyMapDB to
+// find the real values. This is a no-op for the OffChainBudget rule.
+//
+// NOTE: this is part of the Values interface.
+func (o *OffChainBudget) PseudoToReal(_ firewalldb.PrivacyMapDB) (Values,
+	error) {
+
+	return o, nil
+}
+
+// RealToPseudo converts the rule Values to a new one that uses pseudo keys,
+// channel IDs, channel points etc. It returns a map of real to pseudo strings
+// that should be persisted. This is a no-op for the OffChainBudget rule.
+//
+// NOTE: this is part of the Values interface.
+func (o *OffChainBudget) RealToPseudo() (Values, map[string]string, error) {
+	return o, nil, nil
+}
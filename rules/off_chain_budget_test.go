@@ -0,0 +1,364 @@
+package rules
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/lightninglabs/lightning-terminal/firewalldb"
+	"github.com/lightninglabs/lndclient"
+	"github.com/lightninglabs/loop/looprpc"
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/lightningnetwork/lnd/lnrpc/routerrpc"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	sendPaymentURI = "/routerrpc.Router/SendPaymentV2"
+	sendToRouteURI = "/routerrpc.Router/SendToRouteV2"
+	loopOutURI     = "/looprpc.SwapClient/LoopOut"
+)
+
+// TestOffChainBudgetCheckValues tests that the OffChainBudget values correctly
+// implement the VerifySane method.
+func TestOffChainBudgetCheckValues(t *testing.T) {
+	minBudget := &OffChainBudget{
+		MaxAmtMsat:  1000,
+		MaxFeesMsat: 10,
+		Window:      time.Hour,
+	}
+	maxBudget := &OffChainBudget{
+		MaxAmtMsat:  100000,
+		MaxFeesMsat: 1000,
+		Window:      time.Hour * 24,
+	}
+
+	tests := []struct {
+		name      string
+		budget    *OffChainBudget
+		expectErr error
+	}{
+		{
+			name: "valid",
+			budget: &OffChainBudget{
+				MaxAmtMsat:  5000,
+				MaxFeesMsat: 50,
+				Window:      time.Hour * 2,
+			},
+		},
+		{
+			name: "valid lifetime budget",
+			budget: &OffChainBudget{
+				MaxAmtMsat:  100000,
+				MaxFeesMsat: 1000,
+			},
+		},
+		{
+			name: "valid window longer than max",
+			budget: &OffChainBudget{
+				MaxAmtMsat:  1000,
+				MaxFeesMsat: 10,
+				Window:      time.Hour * 48,
+			},
+		},
+		{
+			name: "amount too small",
+			budget: &OffChainBudget{
+				MaxAmtMsat:  999,
+				MaxFeesMsat: 10,
+				Window:      time.Hour,
+			},
+			expectErr: fmt.Errorf("max amount is not between the " +
+				"min and max"),
+		},
+		{
+			name: "amount too large",
+			budget: &OffChainBudget{
+				MaxAmtMsat:  100001,
+				MaxFeesMsat: 10,
+				Window:      time.Hour,
+			},
+			expectErr: fmt.Errorf("max amount is not between the " +
+				"min and max"),
+		},
+		{
+			name: "fees too large",
+			budget: &OffChainBudget{
+				MaxAmtMsat:  1000,
+				MaxFeesMsat: 1001,
+				Window:      time.Hour,
+			},
+			expectErr: fmt.Errorf("max fees are not between the " +
+				"min and max"),
+		},
+		{
+			name: "window too short",
+			budget: &OffChainBudget{
+				MaxAmtMsat:  1000,
+				MaxFeesMsat: 10,
+				Window:      time.Minute,
+			},
+			expectErr: fmt.Errorf("window must be at least 1h0m0s"),
+		},
+	}
+
+	for _, test := range tests {
+		err := test.budget.VerifySane(minBudget, maxBudget)
+		if test.expectErr == nil {
+			require.NoError(t, err, test.name)
+			continue
+		}
+
+		require.EqualError(t, err, test.expectErr.Error(), test.name)
+	}
+}
+
+// TestOffChainBudget tests that the OffChainBudgetEnforcer only accepts
+// payments that fit into the remaining budget and that in-flight payments are
+// accounted for correctly.
+func TestOffChainBudget(t *testing.T) {
+	ctx := context.Background()
+	cfg := newMockOffChainBudgetCfg(t)
+	cfg.invoices["invoice"] = 5000
+
+	enf := &OffChainBudgetEnforcer{
+		offChainBudgetConfig: cfg,
+		OffChainBudget: &OffChainBudget{
+			MaxAmtMsat:  10000,
+			MaxFeesMsat: 2000,
+		},
+	}
+
+	// The first payment reserves its amount and fee limit.
+	cfg.reqID = 1
+	_, err := enf.HandleRequest(ctx, sendPaymentURI,
+		&routerrpc.SendPaymentRequest{
+			AmtMsat:      6000,
+			FeeLimitMsat: 500,
+		},
+	)
+	require.NoError(t, err)
+
+	// An invoice over 5000 msat doesn't fit into the remaining budget while
+	// the first payment is in flight.
+	cfg.reqID = 2
+	_, err = enf.HandleRequest(ctx, sendPaymentURI,
+		&routerrpc.SendPaymentRequest{
+			PaymentRequest: "invoice",
+		},
+	)
+	require.ErrorContains(t, err, "remaining off-chain budget of 4000")
+
+	// Updates of the first payment that don't contain its final outcome
+	// don't change anything. Once it succeeds, only the fees that were
+	// actually paid are counted.
+	cfg.reqID = 1
+	for _, status := range []lnrpc.Payment_PaymentStatus{
+		lnrpc.Payment_IN_FLIGHT, lnrpc.Payment_SUCCEEDED,
+	} {
+		_, err = enf.HandleResponse(ctx, sendPaymentURI, &lnrpc.Payment{
+			Status:    status,
+			ValueMsat: 6000,
+			FeeMsat:   100,
+		})
+		require.NoError(t, err)
+	}
+	requireSpent(t, enf, 6000, 100)
+
+	// The second payment can't exceed the fee budget.
+	cfg.reqID = 2
+	_, err = enf.HandleRequest(ctx, sendPaymentURI,
+		&routerrpc.SendPaymentRequest{
+			AmtMsat:     4000,
+			FeeLimitSat: 2,
+		},
+	)
+	require.ErrorContains(t, err, "remaining off-chain fee budget of 1900")
+
+	_, err = enf.HandleRequest(ctx, sendPaymentURI,
+		&routerrpc.SendPaymentRequest{
+			AmtMsat:      4000,
+			FeeLimitMsat: 900,
+		},
+	)
+	require.NoError(t, err)
+	requireSpent(t, enf, 10000, 1000)
+
+	// The budget is exhausted while the payment is in flight. Once it
+	// fails with an error, its reservation is released again.
+	route := &lnrpc.Route{
+		TotalAmtMsat:  2100,
+		TotalFeesMsat: 100,
+	}
+	cfg.reqID = 3
+	_, err = enf.HandleRequest(ctx, sendToRouteURI,
+		&routerrpc.SendToRouteRequest{
+			Route: route,
+		},
+	)
+	require.Error(t, err)
+
+	cfg.reqID = 2
+	_, err = enf.HandleErrorResponse(
+		ctx, sendPaymentURI, fmt.Errorf("no route"),
+	)
+	require.NoError(t, err)
+	requireSpent(t, enf, 6000, 100)
+
+	// A payment along a route is settled with the amounts of the
+	// route of the successful attempt.
+	cfg.reqID = 3
+	_, err = enf.HandleRequest(ctx, sendToRouteURI,
+		&routerrpc.SendToRouteRequest{
+			Route: route,
+		},
+	)
+	require.NoError(t, err)
+
+	_, err = enf.HandleResponse(ctx, sendToRouteURI, &lnrpc.HTLCAttempt{
+		Status: lnrpc.HTLCAttempt_SUCCEEDED,
+		Route:  route,
+	})
+	require.NoError(t, err)
+	requireSpent(t, enf, 8000, 200)
+
+	// A Loop Out swap counts its maximum fees as spent once it was
+	// initiated.
+	cfg.reqID = 4
+	_, err = enf.HandleRequest(ctx, loopOutURI, &looprpc.LoopOutRequest{
+		Amt:        2,
+		MaxSwapFee: 1,
+	})
+	require.NoError(t, err)
+
+	_, err = enf.HandleResponse(ctx, loopOutURI, &looprpc.SwapResponse{})
+	require.NoError(t, err)
+	requireSpent(t, enf, 10000, 1200)
+
+	// Calls that don't spend anything are not affected by the rule.
+	_, err = enf.HandleRequest(
+		ctx, "/lnrpc.Lightning/GetInfo", &lnrpc.GetInfoRequest{},
+	)
+	require.NoError(t, err)
+}
+
+// TestOffChainBudgetWindow tests that settled payments only count towards a
+// budget with a rolling window until they leave the window, while in-flight
+// payments keep counting.
+func TestOffChainBudgetWindow(t *testing.T) {
+	ctx := context.Background()
+	cfg := newMockOffChainBudgetCfg(t)
+
+	enf := &OffChainBudgetEnforcer{
+		offChainBudgetConfig: cfg,
+		OffChainBudget: &OffChainBudget{
+			MaxAmtMsat:  10000,
+			MaxFeesMsat: 1000,
+			Window:      time.Hour,
+		},
+	}
+
+	// Add a settled and an in-flight payment that were made before the
+	// start of the window.
+	longAgo := time.Now().Add(-2 * time.Hour)
+	err := cfg.stores.Update(func(tx firewalldb.KVStoreTx) error {
+		ledger := &budgetLedger{}
+		ledger.reserve(1, 8000, 0, longAgo)
+		require.True(t, ledger.confirm(1, longAgo))
+		ledger.reserve(2, 4000, 0, longAgo)
+
+		return ledger.save(ctx, tx.Local(), time.Time{})
+	})
+	require.NoError(t, err)
+
+	cfg.reqID = 3
+	req := &routerrpc.SendPaymentRequest{
+		AmtMsat: 6000,
+	}
+	_, err = enf.HandleRequest(ctx, sendPaymentURI, req)
+	require.NoError(t, err)
+	requireSpent(t, enf, 10000, 0)
+
+	_, err = enf.HandleRequest(ctx, sendPaymentURI, req)
+	require.Error(t, err)
+}
+
+// mockOffChainBudgetCfg is used to mock the config backend given to the
+// OffChainBudgetEnforcer during testing.
+type mockOffChainBudgetCfg struct {
+	lndclient.LightningClient
+
+	stores   firewalldb.KVStores
+	reqID    int64
+	invoices map[string]lnwire.MilliSatoshi
+}
+
+var _ offChainBudgetConfig = (*mockOffChainBudgetCfg)(nil)
+
+// newMockOffChainBudgetCfg creates a new mock config that is backed by a
+// temporary rules DB.
+func newMockOffChainBudgetCfg(t *testing.T) *mockOffChainBudgetCfg {
+	db, err := firewalldb.NewDB(t.TempDir(), "test.db")
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_ = db.Close()
+	})
+
+	return &mockOffChainBudgetCfg{
+		stores: db.GetKVStores(
+			OffChainBudgetName, [4]byte{1, 2, 3, 4}, "feature",
+		),
+		invoices: make(map[string]lnwire.MilliSatoshi),
+	}
+}
+
+func (m *mockOffChainBudgetCfg) GetStores() firewalldb.KVStores {
+	return m.stores
+}
+
+func (m *mockOffChainBudgetCfg) GetReqID() int64 {
+	return m.reqID
+}
+
+func (m *mockOffChainBudgetCfg) GetLndClient() lndclient.LightningClient {
+	return m
+}
+
+func (m *mockOffChainBudgetCfg) DecodePaymentRequest(_ context.Context,
+	payReq string) (*lndclient.PaymentRequest, error) {
+
+	value, ok := m.invoices[payReq]
+	if !ok {
+		return nil, fmt.Errorf("unknown payment request")
+	}
+
+	return &lndclient.PaymentRequest{
+		Value: value,
+	}, nil
+}
+
+// requireSpent asserts that the given amount and fees in msat count towards
+// the budget of the given enforcer, including the ones of in-flight payments.
+func requireSpent(t *testing.T, enf *OffChainBudgetEnforcer, amt,
+	fees uint64) {
+
+	err := enf.GetStores().View(func(tx firewalldb.KVStoreTx) error {
+		ledger, err := loadBudgetLedger(
+			context.Background(), tx.Local(),
+		)
+		if err != nil {
+			return err
+		}
+
+		spentAmt, spentFees := ledger.total(
+			windowStart(time.Now(), enf.Window),
+		)
+		require.Equal(t, amt, spentAmt)
+		require.Equal(t, fees, spentFees)
+
+		return nil
+	})
+	require.NoError(t, err)
+}
@@ -0,0 +1,722 @@
+package rules
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/lightninglabs/lightning-terminal/firewalldb"
+	"github.com/lightninglabs/lightning-terminal/litrpc"
+	mid "github.com/lightninglabs/lightning-terminal/rpcmiddleware"
+	"github.com/lightninglabs/lndclient"
+	"github.com/lightninglabs/pool/poolrpc"
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"google.golang.org/protobuf/proto"
+)
+
+var (
+	// Compile-time checks to ensure that OnChainBudget, OnChainBudgetMgr
+	// and OnChainBudgetEnforcer implement the appropriate Manager,
+	// Enforcer and Values interface.
+	_ Manager  = (*OnChainBudgetMgr)(nil)
+	_ Enforcer = (*OnChainBudgetEnforcer)(nil)
+	_ Values   = (*OnChainBudget)(nil)
+)
+
+// OnChainBudgetName is the string identifier of the OnChainBudget rule.
+const OnChainBudgetName = "on-chain-budget"
+
+// OnChainBudgetMgr manages the OnChainBudget rule.
+type OnChainBudgetMgr struct{}
+
+// Stop cleans up the resources held by the manager.
+//
+// NOTE: This is part of the Manager interface.
+func (o *OnChainBudgetMgr) Stop() error {
+	return nil
+}
+
+// NewEnforcer constructs a new OnChainBudget rule enforcer using the passed
+// values and config.
+//
+// NOTE: This is part of the Manager interface.
+func (o *OnChainBudgetMgr) NewEnforcer(cfg Config, values Values) (Enforcer,
+	error) {
+
+	budget, ok := values.(*OnChainBudget)
+	if !ok {
+		return nil, fmt.Errorf("values must be of type "+
+			"OnChainBudget, got %T", values)
+	}
+
+	return &OnChainBudgetEnforcer{
+		onChainBudgetConfig: cfg,
+		OnChainBudget:       budget,
+	}, nil
+}
+
+// NewValueFromProto converts the given proto value into a OnChainBudget Value
+// object.
+//
+// NOTE: This is part of the Manager interface.
+func (o *OnChainBudgetMgr) NewValueFromProto(v *litrpc.RuleValue) (Values,
+	error) {
+
+	rv, ok := v.Value.(*litrpc.RuleValue_OnChainBudget)
+	if !ok {
+		return nil, fmt.Errorf("incorrect RuleValue type")
+	}
+
+	budget := rv.OnChainBudget
+	period := time.Duration(budget.PeriodSeconds) * time.Second
+
+	return &OnChainBudget{
+		AbsoluteAmtSats: budget.AbsoluteAmtSats,
+		PeriodAmtSats:   budget.PeriodAmtSats,
+		Period:          period,
+		MaxSatPerVByte:  budget.MaxSatPerVByte,
+	}, nil
+}
+
+// EmptyValue returns a new OnChainBudget instance.
+//
+// NOTE: This is part of the Manager interface.
+func (o *OnChainBudgetMgr) EmptyValue() Values {
+	return &OnChainBudget{}
+}
+
+// onChainBudgetConfig is the config required by OnChainBudgetMgr. It can be
+// derived from the main rules Config struct.
+type onChainBudgetConfig interface {
+	GetStores() firewalldb.KVStores
+	GetReqID() int64
+	GetLndClient() lndclient.LightningClient
+}
+
+// OnChainBudgetEnforcer enforces requests and responses against an
+// OnChainBudget rule.
+//
+// Each transaction reserves the amount it sends when its request is accepted.
+// The fees of a transaction are only known once it was published, so a
+// transaction is accepted if its amount fits into the remaining budget. Once
+// the transaction is published, the reservation is replaced with the amount
+// and fees that it actually spent from the lnd wallet. The reservation is
+// released if the call fails.
+type OnChainBudgetEnforcer struct {
+	onChainBudgetConfig
+	*OnChainBudget
+}
+
+// HandleRequest checks the validity of a request using the OnChainBudget
+// rpcmiddleware.RoundTripCheckers. If the request spends on-chain funds that
+// fit into the remaining budget, their amount is reserved.
+//
+// NOTE: this is part of the Enforcer interface.
+func (o *OnChainBudgetEnforcer) HandleRequest(ctx context.Context, uri string,
+	msg proto.Message) (proto.Message, error) {
+
+	checker, ok := o.checkers()[uri]
+	if !ok {
+		return nil, nil
+	}
+
+	if !checker.HandlesRequest(msg.ProtoReflect().Type()) {
+		return nil, fmt.Errorf("invalid implementation, checker for "+
+			"URI %s does not accept request of type %v", uri,
+			msg.ProtoReflect().Type())
+	}
+
+	return checker.HandleRequest(ctx, msg)
+}
+
+// HandleResponse handles a response using the OnChainBudget
+// rpcmiddleware.RoundTripCheckers. The reservation of a call is settled once
+// its transaction was published.
+//
+// NOTE: this is part of the Enforcer interface.
+func (o *OnChainBudgetEnforcer) HandleResponse(ctx context.Context, uri string,
+	msg proto.Message) (proto.Message, error) {
+
+	checker, ok := o.checkers()[uri]
+	if !ok {
+		return nil, nil
+	}
+
+	if !checker.HandlesResponse(msg.ProtoReflect().Type()) {
+		return nil, fmt.Errorf("invalid implementation, checker for "+
+			"URI %s does not accept response of type %v", uri,
+			msg.ProtoReflect().Type())
+	}
+
+	return checker.HandleResponse(ctx, msg)
+}
+
+// HandleErrorResponse releases the reservation of a call that failed with an
+// error. The error itself is passed through unchanged.
+//
+// NOTE: this is part of the Enforcer interface.
+func (o *OnChainBudgetEnforcer) HandleErrorResponse(ctx context.Context,
+	uri string, _ error) (error, error) {
+
+	if _, ok := o.checkers()[uri]; !ok {
+		return nil, nil
+	}
+
+	return nil, o.release(ctx)
+}
+
+// checkers returns a map of URI to rpcmiddleware.RoundTripChecker which define
+// how the URI should be handled.
+func (o *OnChainBudgetEnforcer) checkers() map[string]mid.RoundTripChecker {
+	return map[string]mid.RoundTripChecker{
+		"/lnrpc.Lightning/SendCoins": mid.NewFullChecker(
+			&lnrpc.SendCoinsRequest{},
+			&lnrpc.SendCoinsResponse{},
+			func(ctx context.Context,
+				r *lnrpc.SendCoinsRequest) error {
+
+				err := o.checkFeeRate(
+					r.SatPerVbyte, r.SatPerByte,
+				)
+				if err != nil {
+					return err
+				}
+
+				// Sending all coins sweeps the confirmed
+				// balance of the wallet, including the fees.
+				amt := r.Amount
+				if r.SendAll {
+					balance, err := o.GetLndClient().
+						WalletBalance(ctx)
+					if err != nil {
+						return err
+					}
+					amt = int64(balance.Confirmed)
+				}
+
+				return o.reserve(ctx, amt)
+			},
+			func(ctx context.Context,
+				r *lnrpc.SendCoinsResponse) (proto.Message,
+				error) {
+
+				return nil, o.settleTxStr(ctx, r.Txid)
+			}, mid.PassThroughErrorHandler,
+		),
+		"/lnrpc.Lightning/SendMany": mid.NewFullChecker(
+			&lnrpc.SendManyRequest{},
+			&lnrpc.SendManyResponse{},
+			func(ctx context.Context,
+				r *lnrpc.SendManyRequest) error {
+
+				err := o.checkFeeRate(
+					r.SatPerVbyte, r.SatPerByte,
+				)
+				if err != nil {
+					return err
+				}
+
+				var amt int64
+				for _, addrAmt := range r.AddrToAmount {
+					if addrAmt < 0 {
+						return fmt.Errorf("invalid " +
+							"amount")
+					}
+					amt += addrAmt
+				}
+
+				return o.reserve(ctx, amt)
+			},
+			func(ctx context.Context,
+				r *lnrpc.SendManyResponse) (proto.Message,
+				error) {
+
+				return nil, o.settleTxStr(ctx, r.Txid)
+			}, mid.PassThroughErrorHandler,
+		),
+		"/lnrpc.Lightning/OpenChannel": mid.NewFullChecker(
+			&lnrpc.OpenChannelRequest{},
+			&lnrpc.OpenStatusUpdate{},
+			func(ctx context.Context,
+				r *lnrpc.OpenChannelRequest) error {
+
+				return o.checkOpenChannel(ctx, r)
+			},
+			// The funding transaction is published before the
+			// channel is reported as pending.
+			func(ctx context.Context,
+				r *lnrpc.OpenStatusUpdate) (proto.Message,
+				error) {
+
+				pending := r.GetChanPending()
+				if pending == nil {
+					return nil, nil
+				}
+
+				return nil, o.settleTxBytes(ctx, pending.Txid)
+			}, mid.PassThroughErrorHandler,
+		),
+		"/lnrpc.Lightning/OpenChannelSync": mid.NewFullChecker(
+			&lnrpc.OpenChannelRequest{},
+			&lnrpc.ChannelPoint{},
+			func(ctx context.Context,
+				r *lnrpc.OpenChannelRequest) error {
+
+				return o.checkOpenChannel(ctx, r)
+			},
+			func(ctx context.Context,
+				r *lnrpc.ChannelPoint) (proto.Message, error) {
+
+				txid, err := lnrpc.GetChanPointFundingTxid(r)
+				if err != nil {
+					return nil, o.confirm(ctx)
+				}
+
+				return nil, o.settleTx(ctx, txid)
+			}, mid.PassThroughErrorHandler,
+		),
+		"/lnrpc.Lightning/BatchOpenChannel": mid.NewFullChecker(
+			&lnrpc.BatchOpenChannelRequest{},
+			&lnrpc.BatchOpenChannelResponse{},
+			func(ctx context.Context,
+				r *lnrpc.BatchOpenChannelRequest) error {
+
+				if r.SatPerVbyte < 0 {
+					return fmt.Errorf("invalid fee rate")
+				}
+
+				err := o.checkFeeRate(uint64(r.SatPerVbyte), 0)
+				if err != nil {
+					return err
+				}
+
+				var amt int64
+				for _, c := range r.Channels {
+					if c.LocalFundingAmount < 0 {
+						return fmt.Errorf("invalid " +
+							"funding amount")
+					}
+					amt += c.LocalFundingAmount
+				}
+
+				return o.reserve(ctx, amt)
+			},
+			// All channels of the batch are funded by the same
+			// transaction.
+			func(ctx context.Context,
+				r *lnrpc.BatchOpenChannelResponse) (
+				proto.Message, error) {
+
+				if len(r.PendingChannels) == 0 {
+					return nil, o.confirm(ctx)
+				}
+
+				return nil, o.settleTxBytes(
+					ctx, r.PendingChannels[0].Txid,
+				)
+			}, mid.PassThroughErrorHandler,
+		),
+		"/poolrpc.Trader/InitAccount": mid.NewFullChecker(
+			&poolrpc.InitAccountRequest{},
+			&poolrpc.Account{},
+			func(ctx context.Context,
+				r *poolrpc.InitAccountRequest) error {
+
+				err := o.checkFeeRate(
+					satPerKWToSatPerVByte(
+						r.GetFeeRateSatPerKw(),
+					), 0,
+				)
+				if err != nil {
+					return err
+				}
+
+				return o.reserve(ctx, int64(r.AccountValue))
+			},
+			func(ctx context.Context,
+				r *poolrpc.Account) (proto.Message, error) {
+
+				return nil, o.settleTxBytes(ctx, r.LatestTxid)
+			}, mid.PassThroughErrorHandler,
+		),
+		"/poolrpc.Trader/DepositAccount": mid.NewFullChecker(
+			&poolrpc.DepositAccountRequest{},
+			&poolrpc.DepositAccountResponse{},
+			func(ctx context.Context,
+				r *poolrpc.DepositAccountRequest) error {
+
+				err := o.checkFeeRate(
+					satPerKWToSatPerVByte(
+						r.FeeRateSatPerKw,
+					), 0,
+				)
+				if err != nil {
+					return err
+				}
+
+				return o.reserve(ctx, int64(r.AmountSat))
+			},
+			func(ctx context.Context,
+				r *poolrpc.DepositAccountResponse) (
+				proto.Message, error) {
+
+				return nil, o.settleTxBytes(ctx, r.DepositTxid)
+			}, mid.PassThroughErrorHandler,
+		),
+	}
+}
+
+// checkOpenChannel checks the fee rate of the given channel open request and
+// reserves its funding amount. The funding amount is reserved even if the
+// channel is funded by an external PSBT, as the budget can't tell where the
+// funds come from.
+func (o *OnChainBudgetEnforcer) checkOpenChannel(ctx context.Context,
+	r *lnrpc.OpenChannelRequest) error {
+
+	if err := o.checkFeeRate(r.SatPerVbyte, r.SatPerByte); err != nil {
+		return err
+	}
+
+	return o.reserve(ctx, r.LocalFundingAmount)
+}
+
+// checkFeeRate checks that the fee rate of a request, given either in sat/vByte
+// or in the deprecated sat/byte, doesn't exceed the maximum fee rate. If a
+// maximum is set, the fee rate must be set explicitly, as lnd would otherwise
+// estimate it from a confirmation target.
+func (o *OnChainBudgetEnforcer) checkFeeRate(satPerVByte uint64,
+	satPerByte int64) error {
+
+	if satPerByte < 0 {
+		return fmt.Errorf("invalid fee rate")
+	}
+
+	if o.MaxSatPerVByte == 0 {
+		return nil
+	}
+
+	feeRate := satPerVByte
+	if feeRate == 0 {
+		feeRate = uint64(satPerByte)
+	}
+
+	if feeRate == 0 {
+		return fmt.Errorf("an explicit fee rate of at most %d "+
+			"sat/vByte is required", o.MaxSatPerVByte)
+	}
+
+	if feeRate > o.MaxSatPerVByte {
+		return fmt.Errorf("fee rate of %d sat/vByte exceeds the "+
+			"maximum of %d sat/vByte", feeRate, o.MaxSatPerVByte)
+	}
+
+	return nil
+}
+
+// satPerKWToSatPerVByte converts a fee rate in sat/kw to sat/vByte, rounding
+// up so that the fee rate check can't be bypassed by a fractional fee rate.
+func satPerKWToSatPerVByte(satPerKW uint64) uint64 {
+	return (satPerKW*4 + 999) / 1000
+}
+
+// reserve reserves the given amount in sats for the current request if it
+// fits into the remaining absolute and period budget.
+func (o *OnChainBudgetEnforcer) reserve(ctx context.Context, amt int64) error {
+	if amt < 0 {
+		return fmt.Errorf("invalid amount")
+	}
+
+	return updateBudgetLedger(
+		ctx, o.GetStores(), o.Period,
+		func(ledger *budgetLedger, now time.Time) error {
+			spentAmt, spentFees := ledger.total(time.Time{})
+			spent := spentAmt + spentFees
+			if spent+uint64(amt) > o.AbsoluteAmtSats {
+				return fmt.Errorf("amount of %d sats exceeds "+
+					"the remaining on-chain budget of %d "+
+					"sats", amt,
+					remaining(o.AbsoluteAmtSats, spent))
+			}
+
+			if o.PeriodAmtSats != 0 {
+				spentAmt, spentFees = ledger.total(
+					windowStart(now, o.Period),
+				)
+				spent = spentAmt + spentFees
+				if spent+uint64(amt) > o.PeriodAmtSats {
+					return fmt.Errorf("amount of %d sats "+
+						"exceeds the remaining "+
+						"on-chain budget of %d sats "+
+						"for the current period", amt,
+						remaining(
+							o.PeriodAmtSats, spent,
+						))
+				}
+			}
+
+			ledger.reserve(o.GetReqID(), uint64(amt), 0, now)
+
+			return nil
+		},
+	)
+}
+
+// settleTxStr settles the reservation of the current request with the amounts
+// spent by the transaction with the given hex encoded hash.
+func (o *OnChainBudgetEnforcer) settleTxStr(ctx context.Context,
+	txid string) error {
+
+	hash, err := chainhash.NewHashFromStr(txid)
+	if err != nil {
+		log.Warnf("Invalid txid %s in response for request %d: %v",
+			txid, o.GetReqID(), err)
+
+		return o.confirm(ctx)
+	}
+
+	return o.settleTx(ctx, hash)
+}
+
+// settleTxBytes settles the reservation of the current request with the
+// amounts spent by the transaction with the given hash.
+func (o *OnChainBudgetEnforcer) settleTxBytes(ctx context.Context,
+	txid []byte) error {
+
+	hash, err := chainhash.NewHash(txid)
+	if err != nil {
+		log.Warnf("Invalid txid %x in response for request %d: %v",
+			txid, o.GetReqID(), err)
+
+		return o.confirm(ctx)
+	}
+
+	return o.settleTx(ctx, hash)
+}
+
+// settleTx settles the reservation of the current request with the amount and
+// fees that the given transaction spent from the lnd wallet. The transaction
+// was published already, so if it can't be found in the wallet, the reserved
+// amount is kept instead of failing the call.
+func (o *OnChainBudgetEnforcer) settleTx(ctx context.Context,
+	txid *chainhash.Hash) error {
+
+	amt, fees, err := o.walletSpend(ctx, txid)
+	if err != nil {
+		log.Warnf("Could not look up the amount spent by "+
+			"transaction %v, keeping the reserved amount: %v",
+			txid, err)
+
+		return o.confirm(ctx)
+	}
+
+	return updateBudgetLedger(
+		ctx, o.GetStores(), o.Period,
+		func(ledger *budgetLedger, now time.Time) error {
+			ledger.settle(o.GetReqID(), amt, fees, now)
+
+			return nil
+		},
+	)
+}
+
+// walletSpend returns the amount excluding fees and the fees in sats that the
+// transaction with the given hash spent from the lnd wallet.
+func (o *OnChainBudgetEnforcer) walletSpend(ctx context.Context,
+	txid *chainhash.Hash) (uint64, uint64, error) {
+
+	info, err := o.GetLndClient().GetInfo(ctx)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	// The transaction was just published, so it is either unconfirmed or
+	// confirmed in one of the latest blocks.
+	txs, err := o.GetLndClient().ListTransactions(
+		ctx, int32(info.BlockHeight), -1,
+	)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	for _, tx := range txs {
+		if tx.TxHash != txid.String() {
+			continue
+		}
+
+		// The amount of a transaction is the change of the wallet
+		// balance, which includes the fees.
+		var spent, fees uint64
+		if tx.Amount < 0 {
+			spent = uint64(-tx.Amount)
+		}
+		if tx.Fee > 0 {
+			fees = uint64(tx.Fee)
+		}
+
+		if spent < fees {
+			return 0, spent, nil
+		}
+
+		return spent - fees, fees, nil
+	}
+
+	return 0, 0, fmt.Errorf("transaction not found in wallet")
+}
+
+// confirm marks the reservation of the current request as spent in full.
+func (o *OnChainBudgetEnforcer) confirm(ctx context.Context) error {
+	return updateBudgetLedger(
+		ctx, o.GetStores(), o.Period,
+		func(ledger *budgetLedger, now time.Time) error {
+			ledger.confirm(o.GetReqID(), now)
+
+			return nil
+		},
+	)
+}
+
+// release removes the reservation of the current request.
+func (o *OnChainBudgetEnforcer) release(ctx context.Context) error {
+	return updateBudgetLedger(
+		ctx, o.GetStores(), o.Period,
+		func(ledger *budgetLedger, _ time.Time) error {
+			ledger.release(o.GetReqID())
+
+			return nil
+		},
+	)
+}
+
+// OnChainBudget represents the on-chain budget rule values.
+type OnChainBudget struct {
+	// AbsoluteAmtSats is the maximum amount in sats that can be spent
+	// on-chain over the whole lifetime of the session, including fees.
+	AbsoluteAmtSats uint64 `json:"absolute_amt_sats"`
+
+	// PeriodAmtSats is the maximum amount in sats that can be spent
+	// on-chain within each rolling period, including fees. If it is zero,
+	// only the absolute amount is limited.
+	PeriodAmtSats uint64 `json:"period_amt_sats"`
+
+	// Period is the duration of the rolling period that PeriodAmtSats
+	// applies to.
+	Period time.Duration `json:"period"`
+
+	// MaxSatPerVByte is the maximum fee rate in sat/vByte that on-chain
+	// transactions can use. If it is zero, the fee rate is not limited.
+	MaxSatPerVByte uint64 `json:"max_sat_per_v_byte"`
+}
+
+// VerifySane checks that the value of the values is ok given the min and max
+// allowed values.
+//
+// NOTE: this is part of the Values interface.
+func (o *OnChainBudget) VerifySane(minVal, maxVal Values) error {
+	minOB, ok := minVal.(*OnChainBudget)
+	if !ok {
+		return fmt.Errorf("min value is not of type OnChainBudget")
+	}
+
+	maxOB, ok := maxVal.(*OnChainBudget)
+	if !ok {
+		return fmt.Errorf("max value is not of type OnChainBudget")
+	}
+
+	if (o.PeriodAmtSats == 0) != (o.Period == 0) {
+		return fmt.Errorf("period amount and period must be set " +
+			"together")
+	}
+
+	if o.AbsoluteAmtSats < minOB.AbsoluteAmtSats ||
+		o.AbsoluteAmtSats > maxOB.AbsoluteAmtSats {
+
+		return fmt.Errorf("absolute amount is not between the min " +
+			"and max")
+	}
+
+	// A period budget is only required if the max value has one. If it is
+	// set, a shorter period allows more to be spent over time, so only the
+	// minimum period is checked.
+	if o.PeriodAmtSats == 0 && maxOB.PeriodAmtSats != 0 {
+		return fmt.Errorf("a period amount is required")
+	}
+
+	if o.PeriodAmtSats != 0 {
+		if !between(o.PeriodAmtSats, minOB.PeriodAmtSats,
+			maxOB.PeriodAmtSats) {
+
+			return fmt.Errorf("period amount is not between the " +
+				"min and max")
+		}
+
+		if o.Period < minOB.Period {
+			return fmt.Errorf("period must be at least %v",
+				minOB.Period)
+		}
+	}
+
+	// A zero fee rate means that the fee rate isn't limited, which is only
+	// allowed if the max value doesn't limit it either.
+	if o.MaxSatPerVByte == 0 && maxOB.MaxSatPerVByte != 0 {
+		return fmt.Errorf("a max fee rate is required")
+	}
+
+	if o.MaxSatPerVByte != 0 && !between(o.MaxSatPerVByte,
+		minOB.MaxSatPerVByte, maxOB.MaxSatPerVByte) {
+
+		return fmt.Errorf("max fee rate is not between the min and " +
+			"max")
+	}
+
+	return nil
+}
+
+// between returns true if the given value lies between the given min and max
+// value. A max value of zero means that there is no upper bound.
+func between(value, minVal, maxVal uint64) bool {
+	return value >= minVal && (maxVal == 0 || value <= maxVal)
+}
+
+// RuleName returns the name of the rule that these values are to be used with.
+//
+// NOTE: this is part of the Values interface.
+func (o *OnChainBudget) RuleName() string {
+	return OnChainBudgetName
+}
+
+// ToProto converts the rule Values to the litrpc counterpart.
+//
+// NOTE: this is part of the Values interface.
+func (o *OnChainBudget) ToProto() *litrpc.RuleValue {
+	return &litrpc.RuleValue{
+		Value: &litrpc.RuleValue_OnChainBudget{
+			OnChainBudget: &litrpc.OnChainBudget{
+				AbsoluteAmtSats: o.AbsoluteAmtSats,
+				MaxSatPerVByte:  o.MaxSatPerVByte,
+				PeriodAmtSats:   o.PeriodAmtSats,
+				PeriodSeconds:   uint64(o.Period.Seconds()),
+			},
+		},
+	}
+}
+
+// PseudoToReal attempts to convert any appropriate pseudo fields in the rule
+// Values to their corresponding real values. It uses the passed PrivacyMapDB to
+// find the real values. This is a no-op for the OnChainBudget rule.
+//
+// NOTE: this is part of the Values interface.
+func (o *OnChainBudget) PseudoToReal(_ firewalldb.PrivacyMapDB) (Values,
+	error) {
+
+	return o, nil
+}
+
+// RealToPseudo converts the rule Values to a new one that uses pseudo keys,
+// channel IDs, channel points etc. It returns a map of real to pseudo strings
+// that should be persisted. This is a no-op for the OnChainBudget rule.
+//
+// NOTE: this is part of the Values interface.
+func (o *OnChainBudget) RealToPseudo() (Values, map[string]string, error) {
+	return o, nil, nil
+}
@@ -0,0 +1,397 @@
+package rules
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/lightninglabs/lightning-terminal/firewalldb"
+	"github.com/lightninglabs/lndclient"
+	"github.com/lightninglabs/pool/poolrpc"
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/stretchr/testify/require"
+)
+
+// TestOnChainBudgetCheckValues tests that the OnChainBudget values correctly
+// implement the VerifySane method.
+func TestOnChainBudgetCheckValues(t *testing.T) {
+	minBudget := &OnChainBudget{
+		AbsoluteAmtSats: 1000,
+		PeriodAmtSats:   100,
+		Period:          time.Hour,
+		MaxSatPerVByte:  1,
+	}
+	maxBudget := &OnChainBudget{
+		AbsoluteAmtSats: 100000,
+		PeriodAmtSats:   10000,
+		Period:          time.Hour * 24,
+		MaxSatPerVByte:  100,
+	}
+
+	tests := []struct {
+		name      string
+		budget    *OnChainBudget
+		maxBudget *OnChainBudget
+		expectErr error
+	}{
+		{
+			name: "valid",
+			budget: &OnChainBudget{
+				AbsoluteAmtSats: 50000,
+				PeriodAmtSats:   5000,
+				Period:          time.Hour * 2,
+				MaxSatPerVByte:  10,
+			},
+		},
+		{
+			name: "valid without period and fee rate limits",
+			budget: &OnChainBudget{
+				AbsoluteAmtSats: 50000,
+			},
+			maxBudget: &OnChainBudget{
+				AbsoluteAmtSats: 100000,
+			},
+		},
+		{
+			name: "absolute amount too large",
+			budget: &OnChainBudget{
+				AbsoluteAmtSats: 100001,
+				PeriodAmtSats:   5000,
+				Period:          time.Hour,
+				MaxSatPerVByte:  10,
+			},
+			expectErr: fmt.Errorf("absolute amount is not " +
+				"between the min and max"),
+		},
+		{
+			name: "period without amount",
+			budget: &OnChainBudget{
+				AbsoluteAmtSats: 50000,
+				Period:          time.Hour,
+				MaxSatPerVByte:  10,
+			},
+			expectErr: fmt.Errorf("period amount and period must " +
+				"be set together"),
+		},
+		{
+			name: "period amount required",
+			budget: &OnChainBudget{
+				AbsoluteAmtSats: 50000,
+				MaxSatPerVByte:  10,
+			},
+			expectErr: fmt.Errorf("a period amount is required"),
+		},
+		{
+			name: "period amount too small",
+			budget: &OnChainBudget{
+				AbsoluteAmtSats: 50000,
+				PeriodAmtSats:   99,
+				Period:          time.Hour,
+				MaxSatPerVByte:  10,
+			},
+			expectErr: fmt.Errorf("period amount is not between " +
+				"the min and max"),
+		},
+		{
+			name: "period too short",
+			budget: &OnChainBudget{
+				AbsoluteAmtSats: 50000,
+				PeriodAmtSats:   5000,
+				Period:          time.Minute,
+				MaxSatPerVByte:  10,
+			},
+			expectErr: fmt.Errorf("period must be at least 1h0m0s"),
+		},
+		{
+			name: "fee rate required",
+			budget: &OnChainBudget{
+				AbsoluteAmtSats: 50000,
+				PeriodAmtSats:   5000,
+				Period:          time.Hour,
+			},
+			expectErr: fmt.Errorf("a max fee rate is required"),
+		},
+		{
+			name: "fee rate too large",
+			budget: &OnChainBudget{
+				AbsoluteAmtSats: 50000,
+				PeriodAmtSats:   5000,
+				Period:          time.Hour,
+				MaxSatPerVByte:  101,
+			},
+			expectErr: fmt.Errorf("max fee rate is not between " +
+				"the min and max"),
+		},
+	}
+
+	for _, test := range tests {
+		maxVal := maxBudget
+		if test.maxBudget != nil {
+			maxVal = test.maxBudget
+		}
+
+		err := test.budget.VerifySane(minBudget, maxVal)
+		if test.expectErr == nil {
+			require.NoError(t, err, test.name)
+			continue
+		}
+
+		require.EqualError(t, err, test.expectErr.Error(), test.name)
+	}
+}
+
+// TestOnChainBudget tests that the OnChainBudgetEnforcer enforces the absolute
+// and period budget as well as the max fee rate, and that published
+// transactions are counted with the amounts they actually spent.
+func TestOnChainBudget(t *testing.T) {
+	ctx := context.Background()
+	cfg := newMockOnChainBudgetCfg(t)
+
+	enf := &OnChainBudgetEnforcer{
+		onChainBudgetConfig: cfg,
+		OnChainBudget: &OnChainBudget{
+			AbsoluteAmtSats: 100000,
+			PeriodAmtSats:   60000,
+			Period:          time.Hour,
+			MaxSatPerVByte:  10,
+		},
+	}
+
+	const sendCoinsURI = "/lnrpc.Lightning/SendCoins"
+
+	// The fee rate must be set explicitly and can't exceed the maximum.
+	cfg.reqID = 1
+	_, err := enf.HandleRequest(ctx, sendCoinsURI, &lnrpc.SendCoinsRequest{
+		Amount:     10000,
+		TargetConf: 6,
+	})
+	require.ErrorContains(t, err, "explicit fee rate")
+
+	_, err = enf.HandleRequest(ctx, sendCoinsURI, &lnrpc.SendCoinsRequest{
+		Amount:      10000,
+		SatPerVbyte: 11,
+	})
+	require.ErrorContains(t, err, "exceeds the maximum of 10 sat/vByte")
+
+	// A valid send reserves its amount. Once it was published, the amount
+	// and fees it spent from the wallet are counted.
+	_, err = enf.HandleRequest(ctx, sendCoinsURI, &lnrpc.SendCoinsRequest{
+		Amount:      40000,
+		SatPerVbyte: 10,
+	})
+	require.NoError(t, err)
+	requireOnChainSpent(t, enf, 40000, 40000)
+
+	txid := cfg.addTx(40000, 500)
+	_, err = enf.HandleResponse(ctx, sendCoinsURI, &lnrpc.SendCoinsResponse{
+		Txid: txid.String(),
+	})
+	require.NoError(t, err)
+	requireOnChainSpent(t, enf, 40500, 40500)
+
+	// Another send of 20000 sats exceeds the period budget.
+	const sendManyURI = "/lnrpc.Lightning/SendMany"
+	cfg.reqID = 2
+	_, err = enf.HandleRequest(ctx, sendManyURI, &lnrpc.SendManyRequest{
+		AddrToAmount: map[string]int64{
+			"addr1": 10000,
+			"addr2": 10000,
+		},
+		SatPerByte: 5,
+	})
+	require.ErrorContains(t, err, "budget of 19500 sats for the current "+
+		"period")
+
+	// Move the first send out of the period. It still counts towards the
+	// absolute budget.
+	cfg.ageSpends(t, 2*time.Hour)
+	requireOnChainSpent(t, enf, 40500, 0)
+
+	// A channel open that fails releases its reservation again.
+	const openChannelURI = "/lnrpc.Lightning/OpenChannel"
+	cfg.reqID = 3
+	_, err = enf.HandleRequest(
+		ctx, openChannelURI, &lnrpc.OpenChannelRequest{
+			LocalFundingAmount: 50000,
+			SatPerVbyte:        2,
+		},
+	)
+	require.NoError(t, err)
+	requireOnChainSpent(t, enf, 90500, 50000)
+
+	_, err = enf.HandleErrorResponse(
+		ctx, openChannelURI, fmt.Errorf("peer offline"),
+	)
+	require.NoError(t, err)
+	requireOnChainSpent(t, enf, 40500, 0)
+
+	// A channel open is settled once the channel is pending. If its
+	// funding transaction can't be found, the reserved amount is kept.
+	cfg.reqID = 4
+	_, err = enf.HandleRequest(
+		ctx, openChannelURI, &lnrpc.OpenChannelRequest{
+			LocalFundingAmount: 50000,
+			SatPerVbyte:        2,
+		},
+	)
+	require.NoError(t, err)
+
+	_, err = enf.HandleResponse(
+		ctx, openChannelURI, &lnrpc.OpenStatusUpdate{
+			Update: &lnrpc.OpenStatusUpdate_ChanPending{
+				ChanPending: &lnrpc.PendingUpdate{
+					Txid: make([]byte, 32),
+				},
+			},
+		},
+	)
+	require.NoError(t, err)
+	requireOnChainSpent(t, enf, 90500, 50000)
+
+	// The remaining absolute budget is 9500 sats, so a Pool account of
+	// 10000 sats can't be funded.
+	const initAccountURI = "/poolrpc.Trader/InitAccount"
+	cfg.reqID = 5
+	_, err = enf.HandleRequest(
+		ctx, initAccountURI, &poolrpc.InitAccountRequest{
+			AccountValue: 10000,
+			Fees: &poolrpc.InitAccountRequest_FeeRateSatPerKw{
+				FeeRateSatPerKw: 2500,
+			},
+		},
+	)
+	require.ErrorContains(t, err, "remaining on-chain budget of 9500 sats")
+
+	// Sending the whole wallet balance counts the confirmed balance.
+	cfg.balance = 9000
+	_, err = enf.HandleRequest(ctx, sendCoinsURI, &lnrpc.SendCoinsRequest{
+		SendAll:     true,
+		SatPerVbyte: 1,
+	})
+	require.NoError(t, err)
+	requireOnChainSpent(t, enf, 99500, 59000)
+}
+
+// mockOnChainBudgetCfg is used to mock the config backend given to the
+// OnChainBudgetEnforcer during testing.
+type mockOnChainBudgetCfg struct {
+	lndclient.LightningClient
+
+	stores  firewalldb.KVStores
+	reqID   int64
+	balance btcutil.Amount
+	txs     []lndclient.Transaction
+}
+
+var _ onChainBudgetConfig = (*mockOnChainBudgetCfg)(nil)
+
+// newMockOnChainBudgetCfg creates a new mock config that is backed by a
+// temporary rules DB.
+func newMockOnChainBudgetCfg(t *testing.T) *mockOnChainBudgetCfg {
+	db, err := firewalldb.NewDB(t.TempDir(), "test.db")
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_ = db.Close()
+	})
+
+	return &mockOnChainBudgetCfg{
+		stores: db.GetKVStores(
+			OnChainBudgetName, [4]byte{1, 2, 3, 4}, "feature",
+		),
+	}
+}
+
+func (m *mockOnChainBudgetCfg) GetStores() firewalldb.KVStores {
+	return m.stores
+}
+
+func (m *mockOnChainBudgetCfg) GetReqID() int64 {
+	return m.reqID
+}
+
+func (m *mockOnChainBudgetCfg) GetLndClient() lndclient.LightningClient {
+	return m
+}
+
+func (m *mockOnChainBudgetCfg) GetInfo(_ context.Context) (*lndclient.Info,
+	error) {
+
+	return &lndclient.Info{
+		BlockHeight: 100,
+	}, nil
+}
+
+func (m *mockOnChainBudgetCfg) WalletBalance(_ context.Context) (
+	*lndclient.WalletBalance, error) {
+
+	return &lndclient.WalletBalance{
+		Confirmed: m.balance,
+	}, nil
+}
+
+func (m *mockOnChainBudgetCfg) ListTransactions(_ context.Context, _,
+	_ int32, _ ...lndclient.ListTransactionsOption) (
+	[]lndclient.Transaction, error) {
+
+	return m.txs, nil
+}
+
+// addTx adds a transaction to the mock wallet that sends the given amount and
+// pays the given fees.
+func (m *mockOnChainBudgetCfg) addTx(amt, fees int64) chainhash.Hash {
+	var txid chainhash.Hash
+	txid[0] = byte(len(m.txs) + 1)
+
+	m.txs = append(m.txs, lndclient.Transaction{
+		TxHash: txid.String(),
+		Amount: btcutil.Amount(-amt - fees),
+		Fee:    btcutil.Amount(fees),
+	})
+
+	return txid
+}
+
+// ageSpends moves all spends of the budget the given duration into the past.
+func (m *mockOnChainBudgetCfg) ageSpends(t *testing.T, age time.Duration) {
+	ctx := context.Background()
+	err := m.stores.Update(func(tx firewalldb.KVStoreTx) error {
+		ledger, err := loadBudgetLedger(ctx, tx.Local())
+		if err != nil {
+			return err
+		}
+
+		for _, spend := range ledger.spends {
+			spend.Time = spend.Time.Add(-age)
+		}
+
+		return ledger.save(ctx, tx.Local(), time.Time{})
+	})
+	require.NoError(t, err)
+}
+
+// requireOnChainSpent asserts the total amount including fees in sats that
+// counts towards the absolute and the period budget of the given enforcer.
+func requireOnChainSpent(t *testing.T, enf *OnChainBudgetEnforcer, absolute,
+	period uint64) {
+
+	err := enf.GetStores().View(func(tx firewalldb.KVStoreTx) error {
+		ledger, err := loadBudgetLedger(
+			context.Background(), tx.Local(),
+		)
+		if err != nil {
+			return err
+		}
+
+		amt, fees := ledger.total(time.Time{})
+		require.Equal(t, absolute, amt+fees)
+
+		amt, fees = ledger.total(windowStart(time.Now(), enf.Period))
+		require.Equal(t, period, amt+fees)
+
+		return nil
+	})
+	require.NoError(t, err)
+}
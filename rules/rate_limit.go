@@ -170,7 +170,16 @@ func (r *RateLimitEnforcer) HandleErrorResponse(_ context.Context, _ string,
 // isRead is a helper that returns true if the given method/URI only requires
 // read-permissions and false otherwise.
 func (r *RateLimitEnforcer) isRead(method string) bool {
-	perms, ok := r.GetMethodPerms()(method)
+	return isReadMethod(r.GetMethodPerms(), method)
+}
+
+// isReadMethod returns true if the given method/URI only requires
+// read-permissions according to the given method permissions and false
+// otherwise.
+func isReadMethod(methodPerms func(string) ([]bakery.Op, bool),
+	method string) bool {
+
+	perms, ok := methodPerms(method)
 	if !ok {
 		return false
 	}
@@ -0,0 +1,374 @@
+package rules
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/lightninglabs/lightning-terminal/firewalldb"
+	"github.com/lightninglabs/lightning-terminal/litrpc"
+	"github.com/lightninglabs/lightning-terminal/session"
+	"google.golang.org/protobuf/proto"
+	"gopkg.in/macaroon-bakery.v2/bakery"
+)
+
+var (
+	// Compile-time checks to ensure that TimeWindow, TimeWindowMgr and
+	// TimeWindowEnforcer implement the appropriate Manager, Enforcer and
+	// Values interface.
+	_ Manager  = (*TimeWindowMgr)(nil)
+	_ Enforcer = (*TimeWindowEnforcer)(nil)
+	_ Values   = (*TimeWindow)(nil)
+)
+
+// TimeWindowName is the string identifier of the TimeWindow rule.
+const TimeWindowName = "time-window"
+
+// TimeWindowMgr manages the TimeWindow rule.
+type TimeWindowMgr struct{}
+
+// Stop cleans up the resources held by the manager.
+//
+// NOTE: This is part of the Manager interface.
+func (t *TimeWindowMgr) Stop() error {
+	return nil
+}
+
+// NewEnforcer constructs a new TimeWindow rule enforcer using the passed
+// values and config.
+//
+// NOTE: This is part of the Manager interface.
+func (t *TimeWindowMgr) NewEnforcer(cfg Config, values Values) (Enforcer,
+	error) {
+
+	timeWindow, ok := values.(*TimeWindow)
+	if !ok {
+		return nil, fmt.Errorf("values must be of type "+
+			"TimeWindow, got %T", values)
+	}
+
+	readWindows, err := parseWeekdayWindows(
+		timeWindow.ReadWindows, timeWindow.Timezone,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	writeWindows, err := parseWeekdayWindows(
+		timeWindow.WriteWindows, timeWindow.Timezone,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &TimeWindowEnforcer{
+		timeWindowConfig: cfg,
+		TimeWindow:       timeWindow,
+		readWindows:      readWindows,
+		writeWindows:     writeWindows,
+		now:              time.Now,
+	}, nil
+}
+
+// NewValueFromProto converts the given proto value into a TimeWindow Value
+// object.
+//
+// NOTE: This is part of the Manager interface.
+func (t *TimeWindowMgr) NewValueFromProto(v *litrpc.RuleValue) (Values,
+	error) {
+
+	rv, ok := v.Value.(*litrpc.RuleValue_TimeWindow)
+	if !ok {
+		return nil, fmt.Errorf("incorrect RuleValue type")
+	}
+
+	timeWindow := rv.TimeWindow
+	if len(timeWindow.ReadWindows) == 0 &&
+		len(timeWindow.WriteWindows) == 0 {
+
+		return nil, fmt.Errorf("time windows cannot be empty. If " +
+			"calls should be allowed at any time then there is " +
+			"no need to add the rule")
+	}
+
+	fromProto := func(windows []*litrpc.WeekdayWindow) []*WeekdayWindow {
+		values := make([]*WeekdayWindow, len(windows))
+		for i, w := range windows {
+			weekdays := make([]time.Weekday, len(w.Weekdays))
+			for j, weekday := range w.Weekdays {
+				weekdays[j] = time.Weekday(weekday)
+			}
+
+			values[i] = &WeekdayWindow{
+				Weekdays: weekdays,
+				Start:    w.Start,
+				End:      w.End,
+			}
+		}
+
+		return values
+	}
+
+	values := &TimeWindow{
+		ReadWindows:  fromProto(timeWindow.ReadWindows),
+		WriteWindows: fromProto(timeWindow.WriteWindows),
+		Timezone:     timeWindow.Timezone,
+	}
+
+	// Make sure that the windows are valid so that an invalid rule is
+	// rejected when the session is created rather than when it is used.
+	_, err := parseWeekdayWindows(values.ReadWindows, values.Timezone)
+	if err != nil {
+		return nil, fmt.Errorf("invalid read window: %w", err)
+	}
+
+	_, err = parseWeekdayWindows(values.WriteWindows, values.Timezone)
+	if err != nil {
+		return nil, fmt.Errorf("invalid write window: %w", err)
+	}
+
+	return values, nil
+}
+
+// EmptyValue returns a new TimeWindow instance.
+//
+// NOTE: This is part of the Manager interface.
+func (t *TimeWindowMgr) EmptyValue() Values {
+	return &TimeWindow{}
+}
+
+// timeWindowConfig is the config required by TimeWindowMgr. It can be derived
+// from the main rules Config struct.
+type timeWindowConfig interface {
+	GetMethodPerms() func(string) ([]bakery.Op, bool)
+}
+
+// TimeWindowEnforcer enforces requests and responses against a TimeWindow
+// rule.
+type TimeWindowEnforcer struct {
+	timeWindowConfig
+	*TimeWindow
+
+	readWindows  []*weekdayWindow
+	writeWindows []*weekdayWindow
+
+	// now returns the current time. It can be overridden in tests.
+	now func() time.Time
+}
+
+// HandleRequest checks the validity of a request. It checks if the request is
+// a read or a write request and rejects it if it is made outside the time
+// windows for that type of request.
+//
+// NOTE: this is part of the Enforcer interface.
+func (t *TimeWindowEnforcer) HandleRequest(_ context.Context, uri string,
+	_ proto.Message) (proto.Message, error) {
+
+	windows, callType := t.writeWindows, "write"
+	if isReadMethod(t.GetMethodPerms(), uri) {
+		windows, callType = t.readWindows, "read-only"
+	}
+
+	// If there are no windows for this type of call, it is allowed at any
+	// time.
+	if len(windows) == 0 {
+		return nil, nil
+	}
+
+	now := t.now()
+	for _, window := range windows {
+		if window.contains(now) {
+			return nil, nil
+		}
+	}
+
+	return nil, fmt.Errorf("%s calls are only allowed within the "+
+		"configured time windows", callType)
+}
+
+// HandleResponse handles and possible alters a response. This is a noop for
+// the TimeWindow rule.
+//
+// NOTE: this is part of the Enforcer interface.
+func (t *TimeWindowEnforcer) HandleResponse(_ context.Context, _ string,
+	_ proto.Message) (proto.Message, error) {
+
+	return nil, nil
+}
+
+// HandleErrorResponse handles and possible alters an error. This is a noop for
+// the TimeWindow rule.
+//
+// NOTE: this is part of the Enforcer interface.
+func (t *TimeWindowEnforcer) HandleErrorResponse(_ context.Context, _ string,
+	_ error) (error, error) {
+
+	return nil, nil
+}
+
+// weekdayWindow is a parsed WeekdayWindow.
+type weekdayWindow struct {
+	// weekdays holds the weekdays on which the window opens. If it is
+	// empty, the window opens on every day.
+	weekdays map[time.Weekday]bool
+
+	// window is the time of day during which the window is open.
+	window session.TimeWindow
+}
+
+// parseWeekdayWindows parses the given windows in the timezone with the given
+// IANA name.
+func parseWeekdayWindows(windows []*WeekdayWindow,
+	timezone string) ([]*weekdayWindow, error) {
+
+	parsed := make([]*weekdayWindow, len(windows))
+	for i, w := range windows {
+		window, err := session.ParseTimeWindow(
+			w.Start, w.End, timezone,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		weekdays := make(map[time.Weekday]bool, len(w.Weekdays))
+		for _, weekday := range w.Weekdays {
+			if weekday < time.Sunday || weekday > time.Saturday {
+				return nil, fmt.Errorf("invalid weekday %d",
+					weekday)
+			}
+
+			weekdays[weekday] = true
+		}
+
+		parsed[i] = &weekdayWindow{
+			weekdays: weekdays,
+			window:   window,
+		}
+	}
+
+	return parsed, nil
+}
+
+// contains returns true if the given time lies within the window.
+func (w *weekdayWindow) contains(t time.Time) bool {
+	if !w.window.Contains(t) {
+		return false
+	}
+
+	if len(w.weekdays) == 0 {
+		return true
+	}
+
+	// A window that wraps around midnight opened on the previous day if
+	// the given time is before its end.
+	t = t.In(w.window.Location)
+	weekday := t.Weekday()
+	offset := time.Duration(t.Hour())*time.Hour +
+		time.Duration(t.Minute())*time.Minute +
+		time.Duration(t.Second())*time.Second
+	if w.window.End < w.window.Start && offset < w.window.End {
+		weekday = (weekday + 6) % 7
+	}
+
+	return w.weekdays[weekday]
+}
+
+// WeekdayWindow is a window of time that opens at the same time of day on
+// certain weekdays.
+type WeekdayWindow struct {
+	// Weekdays holds the weekdays on which the window opens. If it is
+	// empty, the window opens on every day.
+	Weekdays []time.Weekday `json:"weekdays"`
+
+	// Start is the time of day at which the window opens, in the HH:MM
+	// format.
+	Start string `json:"start"`
+
+	// End is the time of day at which the window closes, in the HH:MM
+	// format. If it is before the start, the window closes on the next
+	// day.
+	End string `json:"end"`
+}
+
+// TimeWindow represents the time window rule values.
+type TimeWindow struct {
+	// ReadWindows are the windows within which read-only calls are
+	// allowed. If it is empty, read-only calls are allowed at any time.
+	ReadWindows []*WeekdayWindow `json:"read_windows"`
+
+	// WriteWindows are the windows within which write calls are allowed.
+	// If it is empty, write calls are allowed at any time.
+	WriteWindows []*WeekdayWindow `json:"write_windows"`
+
+	// Timezone is the IANA name of the timezone the windows refer to. If
+	// it is empty, UTC is used.
+	Timezone string `json:"timezone"`
+}
+
+// VerifySane checks that the value of the values is ok given the min and max
+// allowed values. This is a noop for the TimeWindow rule.
+//
+// NOTE: this is part of the Values interface.
+func (t *TimeWindow) VerifySane(_, _ Values) error {
+	return nil
+}
+
+// RuleName returns the name of the rule that these values are to be used with.
+//
+// NOTE: this is part of the Values interface.
+func (t *TimeWindow) RuleName() string {
+	return TimeWindowName
+}
+
+// ToProto converts the rule Values to the litrpc counterpart.
+//
+// NOTE: this is part of the Values interface.
+func (t *TimeWindow) ToProto() *litrpc.RuleValue {
+	toProto := func(windows []*WeekdayWindow) []*litrpc.WeekdayWindow {
+		protoWindows := make([]*litrpc.WeekdayWindow, len(windows))
+		for i, w := range windows {
+			weekdays := make([]uint32, len(w.Weekdays))
+			for j, weekday := range w.Weekdays {
+				weekdays[j] = uint32(weekday)
+			}
+
+			protoWindows[i] = &litrpc.WeekdayWindow{
+				Weekdays: weekdays,
+				Start:    w.Start,
+				End:      w.End,
+			}
+		}
+
+		return protoWindows
+	}
+
+	return &litrpc.RuleValue{
+		Value: &litrpc.RuleValue_TimeWindow{
+			TimeWindow: &litrpc.TimeWindow{
+				ReadWindows:  toProto(t.ReadWindows),
+				WriteWindows: toProto(t.WriteWindows),
+				Timezone:     t.Timezone,
+			},
+		},
+	}
+}
+
+// PseudoToReal attempts to convert any appropriate pseudo fields in the rule
+// Values to their corresponding real values. It uses the passed PrivacyMapDB to
+// find the real values. This is a no-op for the TimeWindow rule.
+//
+// NOTE: this is part of the Values interface.
+func (t *TimeWindow) PseudoToReal(_ firewalldb.PrivacyMapDB) (Values,
+	error) {
+
+	return t, nil
+}
+
+// RealToPseudo converts the rule Values to a new one that uses pseudo keys,
+// channel IDs, channel points etc. It returns a map of real to pseudo strings
+// that should be persisted. This is a no-op for the TimeWindow rule.
+//
+// NOTE: this is part of the Values interface.
+func (t *TimeWindow) RealToPseudo() (Values, map[string]string, error) {
+	return t, nil, nil
+}
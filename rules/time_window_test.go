@@ -0,0 +1,250 @@
+package rules
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/lightninglabs/lightning-terminal/litrpc"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"gopkg.in/macaroon-bakery.v2/bakery"
+)
+
+// TestTimeWindowValues tests that the TimeWindowMgr only accepts valid
+// TimeWindow values and that they can be converted back to their proto
+// counterpart.
+func TestTimeWindowValues(t *testing.T) {
+	mgr := &TimeWindowMgr{}
+
+	newValue := func(w *litrpc.TimeWindow) (Values, error) {
+		return mgr.NewValueFromProto(&litrpc.RuleValue{
+			Value: &litrpc.RuleValue_TimeWindow{
+				TimeWindow: w,
+			},
+		})
+	}
+
+	tests := []struct {
+		name      string
+		value     *litrpc.TimeWindow
+		expectErr string
+	}{
+		{
+			name: "valid",
+			value: &litrpc.TimeWindow{
+				ReadWindows: []*litrpc.WeekdayWindow{{
+					Start: "08:00",
+					End:   "18:00",
+				}},
+				WriteWindows: []*litrpc.WeekdayWindow{{
+					Weekdays: []uint32{1, 2, 3, 4, 5},
+					Start:    "22:00",
+					End:      "02:00",
+				}},
+				Timezone: "Europe/Zurich",
+			},
+		},
+		{
+			name:      "no windows",
+			value:     &litrpc.TimeWindow{},
+			expectErr: "time windows cannot be empty",
+		},
+		{
+			name: "invalid time of day",
+			value: &litrpc.TimeWindow{
+				WriteWindows: []*litrpc.WeekdayWindow{{
+					Start: "25:00",
+					End:   "02:00",
+				}},
+			},
+			expectErr: "invalid write window: invalid start",
+		},
+		{
+			name: "invalid weekday",
+			value: &litrpc.TimeWindow{
+				ReadWindows: []*litrpc.WeekdayWindow{{
+					Weekdays: []uint32{7},
+					Start:    "08:00",
+					End:      "18:00",
+				}},
+			},
+			expectErr: "invalid read window: invalid weekday 7",
+		},
+		{
+			name: "invalid timezone",
+			value: &litrpc.TimeWindow{
+				ReadWindows: []*litrpc.WeekdayWindow{{
+					Start: "08:00",
+					End:   "18:00",
+				}},
+				Timezone: "Mars/Olympus_Mons",
+			},
+			expectErr: "invalid timezone",
+		},
+	}
+
+	for _, test := range tests {
+		v, err := newValue(test.value)
+		if test.expectErr != "" {
+			require.ErrorContains(t, err, test.expectErr, test.name)
+			continue
+		}
+
+		require.NoError(t, err, test.name)
+		require.True(
+			t, proto.Equal(test.value, v.ToProto().GetTimeWindow()),
+			test.name,
+		)
+	}
+}
+
+// TestTimeWindow tests that the TimeWindowEnforcer only accepts calls within
+// the time windows for their type of call.
+func TestTimeWindow(t *testing.T) {
+	ctx := context.Background()
+	cfg := &mockTimeWindowCfg{
+		perms: map[string][]bakery.Op{
+			"read-uri":  {{Action: "read"}},
+			"write-uri": {{Action: "write"}},
+		},
+	}
+
+	loc, err := time.LoadLocation("Europe/Zurich")
+	require.NoError(t, err)
+
+	mgr := &TimeWindowMgr{}
+	enf, err := mgr.NewEnforcer(cfg, &TimeWindow{
+		ReadWindows: []*WeekdayWindow{{
+			Start: "08:00",
+			End:   "18:00",
+		}},
+		WriteWindows: []*WeekdayWindow{{
+			Weekdays: []time.Weekday{
+				time.Monday, time.Tuesday, time.Wednesday,
+				time.Thursday, time.Friday,
+			},
+			Start: "22:00",
+			End:   "02:00",
+		}},
+		Timezone: "Europe/Zurich",
+	})
+	require.NoError(t, err)
+
+	twEnf, ok := enf.(*TimeWindowEnforcer)
+	require.True(t, ok)
+
+	// 2023-05-01 is a Monday.
+	tests := []struct {
+		name    string
+		uri     string
+		now     time.Time
+		allowed bool
+	}{
+		{
+			name:    "write on Monday evening",
+			uri:     "write-uri",
+			now:     time.Date(2023, 5, 1, 23, 0, 0, 0, loc),
+			allowed: true,
+		},
+		{
+			name:    "write on Tuesday night",
+			uri:     "write-uri",
+			now:     time.Date(2023, 5, 2, 1, 59, 0, 0, loc),
+			allowed: true,
+		},
+		{
+			name: "write on Tuesday night after the window",
+			uri:  "write-uri",
+			now:  time.Date(2023, 5, 2, 2, 0, 0, 0, loc),
+		},
+		{
+			name:    "write on Saturday night",
+			uri:     "write-uri",
+			now:     time.Date(2023, 5, 6, 1, 0, 0, 0, loc),
+			allowed: true,
+		},
+		{
+			name: "write on Sunday night",
+			uri:  "write-uri",
+			now:  time.Date(2023, 5, 7, 1, 0, 0, 0, loc),
+		},
+		{
+			name: "write on Saturday evening",
+			uri:  "write-uri",
+			now:  time.Date(2023, 5, 6, 23, 0, 0, 0, loc),
+		},
+		{
+			name: "write during the day",
+			uri:  "write-uri",
+			now:  time.Date(2023, 5, 3, 12, 0, 0, 0, loc),
+		},
+		{
+			name:    "write in a different timezone",
+			uri:     "write-uri",
+			now:     time.Date(2023, 5, 1, 21, 0, 0, 0, time.UTC),
+			allowed: true,
+		},
+		{
+			name:    "read during the day",
+			uri:     "read-uri",
+			now:     time.Date(2023, 5, 7, 12, 0, 0, 0, loc),
+			allowed: true,
+		},
+		{
+			name: "read in the evening",
+			uri:  "read-uri",
+			now:  time.Date(2023, 5, 1, 23, 0, 0, 0, loc),
+		},
+		{
+			name: "unknown uri is treated as write",
+			uri:  "unknown-uri",
+			now:  time.Date(2023, 5, 7, 12, 0, 0, 0, loc),
+		},
+	}
+
+	for _, test := range tests {
+		twEnf.now = func() time.Time {
+			return test.now
+		}
+
+		_, err := enf.HandleRequest(ctx, test.uri, nil)
+		if test.allowed {
+			require.NoError(t, err, test.name)
+			continue
+		}
+
+		require.ErrorContains(t, err, "only allowed within the "+
+			"configured time windows", test.name)
+	}
+
+	// Read-only calls are allowed at any time if there are no read
+	// windows.
+	enf, err = mgr.NewEnforcer(cfg, &TimeWindow{
+		WriteWindows: []*WeekdayWindow{{
+			Start: "22:00",
+			End:   "02:00",
+		}},
+	})
+	require.NoError(t, err)
+
+	_, err = enf.HandleRequest(ctx, "read-uri", nil)
+	require.NoError(t, err)
+}
+
+// mockTimeWindowCfg is used to mock the config backend given to the
+// TimeWindowEnforcer during testing.
+type mockTimeWindowCfg struct {
+	Config
+
+	perms map[string][]bakery.Op
+}
+
+func (m *mockTimeWindowCfg) GetMethodPerms() func(string) ([]bakery.Op,
+	bool) {
+
+	return func(s string) ([]bakery.Op, bool) {
+		ops, ok := m.perms[s]
+		return ops, ok
+	}
+}
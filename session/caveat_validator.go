@@ -0,0 +1,354 @@
+package session
+
+import (
+	"context"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"net"
+	"sync"
+	"time"
+
+	mid "github.com/lightninglabs/lightning-terminal/rpcmiddleware"
+	"github.com/lightningnetwork/lnd/clock"
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"google.golang.org/grpc/peer"
+	"gopkg.in/macaroon.v2"
+)
+
+const (
+	// restrictionMiddlewareName is the name that is used for the caveat
+	// validator when registering it to lnd as an RPC middleware.
+	restrictionMiddlewareName = "lit-restrictions"
+
+	// forwardedRequestTimeout is the time after which a request that was
+	// forwarded to lnd is no longer expected to be intercepted.
+	forwardedRequestTimeout = time.Minute
+)
+
+var (
+	// ErrMaxCallsReached is returned if a macaroon is used for more calls
+	// than one of its max-call-count caveats allows.
+	ErrMaxCallsReached = errors.New("maximum number of calls reached")
+
+	// trustedNetworks are the networks of the connections for which the IP
+	// restrictions of a macaroon are not checked. Requests made through an
+	// LNC session arrive over the mailbox and are authenticated by the
+	// session itself. Requests on LiT's internal connection to lnd were
+	// already checked when LiT received them.
+	trustedNetworks = map[string]bool{
+		"bufconn": true,
+		"mailbox": true,
+		"noise":   true,
+	}
+)
+
+// CallCounter counts the calls made with macaroons that are restricted by
+// max-call-count caveats.
+type CallCounter interface {
+	// CountCall increments the call counters with the given IDs. If any of
+	// them has already reached the maximum number of calls that is given
+	// for it, ErrMaxCallsReached is returned and none of the counters is
+	// changed.
+	CountCall(limits map[[32]byte]uint64) error
+}
+
+// CaveatValidator checks the restriction caveats of super macaroons.
+//
+// The time-of-day and IP restrictions are checked where a request is received,
+// which is either LiT's proxy or one of the gRPC servers LiT's services are
+// registered to. Each call is counted exactly once: calls to lnd and to remote
+// daemons are counted by the proxy, all other calls by LiT's macaroon
+// validation. Because lnd can also be reached without going through LiT, the
+// proxy announces each lnd request it checked, and the RPC middleware rejects
+// lnd requests with IP or call count restrictions that weren't announced.
+type CaveatValidator struct {
+	counter CallCounter
+	clock   clock.Clock
+
+	// forwarded holds the times at which the proxy forwarded requests to
+	// lnd that weren't intercepted yet. They are indexed by the hash of
+	// their raw macaroon and URI.
+	forwarded map[[32]byte][]time.Time
+	mu        sync.Mutex
+}
+
+// NewCaveatValidator creates a new caveat validator that uses the given call
+// counter.
+func NewCaveatValidator(counter CallCounter,
+	clock clock.Clock) *CaveatValidator {
+
+	return &CaveatValidator{
+		counter:   counter,
+		clock:     clock,
+		forwarded: make(map[[32]byte][]time.Time),
+	}
+}
+
+// CheckRestrictions makes sure the request with the given context satisfies
+// the time-of-day and IP restrictions of the given macaroon. The IP
+// restrictions only apply to direct gRPC connections.
+func (v *CaveatValidator) CheckRestrictions(ctx context.Context,
+	mac *macaroon.Macaroon) error {
+
+	restrictions, err := RestrictionsFromMacaroon(mac)
+	if err != nil {
+		return err
+	}
+
+	if err := v.checkTime(restrictions); err != nil {
+		return err
+	}
+
+	if len(restrictions.AllowedIPs) == 0 {
+		return nil
+	}
+
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return errors.New("unknown source of request")
+	}
+
+	if trustedNetworks[p.Addr.Network()] {
+		return nil
+	}
+
+	// Anything other than a TCP connection can't be matched against the
+	// allowed IP ranges, so we fail closed.
+	tcpAddr, ok := p.Addr.(*net.TCPAddr)
+	if !ok {
+		return fmt.Errorf("source %v of request is not an IP address",
+			p.Addr)
+	}
+
+	for _, ipNets := range restrictions.AllowedIPs {
+		if !containsIP(ipNets, tcpAddr.IP) {
+			return fmt.Errorf("source IP %v is not allowed",
+				tcpAddr.IP)
+		}
+	}
+
+	return nil
+}
+
+// CountCall counts a call made with the given macaroon against its
+// max-call-count restrictions. The counters are bound to the ID of the
+// macaroon, so they are shared by all macaroons derived from it.
+func (v *CaveatValidator) CountCall(mac *macaroon.Macaroon) error {
+	restrictions, err := RestrictionsFromMacaroon(mac)
+	if err != nil {
+		return err
+	}
+
+	if len(restrictions.callLimits) == 0 {
+		return nil
+	}
+
+	limits := make(map[[32]byte]uint64, len(restrictions.callLimits))
+	for _, limit := range restrictions.callLimits {
+		limits[hashOf(mac.Id(), limit.caveatID)] = limit.maxCalls
+	}
+
+	return v.counter.CountCall(limits)
+}
+
+// ExpectForwarded records that a request with the given raw macaroon for the
+// given lnd URI was checked and is forwarded to lnd.
+func (v *CaveatValidator) ExpectForwarded(rawMacaroon []byte, uri string) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	v.pruneForwarded()
+
+	key := hashOf(rawMacaroon, []byte(uri))
+	v.forwarded[key] = append(v.forwarded[key], v.clock.Now())
+}
+
+// consumeForwarded returns true if a request with the given raw macaroon for
+// the given lnd URI was forwarded and removes it from the expected requests.
+func (v *CaveatValidator) consumeForwarded(rawMacaroon []byte,
+	uri string) bool {
+
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	v.pruneForwarded()
+
+	key := hashOf(rawMacaroon, []byte(uri))
+	times := v.forwarded[key]
+	if len(times) == 0 {
+		return false
+	}
+
+	if len(times) == 1 {
+		delete(v.forwarded, key)
+	} else {
+		v.forwarded[key] = times[1:]
+	}
+
+	return true
+}
+
+// pruneForwarded removes the forwarded requests that weren't intercepted in
+// time.
+//
+// NOTE: The mutex MUST be held when calling this method.
+func (v *CaveatValidator) pruneForwarded() {
+	cutoff := v.clock.Now().Add(-forwardedRequestTimeout)
+	for key, times := range v.forwarded {
+		for len(times) > 0 && times[0].Before(cutoff) {
+			times = times[1:]
+		}
+
+		if len(times) == 0 {
+			delete(v.forwarded, key)
+		} else {
+			v.forwarded[key] = times
+		}
+	}
+}
+
+// checkTime makes sure the current time lies within one of the windows of
+// each time-of-day restriction.
+func (v *CaveatValidator) checkTime(restrictions *Restrictions) error {
+	now := v.clock.Now()
+	for _, windows := range restrictions.TimeWindows {
+		allowed := false
+		for _, window := range windows {
+			if window.Contains(now) {
+				allowed = true
+				break
+			}
+		}
+
+		if !allowed {
+			return fmt.Errorf("macaroon can't be used at %v",
+				now.Format(time.RFC3339))
+		}
+	}
+
+	return nil
+}
+
+// containsIP returns true if the given IP address lies within any of the given
+// IP ranges.
+func containsIP(ipNets []*net.IPNet, ip net.IP) bool {
+	for _, ipNet := range ipNets {
+		if ipNet.Contains(ip) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// hashOf returns the SHA256 hash of the concatenation of the given byte
+// slices.
+func hashOf(parts ...[]byte) [32]byte {
+	h := sha256.New()
+	for _, part := range parts {
+		_, _ = h.Write(part)
+	}
+
+	var hash [32]byte
+	copy(hash[:], h.Sum(nil))
+
+	return hash
+}
+
+// RestrictionInterceptor is the RPC middleware that handles the restriction
+// caveats of the macaroons used for lnd requests. Registering it makes lnd
+// accept macaroons with restriction caveats.
+type RestrictionInterceptor struct {
+	validator *CaveatValidator
+	isLndURI  func(uri string) bool
+}
+
+// A compile-time check to ensure that RestrictionInterceptor implements
+// mid.RequestInterceptor.
+var _ mid.RequestInterceptor = (*RestrictionInterceptor)(nil)
+
+// NewRestrictionInterceptor creates a new RPC middleware for the restriction
+// caveats that uses the given validator. The isLndURI function must return
+// true for the URIs of lnd's own RPCs.
+func NewRestrictionInterceptor(validator *CaveatValidator,
+	isLndURI func(uri string) bool) *RestrictionInterceptor {
+
+	return &RestrictionInterceptor{
+		validator: validator,
+		isLndURI:  isLndURI,
+	}
+}
+
+// Name returns the name of the interceptor.
+func (r *RestrictionInterceptor) Name() string {
+	return restrictionMiddlewareName
+}
+
+// ReadOnly returns true if this interceptor should be registered in read-only
+// mode. In read-only mode no custom caveat name can be specified.
+func (r *RestrictionInterceptor) ReadOnly() bool {
+	return false
+}
+
+// CustomCaveatName returns the name of the custom caveat that is expected to be
+// handled by this interceptor. Cannot be specified in read-only mode.
+func (r *RestrictionInterceptor) CustomCaveatName() string {
+	return CondRestriction
+}
+
+// Intercept processes an RPC middleware interception request and returns the
+// interception result which either accepts or rejects the intercepted message.
+func (r *RestrictionInterceptor) Intercept(_ context.Context,
+	req *lnrpc.RPCMiddlewareRequest) (*lnrpc.RPCMiddlewareResponse, error) {
+
+	// Each call is only checked once, either when a stream is established
+	// or when the request of a unary call arrives.
+	var uri string
+	switch t := req.InterceptType.(type) {
+	case *lnrpc.RPCMiddlewareRequest_StreamAuth:
+		uri = t.StreamAuth.MethodFullUri
+
+	case *lnrpc.RPCMiddlewareRequest_Request:
+		if t.Request.StreamRpc {
+			return mid.RPCOk(req)
+		}
+		uri = t.Request.MethodFullUri
+
+	default:
+		return mid.RPCOk(req)
+	}
+
+	mac := &macaroon.Macaroon{}
+	if err := mac.UnmarshalBinary(req.RawMacaroon); err != nil {
+		return mid.RPCErrString(req, "error parsing macaroon: %v", err)
+	}
+
+	restrictions, err := RestrictionsFromMacaroon(mac)
+	if err != nil {
+		return mid.RPCErr(req, err)
+	}
+
+	if err := r.validator.checkTime(restrictions); err != nil {
+		return mid.RPCErr(req, err)
+	}
+
+	// Requests for LiT and the integrated daemons are fully checked by
+	// LiT's macaroon validation.
+	if !r.isLndURI(uri) {
+		return mid.RPCOk(req)
+	}
+
+	if len(restrictions.AllowedIPs) == 0 &&
+		len(restrictions.callLimits) == 0 {
+
+		return mid.RPCOk(req)
+	}
+
+	if !r.validator.consumeForwarded(req.RawMacaroon, uri) {
+		return mid.RPCErrString(req, "macaroon with IP or call count "+
+			"restrictions can only be used through LiT")
+	}
+
+	return mid.RPCOk(req)
+}
@@ -0,0 +1,343 @@
+package session
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/lightningnetwork/lnd/macaroons"
+	"gopkg.in/macaroon-bakery.v2/bakery/checkers"
+	"gopkg.in/macaroon.v2"
+)
+
+const (
+	// CondRestriction is the name of the custom caveat that holds an
+	// additional restriction of a super macaroon that is enforced by LiT.
+	// The condition of the caveat starts with the kind of the restriction,
+	// followed by its value, for example "ip=10.0.0.0/8".
+	CondRestriction = "lit-cond"
+
+	// restrictionTime is the kind of restriction that only allows the
+	// macaroon to be used within one of a list of time-of-day windows.
+	restrictionTime = "time"
+
+	// restrictionIP is the kind of restriction that only allows the
+	// macaroon to be used from one of a list of source IP ranges.
+	restrictionIP = "ip"
+
+	// restrictionMaxCalls is the kind of restriction that limits the total
+	// number of calls that can be made with the macaroon.
+	restrictionMaxCalls = "maxcalls"
+
+	// timeOfDayLayout is the layout of the start and end of a time-of-day
+	// window.
+	timeOfDayLayout = "15:04"
+)
+
+var (
+	// restrictionPrefix is the prefix of the IDs of all caveats that hold
+	// a restriction.
+	restrictionPrefix = checkers.Condition(
+		macaroons.CondLndCustom, CondRestriction+" ",
+	)
+)
+
+// TimeWindow is a daily recurring window of time in a certain location.
+type TimeWindow struct {
+	// Start is the time of day at which the window opens, as an offset
+	// from midnight.
+	Start time.Duration
+
+	// End is the time of day at which the window closes, as an offset from
+	// midnight. If it is before the start, the window wraps around
+	// midnight.
+	End time.Duration
+
+	// Location is the location the times of day refer to.
+	Location *time.Location
+}
+
+// ParseTimeWindow parses a time-of-day window from its start and end in the
+// HH:MM format and the IANA name of its timezone. If the timezone is empty, UTC
+// is used.
+func ParseTimeWindow(start, end, timezone string) (TimeWindow, error) {
+	var (
+		window TimeWindow
+		err    error
+	)
+	window.Start, err = parseTimeOfDay(start)
+	if err != nil {
+		return window, fmt.Errorf("invalid start: %w", err)
+	}
+
+	window.End, err = parseTimeOfDay(end)
+	if err != nil {
+		return window, fmt.Errorf("invalid end: %w", err)
+	}
+
+	if window.Start == window.End {
+		return window, errors.New("start and end must differ")
+	}
+
+	window.Location, err = time.LoadLocation(timezone)
+	if err != nil {
+		return window, fmt.Errorf("invalid timezone: %w", err)
+	}
+
+	return window, nil
+}
+
+// Contains returns true if the given time lies within the window.
+func (w TimeWindow) Contains(t time.Time) bool {
+	t = t.In(w.Location)
+	offset := time.Duration(t.Hour())*time.Hour +
+		time.Duration(t.Minute())*time.Minute +
+		time.Duration(t.Second())*time.Second
+
+	if w.Start < w.End {
+		return offset >= w.Start && offset < w.End
+	}
+
+	return offset >= w.Start || offset < w.End
+}
+
+// String returns the encoding of the window that is used in a caveat.
+func (w TimeWindow) String() string {
+	return fmt.Sprintf("%s-%s@%s", formatTimeOfDay(w.Start),
+		formatTimeOfDay(w.End), w.Location)
+}
+
+// parseTimeOfDay parses a time of day in the HH:MM format into its offset from
+// midnight.
+func parseTimeOfDay(s string) (time.Duration, error) {
+	t, err := time.Parse(timeOfDayLayout, s)
+	if err != nil {
+		return 0, err
+	}
+
+	return time.Duration(t.Hour())*time.Hour +
+		time.Duration(t.Minute())*time.Minute, nil
+}
+
+// formatTimeOfDay formats an offset from midnight in the HH:MM format.
+func formatTimeOfDay(d time.Duration) string {
+	return fmt.Sprintf("%02d:%02d", int(d.Hours()), int(d.Minutes())%60)
+}
+
+// ParseIPNet parses an IP address or a CIDR range. A single IP address is
+// turned into a range that only contains that address.
+func ParseIPNet(s string) (*net.IPNet, error) {
+	if strings.Contains(s, "/") {
+		_, ipNet, err := net.ParseCIDR(s)
+		return ipNet, err
+	}
+
+	ip := net.ParseIP(s)
+	if ip == nil {
+		return nil, fmt.Errorf("invalid IP address %s", s)
+	}
+
+	bits := 8 * net.IPv6len
+	if ip4 := ip.To4(); ip4 != nil {
+		ip = ip4
+		bits = 8 * net.IPv4len
+	}
+
+	return &net.IPNet{IP: ip, Mask: net.CIDRMask(bits, bits)}, nil
+}
+
+// CaveatBuilder builds the caveats that restrict how a super macaroon can be
+// used. Each method adds one caveat, all of which must be satisfied for a
+// request to be accepted. The first invalid argument is returned as an error
+// by Caveats.
+type CaveatBuilder struct {
+	caveats []macaroon.Caveat
+	err     error
+}
+
+// NewCaveatBuilder creates a new empty caveat builder.
+func NewCaveatBuilder() *CaveatBuilder {
+	return &CaveatBuilder{}
+}
+
+// TimeWindows adds a caveat that only allows the macaroon to be used within
+// one of the given time-of-day windows.
+func (b *CaveatBuilder) TimeWindows(windows ...TimeWindow) *CaveatBuilder {
+	if len(windows) == 0 {
+		return b.fail(errors.New("no time windows given"))
+	}
+
+	values := make([]string, len(windows))
+	for idx, window := range windows {
+		if window.Location == nil {
+			return b.fail(errors.New("time window has no location"))
+		}
+
+		values[idx] = window.String()
+	}
+
+	return b.add(restrictionTime, strings.Join(values, ","))
+}
+
+// AllowedIPs adds a caveat that only allows the macaroon to be used for direct
+// gRPC connections from one of the given IP ranges. Requests made through an
+// LNC session are not restricted by it.
+func (b *CaveatBuilder) AllowedIPs(ipNets ...*net.IPNet) *CaveatBuilder {
+	if len(ipNets) == 0 {
+		return b.fail(errors.New("no IP ranges given"))
+	}
+
+	values := make([]string, len(ipNets))
+	for idx, ipNet := range ipNets {
+		values[idx] = ipNet.String()
+	}
+
+	return b.add(restrictionIP, strings.Join(values, ","))
+}
+
+// MaxCalls adds a caveat that limits the total number of calls that can be
+// made with the macaroon.
+func (b *CaveatBuilder) MaxCalls(maxCalls uint64) *CaveatBuilder {
+	if maxCalls == 0 {
+		return b.fail(errors.New("max calls must be positive"))
+	}
+
+	return b.add(restrictionMaxCalls, strconv.FormatUint(maxCalls, 10))
+}
+
+// Caveats returns the caveats that were built or the first error that was
+// encountered.
+func (b *CaveatBuilder) Caveats() ([]macaroon.Caveat, error) {
+	if b.err != nil {
+		return nil, b.err
+	}
+
+	return b.caveats, nil
+}
+
+// add adds a caveat that holds a restriction of the given kind.
+func (b *CaveatBuilder) add(kind, value string) *CaveatBuilder {
+	b.caveats = append(b.caveats, macaroon.Caveat{
+		Id: []byte(fmt.Sprintf("%s%s=%s", restrictionPrefix, kind,
+			value)),
+	})
+
+	return b
+}
+
+// fail records the given error if no other error was recorded before.
+func (b *CaveatBuilder) fail(err error) *CaveatBuilder {
+	if b.err == nil {
+		b.err = err
+	}
+
+	return b
+}
+
+// callLimit is the limit of a max-call-count caveat.
+type callLimit struct {
+	// caveatID is the ID of the caveat that holds the limit.
+	caveatID []byte
+
+	// maxCalls is the maximum number of calls that can be made.
+	maxCalls uint64
+}
+
+// Restrictions holds all restrictions of a super macaroon. Each entry of the
+// lists stems from one caveat and all of them must be satisfied.
+type Restrictions struct {
+	// TimeWindows holds the time-of-day windows of each time caveat.
+	TimeWindows [][]TimeWindow
+
+	// AllowedIPs holds the allowed IP ranges of each IP caveat.
+	AllowedIPs [][]*net.IPNet
+
+	// callLimits holds the limits of each max-call-count caveat.
+	callLimits []callLimit
+}
+
+// RestrictionsFromMacaroon parses all restrictions of the given macaroon.
+func RestrictionsFromMacaroon(mac *macaroon.Macaroon) (*Restrictions, error) {
+	var restrictions Restrictions
+	for _, caveat := range mac.Caveats() {
+		cond := string(caveat.Id)
+		if !strings.HasPrefix(cond, restrictionPrefix) {
+			continue
+		}
+
+		kind, value, ok := strings.Cut(
+			strings.TrimPrefix(cond, restrictionPrefix), "=",
+		)
+		if !ok {
+			return nil, fmt.Errorf("invalid restriction caveat %s",
+				cond)
+		}
+
+		values := strings.Split(value, ",")
+		switch kind {
+		case restrictionTime:
+			windows := make([]TimeWindow, len(values))
+			for idx, value := range values {
+				window, err := parseTimeWindow(value)
+				if err != nil {
+					return nil, fmt.Errorf("invalid time "+
+						"window %s: %w", value, err)
+				}
+				windows[idx] = window
+			}
+			restrictions.TimeWindows = append(
+				restrictions.TimeWindows, windows,
+			)
+
+		case restrictionIP:
+			ipNets := make([]*net.IPNet, len(values))
+			for idx, value := range values {
+				ipNet, err := ParseIPNet(value)
+				if err != nil {
+					return nil, err
+				}
+				ipNets[idx] = ipNet
+			}
+			restrictions.AllowedIPs = append(
+				restrictions.AllowedIPs, ipNets,
+			)
+
+		case restrictionMaxCalls:
+			maxCalls, err := strconv.ParseUint(value, 10, 64)
+			if err != nil {
+				return nil, fmt.Errorf("invalid max calls: %w",
+					err)
+			}
+			restrictions.callLimits = append(
+				restrictions.callLimits, callLimit{
+					caveatID: caveat.Id,
+					maxCalls: maxCalls,
+				},
+			)
+
+		default:
+			return nil, fmt.Errorf("unknown restriction %s", kind)
+		}
+	}
+
+	return &restrictions, nil
+}
+
+// parseTimeWindow parses a time-of-day window from the encoding that is used
+// in a caveat.
+func parseTimeWindow(s string) (TimeWindow, error) {
+	times, timezone, ok := strings.Cut(s, "@")
+	if !ok {
+		return TimeWindow{}, errors.New("missing timezone")
+	}
+
+	start, end, ok := strings.Cut(times, "-")
+	if !ok {
+		return TimeWindow{}, errors.New("missing end")
+	}
+
+	return ParseTimeWindow(start, end, timezone)
+}
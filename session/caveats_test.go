@@ -0,0 +1,262 @@
+package session
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/lightningnetwork/lnd/clock"
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/peer"
+	"gopkg.in/macaroon.v2"
+)
+
+// TestTimeWindow tests that time-of-day windows contain the correct times,
+// including windows that wrap around midnight and windows in other timezones.
+func TestTimeWindow(t *testing.T) {
+	t.Parallel()
+
+	day, err := ParseTimeWindow("09:00", "17:30", "")
+	require.NoError(t, err)
+	require.Equal(t, "09:00-17:30@UTC", day.String())
+
+	night, err := ParseTimeWindow("22:00", "06:00", "Asia/Tokyo")
+	require.NoError(t, err)
+
+	date := func(hour, min int) time.Time {
+		return time.Date(2023, 5, 1, hour, min, 0, 0, time.UTC)
+	}
+	require.False(t, day.Contains(date(8, 59)))
+	require.True(t, day.Contains(date(9, 0)))
+	require.True(t, day.Contains(date(17, 29)))
+	require.False(t, day.Contains(date(17, 30)))
+
+	// Tokyo is 9 hours ahead of UTC, so the night window spans 13:00 to
+	// 21:00 UTC.
+	require.False(t, night.Contains(date(12, 59)))
+	require.True(t, night.Contains(date(13, 0)))
+	require.True(t, night.Contains(date(20, 59)))
+	require.False(t, night.Contains(date(21, 0)))
+
+	_, err = ParseTimeWindow("09:00", "09:00", "")
+	require.Error(t, err)
+	_, err = ParseTimeWindow("9am", "17:00", "")
+	require.Error(t, err)
+	_, err = ParseTimeWindow("09:00", "17:00", "Mars/Olympus")
+	require.Error(t, err)
+}
+
+// TestCaveatBuilder tests that the restrictions added by the caveat builder
+// are parsed from a macaroon again and that invalid arguments are rejected.
+func TestCaveatBuilder(t *testing.T) {
+	t.Parallel()
+
+	window, err := ParseTimeWindow("09:00", "17:00", "Europe/Zurich")
+	require.NoError(t, err)
+	ipNet, err := ParseIPNet("10.0.0.0/8")
+	require.NoError(t, err)
+	ip, err := ParseIPNet("2001:db8::1")
+	require.NoError(t, err)
+
+	caveats, err := NewCaveatBuilder().
+		TimeWindows(window).
+		AllowedIPs(ipNet, ip).
+		MaxCalls(10).
+		Caveats()
+	require.NoError(t, err)
+	require.Len(t, caveats, 3)
+	require.Equal(
+		t, "lnd-custom lit-cond ip=10.0.0.0/8,2001:db8::1/128",
+		string(caveats[1].Id),
+	)
+
+	mac := newTestMacaroon(t, caveats...)
+	restrictions, err := RestrictionsFromMacaroon(mac)
+	require.NoError(t, err)
+	require.Len(t, restrictions.TimeWindows, 1)
+	require.Equal(
+		t, window.String(), restrictions.TimeWindows[0][0].String(),
+	)
+	require.Equal(
+		t, [][]*net.IPNet{{ipNet, ip}}, restrictions.AllowedIPs,
+	)
+	require.Len(t, restrictions.callLimits, 1)
+	require.EqualValues(t, 10, restrictions.callLimits[0].maxCalls)
+
+	_, err = NewCaveatBuilder().MaxCalls(0).TimeWindows().Caveats()
+	require.ErrorContains(t, err, "max calls")
+
+	_, err = NewCaveatBuilder().AllowedIPs().Caveats()
+	require.Error(t, err)
+
+	_, err = ParseIPNet("10.0.0.256")
+	require.Error(t, err)
+}
+
+// TestCheckRestrictions tests that the time-of-day and IP restrictions of a
+// macaroon are checked against the current time and the source of a request.
+func TestCheckRestrictions(t *testing.T) {
+	t.Parallel()
+
+	window, err := ParseTimeWindow("09:00", "17:00", "")
+	require.NoError(t, err)
+	ipNet, err := ParseIPNet("192.168.0.0/16")
+	require.NoError(t, err)
+
+	caveats, err := NewCaveatBuilder().
+		TimeWindows(window).
+		AllowedIPs(ipNet).
+		Caveats()
+	require.NoError(t, err)
+	mac := newTestMacaroon(t, caveats...)
+
+	testClock := clock.NewTestClock(
+		time.Date(2023, 5, 1, 12, 0, 0, 0, time.UTC),
+	)
+	validator := NewCaveatValidator(nil, testClock)
+
+	peerCtx := func(addr net.Addr) context.Context {
+		return peer.NewContext(
+			context.Background(), &peer.Peer{Addr: addr},
+		)
+	}
+	allowed := peerCtx(&net.TCPAddr{IP: net.ParseIP("192.168.1.2")})
+	denied := peerCtx(&net.TCPAddr{IP: net.ParseIP("10.0.0.1")})
+	unix := peerCtx(&net.UnixAddr{Name: "lnd.sock", Net: "unix"})
+	internal := peerCtx(testAddr("bufconn"))
+	mailbox := peerCtx(testAddr("mailbox"))
+
+	require.NoError(t, validator.CheckRestrictions(allowed, mac))
+	require.NoError(t, validator.CheckRestrictions(internal, mac))
+	require.NoError(t, validator.CheckRestrictions(mailbox, mac))
+	require.ErrorContains(
+		t, validator.CheckRestrictions(denied, mac), "not allowed",
+	)
+	require.Error(t, validator.CheckRestrictions(unix, mac))
+	require.Error(t, validator.CheckRestrictions(context.Background(), mac))
+
+	// Outside the time window, no request is accepted.
+	testClock.SetTime(time.Date(2023, 5, 1, 18, 0, 0, 0, time.UTC))
+	require.ErrorContains(
+		t, validator.CheckRestrictions(allowed, mac), "can't be used",
+	)
+}
+
+// TestCountCall tests that the calls made with a macaroon are counted in the
+// DB and rejected once the limit of any of its caveats is reached.
+func TestCountCall(t *testing.T) {
+	t.Parallel()
+
+	db, err := NewDB(t.TempDir(), DBFilename)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_ = db.Close()
+	})
+
+	validator := NewCaveatValidator(db, clock.NewDefaultClock())
+
+	caveats, err := NewCaveatBuilder().MaxCalls(2).Caveats()
+	require.NoError(t, err)
+	mac := newTestMacaroon(t, caveats...)
+
+	require.NoError(t, validator.CountCall(mac))
+
+	// Adding a stricter limit to a derived macaroon also counts the calls
+	// against the original limit.
+	derived := mac.Clone()
+	stricter, err := NewCaveatBuilder().MaxCalls(5).Caveats()
+	require.NoError(t, err)
+	require.NoError(t, derived.AddFirstPartyCaveat(stricter[0].Id))
+
+	require.NoError(t, validator.CountCall(derived))
+	require.ErrorIs(t, validator.CountCall(derived), ErrMaxCallsReached)
+	require.ErrorIs(t, validator.CountCall(mac), ErrMaxCallsReached)
+
+	// A macaroon without limits isn't counted.
+	require.NoError(t, validator.CountCall(newTestMacaroon(t)))
+}
+
+// TestRestrictionInterceptor tests that the RPC middleware only accepts lnd
+// requests with IP or call count restrictions that were forwarded by LiT.
+func TestRestrictionInterceptor(t *testing.T) {
+	t.Parallel()
+
+	const (
+		lndURI = "/lnrpc.Lightning/GetInfo"
+		litURI = "/litrpc.Sessions/ListSessions"
+	)
+
+	testClock := clock.NewTestClock(time.Now())
+	validator := NewCaveatValidator(nil, testClock)
+	interceptor := NewRestrictionInterceptor(
+		validator, func(uri string) bool {
+			return uri == lndURI
+		},
+	)
+
+	caveats, err := NewCaveatBuilder().MaxCalls(1).Caveats()
+	require.NoError(t, err)
+	rawMac, err := newTestMacaroon(t, caveats...).MarshalBinary()
+	require.NoError(t, err)
+
+	intercept := func(uri string) string {
+		req := &lnrpc.RPCMiddlewareRequest{
+			RawMacaroon: rawMac,
+			InterceptType: &lnrpc.RPCMiddlewareRequest_Request{
+				Request: &lnrpc.RPCMessage{
+					MethodFullUri: uri,
+				},
+			},
+		}
+		resp, err := interceptor.Intercept(context.Background(), req)
+		require.NoError(t, err)
+
+		return resp.GetFeedback().Error
+	}
+
+	// Requests for LiT are checked by LiT's own macaroon validation.
+	require.Empty(t, intercept(litURI))
+
+	// An lnd request is only accepted once for each time it was
+	// forwarded.
+	require.NotEmpty(t, intercept(lndURI))
+	validator.ExpectForwarded(rawMac, lndURI)
+	require.Empty(t, intercept(lndURI))
+	require.NotEmpty(t, intercept(lndURI))
+
+	// Forwarded requests that weren't intercepted in time expire.
+	validator.ExpectForwarded(rawMac, lndURI)
+	testClock.SetTime(testClock.Now().Add(2 * forwardedRequestTimeout))
+	require.NotEmpty(t, intercept(lndURI))
+}
+
+// testAddr is a net.Addr with the given network.
+type testAddr string
+
+// Network returns the network of the address.
+func (a testAddr) Network() string {
+	return string(a)
+}
+
+// String returns the address as a string.
+func (a testAddr) String() string {
+	return string(a)
+}
+
+// newTestMacaroon creates a new macaroon with the given caveats.
+func newTestMacaroon(t *testing.T,
+	caveats ...macaroon.Caveat) *macaroon.Macaroon {
+
+	mac, err := macaroon.New(
+		[]byte("root-key"), []byte("id"), "lnd", macaroon.LatestVersion,
+	)
+	require.NoError(t, err)
+
+	for _, caveat := range caveats {
+		require.NoError(t, mac.AddFirstPartyCaveat(caveat.Id))
+	}
+
+	return mac
+}
@@ -0,0 +1,25 @@
+package session
+
+import (
+	"fmt"
+	"time"
+)
+
+// Config holds all config options for the session system.
+type Config struct {
+	IdleTimeout time.Duration `long:"idle-timeout" description:"The duration after which an in-use session without any traffic is revoked automatically. Individual sessions can override this timeout when they are created. Set to 0 to disable the automatic revocation of idle sessions."`
+}
+
+// DefaultConfig returns the default session Config struct.
+func DefaultConfig() *Config {
+	return &Config{}
+}
+
+// Validate checks that the config options are consistent.
+func (c *Config) Validate() error {
+	if c.IdleTimeout < 0 {
+		return fmt.Errorf("session.idle-timeout cannot be negative")
+	}
+
+	return nil
+}
@@ -0,0 +1,342 @@
+package session
+
+import (
+	"bytes"
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	"go.etcd.io/bbolt"
+)
+
+var (
+	// sessionIndexBucketKey is the top level bucket that holds the indexes
+	// of the sessions in the session bucket. All indexes map to the local
+	// public keys of the sessions, so the sessions can be looked up in the
+	// session bucket.
+	sessionIndexBucketKey = []byte("session-index")
+
+	// stateIndexKey is the key of the sub-bucket of the index bucket that
+	// holds one bucket per session state. Each of them contains the local
+	// public keys of all sessions in that state.
+	stateIndexKey = []byte("state")
+
+	// typeIndexKey is the key of the sub-bucket of the index bucket that
+	// holds one bucket per session type. Each of them contains the local
+	// public keys of all sessions of that type.
+	typeIndexKey = []byte("type")
+
+	// createdIndexKey is the key of the sub-bucket of the index bucket
+	// that contains the local public keys of all sessions prefixed with
+	// their big endian creation time. Iterating over it returns the
+	// sessions in the order they were created.
+	createdIndexKey = []byte("created")
+)
+
+// Query describes which sessions are returned by QuerySessions. The sessions
+// are returned in the order they were created. Zero values don't restrict the
+// result.
+type Query struct {
+	// States restricts the result to sessions in one of the given states.
+	States []State
+
+	// Types restricts the result to sessions of one of the given types.
+	Types []Type
+
+	// LabelContains restricts the result to sessions that contain the
+	// given string in their label, ignoring the case.
+	LabelContains string
+
+	// CreatedAfter restricts the result to sessions that were created at
+	// or after the given time.
+	CreatedAfter time.Time
+
+	// CreatedBefore restricts the result to sessions that were created
+	// before the given time.
+	CreatedBefore time.Time
+
+	// Offset is the number of matching sessions that are skipped.
+	Offset uint64
+
+	// Limit is the maximum number of sessions that are returned.
+	Limit uint64
+}
+
+// QuerySessions returns the sessions that match the given query. Only the
+// sessions that match the state, type and creation time of the query are read
+// from the session bucket.
+func (db *DB) QuerySessions(query *Query) ([]*Session, error) {
+	var sessions []*Session
+	err := db.View(func(tx *bbolt.Tx) error {
+		sessionBucket, err := getBucket(tx, sessionBucketKey)
+		if err != nil {
+			return err
+		}
+
+		indexBucket, err := getBucket(tx, sessionIndexBucketKey)
+		if err != nil {
+			return err
+		}
+
+		createdIndex := indexBucket.Bucket(createdIndexKey)
+		if createdIndex == nil {
+			return nil
+		}
+
+		var stateIDs, typeIDs []byte
+		for _, state := range query.States {
+			stateIDs = append(stateIDs, byte(state))
+		}
+		for _, typ := range query.Types {
+			typeIDs = append(typeIDs, byte(typ))
+		}
+		var (
+			stateIndexes = indexBuckets(
+				indexBucket, stateIndexKey, stateIDs,
+			)
+			typeIndexes = indexBuckets(
+				indexBucket, typeIndexKey, typeIDs,
+			)
+			label   = strings.ToLower(query.LabelContains)
+			start   [8]byte
+			end     uint64 = math.MaxUint64
+			skipped uint64
+		)
+		if !query.CreatedAfter.IsZero() {
+			byteOrder.PutUint64(
+				start[:], uint64(query.CreatedAfter.Unix()),
+			)
+		}
+		if !query.CreatedBefore.IsZero() {
+			end = uint64(query.CreatedBefore.Unix())
+		}
+
+		cursor := createdIndex.Cursor()
+		k, _ := cursor.Seek(start[:])
+		for ; k != nil; k, _ = cursor.Next() {
+			if byteOrder.Uint64(k[:8]) >= end {
+				break
+			}
+
+			sessionKey := k[8:]
+			if len(query.States) != 0 &&
+				!inIndexes(stateIndexes, sessionKey) {
+
+				continue
+			}
+			if len(query.Types) != 0 &&
+				!inIndexes(typeIndexes, sessionKey) {
+
+				continue
+			}
+
+			v := sessionBucket.Get(sessionKey)
+			if len(v) == 0 {
+				return fmt.Errorf("index refers to unknown "+
+					"session %x", sessionKey)
+			}
+
+			session, err := DeserializeSession(bytes.NewReader(v))
+			if err != nil {
+				return err
+			}
+
+			if label != "" && !strings.Contains(
+				strings.ToLower(session.Label), label,
+			) {
+
+				continue
+			}
+
+			if skipped < query.Offset {
+				skipped++
+				continue
+			}
+
+			if err := fetchUsage(tx, session); err != nil {
+				return err
+			}
+
+			sessions = append(sessions, session)
+
+			if query.Limit != 0 &&
+				uint64(len(sessions)) == query.Limit {
+
+				return nil
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return sessions, nil
+}
+
+// putSession stores the given session in the session bucket and updates the
+// session indexes within the given transaction.
+func putSession(tx *bbolt.Tx, session *Session) error {
+	sessionBucket, err := getBucket(tx, sessionBucketKey)
+	if err != nil {
+		return err
+	}
+
+	// The state of a session that is overwritten might have changed, so
+	// its old index entries are removed first.
+	sessionKey := getSessionKey(session)
+	if v := sessionBucket.Get(sessionKey); len(v) != 0 {
+		oldSession, err := DeserializeSession(bytes.NewReader(v))
+		if err != nil {
+			return err
+		}
+
+		if err := updateIndexes(tx, oldSession, false); err != nil {
+			return err
+		}
+	}
+
+	var buf bytes.Buffer
+	if err := SerializeSession(&buf, session); err != nil {
+		return err
+	}
+
+	if err := sessionBucket.Put(sessionKey, buf.Bytes()); err != nil {
+		return err
+	}
+
+	return updateIndexes(tx, session, true)
+}
+
+// updateIndexes adds the given session to or removes it from the state, type
+// and creation time indexes.
+func updateIndexes(tx *bbolt.Tx, session *Session, add bool) error {
+	indexBucket, err := getBucket(tx, sessionIndexBucketKey)
+	if err != nil {
+		return err
+	}
+
+	stateIndex, err := createIndexBucket(
+		indexBucket, stateIndexKey, []byte{byte(session.State)},
+	)
+	if err != nil {
+		return err
+	}
+
+	typeIndex, err := createIndexBucket(
+		indexBucket, typeIndexKey, []byte{byte(session.Type)},
+	)
+	if err != nil {
+		return err
+	}
+
+	createdIndex, err := indexBucket.CreateBucketIfNotExists(
+		createdIndexKey,
+	)
+	if err != nil {
+		return err
+	}
+
+	sessionKey := getSessionKey(session)
+	var createdKey [8]byte
+	byteOrder.PutUint64(createdKey[:], uint64(session.CreatedAt.Unix()))
+
+	entries := []struct {
+		bucket *bbolt.Bucket
+		key    []byte
+	}{
+		{stateIndex, sessionKey},
+		{typeIndex, sessionKey},
+		{createdIndex, append(createdKey[:], sessionKey...)},
+	}
+	for _, entry := range entries {
+		if !add {
+			err = entry.bucket.Delete(entry.key)
+		} else {
+			err = entry.bucket.Put(entry.key, []byte{})
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// createIndexBucket returns the bucket with the given ID within the index with
+// the given key, creating both if they don't exist yet.
+func createIndexBucket(indexBucket *bbolt.Bucket, indexKey,
+	id []byte) (*bbolt.Bucket, error) {
+
+	index, err := indexBucket.CreateBucketIfNotExists(indexKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return index.CreateBucketIfNotExists(id)
+}
+
+// indexBuckets returns the existing buckets with the given IDs within the index
+// with the given key.
+func indexBuckets(indexBucket *bbolt.Bucket, indexKey,
+	ids []byte) []*bbolt.Bucket {
+
+	index := indexBucket.Bucket(indexKey)
+	if index == nil {
+		return nil
+	}
+
+	var buckets []*bbolt.Bucket
+	for _, id := range ids {
+		if bucket := index.Bucket([]byte{id}); bucket != nil {
+			buckets = append(buckets, bucket)
+		}
+	}
+
+	return buckets
+}
+
+// inIndexes returns true if the given key is contained in any of the given
+// index buckets. As the index entries don't have a value, the key is looked up
+// with a cursor.
+func inIndexes(buckets []*bbolt.Bucket, key []byte) bool {
+	for _, bucket := range buckets {
+		k, _ := bucket.Cursor().Seek(key)
+		if bytes.Equal(k, key) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// migrateSessionIndexes is the first migration of the session database. It
+// adds all existing sessions to the session indexes.
+func migrateSessionIndexes(tx *bbolt.Tx) error {
+	_, err := tx.CreateBucketIfNotExists(sessionIndexBucketKey)
+	if err != nil {
+		return err
+	}
+
+	sessionBucket, err := getBucket(tx, sessionBucketKey)
+	if err != nil {
+		return err
+	}
+
+	return sessionBucket.ForEach(func(k, v []byte) error {
+		// We'll also get buckets here, skip those (identified by nil
+		// value).
+		if v == nil {
+			return nil
+		}
+
+		session, err := DeserializeSession(bytes.NewReader(v))
+		if err != nil {
+			return err
+		}
+
+		return updateIndexes(tx, session, true)
+	})
+}
@@ -31,8 +31,65 @@ const (
 	StateInUse   State = 1
 	StateRevoked State = 2
 	StateExpired State = 3
+	StatePaused  State = 4
 )
 
+// RevocationReason describes why a session was revoked.
+type RevocationReason uint8
+
+const (
+	// RevocationReasonUnknown is used for sessions that were revoked
+	// before the revocation reason was recorded.
+	RevocationReasonUnknown RevocationReason = 0
+
+	// RevocationReasonManual means that the session was revoked by the
+	// user.
+	RevocationReasonManual RevocationReason = 1
+
+	// RevocationReasonExpired means that the session was revoked because
+	// it expired.
+	RevocationReasonExpired RevocationReason = 2
+
+	// RevocationReasonFirstConnDeadline means that the session was revoked
+	// because no connection was made before the first connection deadline.
+	RevocationReasonFirstConnDeadline RevocationReason = 3
+
+	// RevocationReasonIdle means that the session was revoked because it
+	// didn't have any traffic for longer than its idle timeout.
+	RevocationReasonIdle RevocationReason = 4
+
+	// RevocationReasonAutopilot means that the session was revoked because
+	// the autopilot server permanently refused to activate it.
+	RevocationReasonAutopilot RevocationReason = 5
+)
+
+// String returns the string representation of the revocation reason.
+func (r RevocationReason) String() string {
+	switch r {
+	case RevocationReasonManual:
+		return "manual"
+
+	case RevocationReasonExpired:
+		return "expired"
+
+	case RevocationReasonFirstConnDeadline:
+		return "first_conn_deadline"
+
+	case RevocationReasonIdle:
+		return "idle"
+
+	case RevocationReasonAutopilot:
+		return "autopilot"
+
+	default:
+		return "unknown"
+	}
+}
+
+// MaxDevices is the maximum number of devices that can be paired with a
+// session in addition to the remote app that paired with the session itself.
+const MaxDevices = 5
+
 // MacaroonRecipe defines the permissions and caveats that should be used
 // to bake a macaroon.
 type MacaroonRecipe struct {
@@ -63,12 +120,125 @@ type Session struct {
 	RemotePublicKey   *btcec.PublicKey
 	FeatureConfig     *FeaturesConfig
 	WithPrivacyMapper bool
+
+	// IdleTimeout is the duration after which the session is revoked if it
+	// is in use but doesn't have any traffic. If zero, the globally
+	// configured idle timeout is used.
+	IdleTimeout time.Duration
+
+	// RevocationReason describes why the session was revoked. It is only
+	// set for revoked sessions.
+	RevocationReason RevocationReason
+
+	// Usage holds the usage statistics of the session. They are stored
+	// separately from the session and are only populated when fetching
+	// sessions from the store.
+	Usage Usage
+
+	// Devices holds the additional devices that were added to the session,
+	// including the revoked ones.
+	Devices []*Device
+}
+
+// Device is an additional remote app that is paired with a session. Each
+// device has its own pairing secret and local key pair, so it pairs and
+// connects through its own mailbox. All devices share the macaroon and the
+// firewall rules of their session.
+type Device struct {
+	Label           string
+	PairingSecret   [mailbox.NumPassphraseEntropyBytes]byte
+	LocalPrivateKey *btcec.PrivateKey
+	LocalPublicKey  *btcec.PublicKey
+	RemotePublicKey *btcec.PublicKey
+	CreatedAt       time.Time
+	RevokedAt       time.Time
+}
+
+// Revoked returns true if the device was revoked.
+func (d *Device) Revoked() bool {
+	return !d.RevokedAt.IsZero()
+}
+
+// NewDevice creates a new device with the given label and a fresh pairing
+// secret and local key pair.
+func NewDevice(label string) (*Device, error) {
+	_, pairingSecret, err := mailbox.NewPassphraseEntropy()
+	if err != nil {
+		return nil, fmt.Errorf("error deriving pairing secret: %v", err)
+	}
+
+	privateKey, err := btcec.NewPrivateKey()
+	if err != nil {
+		return nil, fmt.Errorf("error deriving private key: %v", err)
+	}
+
+	return &Device{
+		Label:           label,
+		PairingSecret:   pairingSecret,
+		LocalPrivateKey: privateKey,
+		LocalPublicKey:  privateKey.PubKey(),
+		CreatedAt:       time.Now(),
+	}, nil
+}
+
+// ActiveDevices returns the devices of the session that are not revoked.
+func (s *Session) ActiveDevices() []*Device {
+	var devices []*Device
+	for _, device := range s.Devices {
+		if !device.Revoked() {
+			devices = append(devices, device)
+		}
+	}
+
+	return devices
+}
+
+// Device returns the device of the session with the given local public key.
+func (s *Session) Device(key *btcec.PublicKey) (*Device, error) {
+	for _, device := range s.Devices {
+		if device.LocalPublicKey.IsEqual(key) {
+			return device, nil
+		}
+	}
+
+	return nil, ErrDeviceNotFound
+}
+
+// DeviceSession returns a copy of the session that uses the pairing secret and
+// keys of the given device. It can be passed to Server.StartSession to pair and
+// connect the device through its own mailbox.
+func (s *Session) DeviceSession(device *Device) *Session {
+	sess := *s
+	sess.PairingSecret = device.PairingSecret
+	sess.LocalPrivateKey = device.LocalPrivateKey
+	sess.LocalPublicKey = device.LocalPublicKey
+	sess.RemotePublicKey = device.RemotePublicKey
+	sess.Devices = nil
+
+	return &sess
+}
+
+// Template is a named, reusable preset for creating sessions. It stores the
+// session type and macaroon recipe together with the default lifetime and
+// mailbox server of the sessions created from it.
+type Template struct {
+	Name           string
+	Type           Type
+	Expiry         time.Duration
+	ServerAddr     string
+	DevServer      bool
+	MacaroonRecipe *MacaroonRecipe
+	CreatedAt      time.Time
 }
 
 // MacaroonBaker is a function type for baking a super macaroon.
 type MacaroonBaker func(ctx context.Context, rootKeyID uint64,
 	recipe *MacaroonRecipe) (string, error)
 
+// MacaroonRootKeyDeleter is a function type for deleting the root key with the
+// given ID, which invalidates all macaroons that were baked with it.
+type MacaroonRootKeyDeleter func(ctx context.Context, rootKeyID uint64) error
+
 // NewSession creates a new session with the given user-defined parameters.
 func NewSession(label string, typ Type, expiry time.Time, serverAddr string,
 	devServer bool, perms []bakery.Op, caveats []macaroon.Caveat,
@@ -131,7 +301,54 @@ type Store interface {
 	// ListSessions returns all sessions currently known to the store.
 	ListSessions() ([]*Session, error)
 
+	// QuerySessions returns the sessions that match the given query.
+	QuerySessions(*Query) ([]*Session, error)
+
 	// RevokeSession updates the state of the session with the given local
-	// public key to be revoked.
-	RevokeSession(*btcec.PublicKey) error
+	// public key to be revoked and records the reason for the revocation.
+	RevokeSession(*btcec.PublicKey, RevocationReason) error
+
+	// RotateSession sets a new expiry and macaroon root key ID for the
+	// active session with the given local public key and returns the
+	// updated session.
+	RotateSession(*btcec.PublicKey, time.Time, uint64) (*Session, error)
+
+	// PauseSession moves the active session with the given local public
+	// key into the paused state and returns the updated session.
+	PauseSession(*btcec.PublicKey) (*Session, error)
+
+	// ResumeSession moves the paused session with the given local public
+	// key back into its active state and returns the updated session.
+	ResumeSession(*btcec.PublicKey) (*Session, error)
+
+	// AddSessionUsage adds the given usage to the usage statistics of the
+	// session with the given local public key.
+	AddSessionUsage(*btcec.PublicKey, *Usage) error
+
+	// AddSessionDevice adds the given device to the session with the given
+	// local public key and returns the updated session.
+	AddSessionDevice(*btcec.PublicKey, *Device) (*Session, error)
+
+	// UpdateRemotePubKey sets the remote public key of the session with
+	// the given session key if the local key is the session key, or
+	// otherwise of the device of the session with the given local key.
+	UpdateRemotePubKey(sessionKey, localKey,
+		remoteKey *btcec.PublicKey) error
+
+	// RevokeSessionDevice revokes the device with the given local public
+	// key of the session with the given local public key and returns the
+	// updated session.
+	RevokeSessionDevice(sessionKey,
+		deviceKey *btcec.PublicKey) (*Session, error)
+
+	// StoreTemplate stores a session template in the store. If a template
+	// with the same name already exists, it is replaced.
+	StoreTemplate(*Template) error
+
+	// GetTemplate fetches the session template with the given name.
+	GetTemplate(name string) (*Template, error)
+
+	// ListTemplates returns all session templates currently known to the
+	// store.
+	ListTemplates() ([]*Template, error)
 }
@@ -20,6 +20,13 @@ var (
 	SuperMacaroonRootKeyPrefix = [4]byte{0xFF, 0xEE, 0xDD, 0xCC}
 )
 
+const (
+	// superMacaroonGenerationByte is the index of the byte in a super
+	// macaroon's root key ID that is changed each time the macaroon of a
+	// session is rotated.
+	superMacaroonGenerationByte = 3
+)
+
 // ID represents the id of a session.
 type ID [4]byte
 
@@ -37,6 +44,17 @@ func NewSuperMacaroonRootKeyID(id [4]byte) uint64 {
 	return binary.BigEndian.Uint64(rootKeyBytes)
 }
 
+// NextSuperMacaroonRootKeyID returns the root key ID that replaces the given
+// super macaroon root key ID when the macaroon of a session is rotated. Only
+// the last byte of the prefix is changed, so the new root key ID still maps to
+// the same session ID.
+func NextSuperMacaroonRootKeyID(rootKeyID uint64) uint64 {
+	rootKeyBytes := make([]byte, 8)
+	binary.BigEndian.PutUint64(rootKeyBytes, rootKeyID)
+	rootKeyBytes[superMacaroonGenerationByte]++
+	return binary.BigEndian.Uint64(rootKeyBytes)
+}
+
 // ParseMacaroon parses a hex encoded macaroon into its native struct.
 func ParseMacaroon(macHex string) (*macaroon.Macaroon, error) {
 	macBytes, err := hex.DecodeString(macHex)
@@ -70,11 +88,14 @@ func IsSuperMacaroon(macHex string) bool {
 
 // isSuperMacaroonRootKeyID returns true if the given macaroon root key ID (also
 // known as storage ID) is a super macaroon, which can be identified by its
-// first 4 bytes.
+// first 3 bytes. The 4th byte is changed on each rotation of the macaroon.
 func isSuperMacaroonRootKeyID(rootKeyID uint64) bool {
 	rootKeyBytes := make([]byte, 8)
 	binary.BigEndian.PutUint64(rootKeyBytes, rootKeyID)
-	return bytes.HasPrefix(rootKeyBytes, SuperMacaroonRootKeyPrefix[:])
+	return bytes.HasPrefix(
+		rootKeyBytes,
+		SuperMacaroonRootKeyPrefix[:superMacaroonGenerationByte],
+	)
 }
 
 // IDFromMacaroon is a helper function that creates a session ID from
@@ -30,6 +30,13 @@ func TestSuperMacaroonRootKeyID(t *testing.T) {
 	rootKeyID := NewSuperMacaroonRootKeyID(someBytes)
 	require.True(t, isSuperMacaroonRootKeyID(rootKeyID))
 	require.False(t, isSuperMacaroonRootKeyID(123))
+
+	// A rotated root key ID is still a super macaroon root key ID and maps
+	// to the same session ID.
+	nextRootKeyID := NextSuperMacaroonRootKeyID(rootKeyID)
+	require.NotEqual(t, rootKeyID, nextRootKeyID)
+	require.True(t, isSuperMacaroonRootKeyID(nextRootKeyID))
+	require.Equal(t, ID(someBytes), IDFromMacRootKeyID(nextRootKeyID))
 }
 
 func TestIsSuperMacaroon(t *testing.T) {
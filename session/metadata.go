@@ -29,7 +29,9 @@ var (
 	// version of the database doesn't match the latest version this list
 	// will be used for retrieving all migration function that are need to
 	// apply to the current db.
-	dbVersions []migration
+	dbVersions = []migration{
+		migrateSessionIndexes,
+	}
 
 	latestDBVersion = uint32(len(dbVersions))
 )
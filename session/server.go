@@ -20,14 +20,16 @@ type GRPCServerCreator func(opts ...grpc.ServerOption) *grpc.Server
 
 type mailboxSession struct {
 	server *grpc.Server
+	usage  *usageTracker
 
 	wg   sync.WaitGroup
 	quit chan struct{}
 }
 
-func newMailboxSession() *mailboxSession {
+func newMailboxSession(onUsage func(usage *Usage) error) *mailboxSession {
 	return &mailboxSession{
-		quit: make(chan struct{}),
+		usage: newUsageTracker(onUsage),
+		quit:  make(chan struct{}),
 	}
 }
 
@@ -51,9 +53,21 @@ func (m *mailboxSession) start(session *Session,
 		}, nil,
 	)
 
+	// Record every new connection of the remote app before passing the
+	// status on.
+	onStatus := func(s mailbox.ServerStatus) {
+		if s == mailbox.ServerStatusInUse {
+			m.usage.connected()
+		}
+
+		if onNewStatus != nil {
+			onNewStatus(s)
+		}
+	}
+
 	// Start the mailbox gRPC server.
 	mailboxServer, err := mailbox.NewServer(
-		session.ServerAddr, keys, onNewStatus,
+		session.ServerAddr, keys, onStatus,
 		grpc.WithTransportCredentials(credentials.NewTLS(tlsConfig)),
 		grpc.WithKeepaliveParams(keepalive.ClientParameters{
 			Time: 2 * time.Minute,
@@ -64,10 +78,15 @@ func (m *mailboxSession) start(session *Session,
 	}
 
 	noiseConn := mailbox.NewNoiseGrpcConn(keys)
-	m.server = serverCreator(grpc.Creds(noiseConn))
+	m.server = serverCreator(
+		grpc.Creds(noiseConn), grpc.StatsHandler(m.usage),
+		grpc.ChainUnaryInterceptor(m.usage.unaryInterceptor),
+		grpc.ChainStreamInterceptor(m.usage.streamInterceptor),
+	)
 
-	m.wg.Add(1)
+	m.wg.Add(2)
 	go m.run(mailboxServer)
+	go m.trackUsage()
 
 	return nil
 }
@@ -81,6 +100,26 @@ func (m *mailboxSession) run(mailboxServer *mailbox.Server) {
 	}
 }
 
+// trackUsage periodically persists the usage of the session until the session
+// is stopped, at which point the remaining usage is persisted.
+func (m *mailboxSession) trackUsage() {
+	defer m.wg.Done()
+
+	ticker := time.NewTicker(usageFlushInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			m.usage.flush()
+
+		case <-m.quit:
+			m.usage.flush()
+			return
+		}
+	}
+}
+
 func (m *mailboxSession) stop() {
 	m.server.Stop()
 	close(m.quit)
@@ -106,7 +145,8 @@ func NewServer(serverCreator GRPCServerCreator) *Server {
 
 func (s *Server) StartSession(session *Session, authData []byte,
 	onUpdate func(sess *Session) error,
-	onNewStatus func(s mailbox.ServerStatus)) (chan struct{}, error) {
+	onNewStatus func(s mailbox.ServerStatus),
+	onUsage func(usage *Usage) error) (chan struct{}, error) {
 
 	s.activeSessionsMtx.Lock()
 	defer s.activeSessionsMtx.Unlock()
@@ -119,12 +159,17 @@ func (s *Server) StartSession(session *Session, authData []byte,
 		return nil, fmt.Errorf("session %x is already active", id[:])
 	}
 
-	sess := newMailboxSession()
-	s.activeSessions[id] = sess
-
-	return sess.quit, sess.start(
+	sess := newMailboxSession(onUsage)
+	err := sess.start(
 		session, s.serverCreator, authData, onUpdate, onNewStatus,
 	)
+	if err != nil {
+		return nil, err
+	}
+
+	s.activeSessions[id] = sess
+
+	return sess.quit, nil
 }
 
 func (s *Server) StopSession(localPublicKey *btcec.PublicKey) error {
@@ -3,6 +3,7 @@ package session
 import (
 	"bytes"
 	"errors"
+	"fmt"
 	"time"
 
 	"github.com/btcsuite/btcd/btcec/v2"
@@ -15,9 +16,32 @@ var (
 	// public key.
 	sessionBucketKey = []byte("session")
 
+	// templateBucketKey is the top level bucket where all session templates
+	// are stored. The templates are indexed by their name.
+	templateBucketKey = []byte("session-templates")
+
+	// usageBucketKey is the top level bucket where the usage statistics of
+	// the sessions are stored. They are indexed by the local public key of
+	// the session. The statistics are kept separate from the sessions so
+	// that updating them never overwrites a concurrent session update.
+	usageBucketKey = []byte("session-usage")
+
+	// callCountBucketKey is the top level bucket where the number of calls
+	// made with macaroons that have max-call-count caveats are stored. They
+	// are indexed by a hash of the macaroon and caveat ID.
+	callCountBucketKey = []byte("macaroon-calls")
+
 	// ErrSessionNotFound is an error returned when we attempt to retrieve
 	// information about a session but it is not found.
 	ErrSessionNotFound = errors.New("session not found")
+
+	// ErrTemplateNotFound is an error returned when we attempt to retrieve
+	// a session template that is not found.
+	ErrTemplateNotFound = errors.New("session template not found")
+
+	// ErrDeviceNotFound is an error returned when we attempt to retrieve a
+	// device of a session that is not found.
+	ErrDeviceNotFound = errors.New("session device not found")
 )
 
 // getSessionKey returns the key for a session.
@@ -29,19 +53,8 @@ func getSessionKey(session *Session) []byte {
 // same local public key already exists, the existing record is updated/
 // overwritten instead.
 func (db *DB) StoreSession(session *Session) error {
-	var buf bytes.Buffer
-	if err := SerializeSession(&buf, session); err != nil {
-		return err
-	}
-	sessionKey := getSessionKey(session)
-
 	return db.Update(func(tx *bbolt.Tx) error {
-		sessionBucket, err := getBucket(tx, sessionBucketKey)
-		if err != nil {
-			return err
-		}
-
-		return sessionBucket.Put(sessionKey, buf.Bytes())
+		return putSession(tx, session)
 	})
 }
 
@@ -64,7 +77,7 @@ func (db *DB) GetSession(key *btcec.PublicKey) (*Session, error) {
 			return err
 		}
 
-		return nil
+		return fetchUsage(tx, session)
 	})
 	if err != nil {
 		return nil, err
@@ -94,6 +107,10 @@ func (db *DB) ListSessions(filterFn func(s *Session) bool) ([]*Session, error) {
 				return err
 			}
 
+			if err := fetchUsage(tx, session); err != nil {
+				return err
+			}
+
 			if filterFn != nil && !filterFn(session) {
 				return nil
 			}
@@ -111,8 +128,10 @@ func (db *DB) ListSessions(filterFn func(s *Session) bool) ([]*Session, error) {
 }
 
 // RevokeSession updates the state of the session with the given local
-// public key to be revoked.
-func (db *DB) RevokeSession(key *btcec.PublicKey) error {
+// public key to be revoked and records the reason for the revocation.
+func (db *DB) RevokeSession(key *btcec.PublicKey,
+	reason RevocationReason) error {
+
 	var session *Session
 	err := db.View(func(tx *bbolt.Tx) error {
 		sessionBucket, err := getBucket(tx, sessionBucketKey)
@@ -134,6 +153,344 @@ func (db *DB) RevokeSession(key *btcec.PublicKey) error {
 
 	session.State = StateRevoked
 	session.RevokedAt = time.Now()
+	session.RevocationReason = reason
 
 	return db.StoreSession(session)
 }
+
+// RotateSession sets a new expiry and macaroon root key ID for the session with
+// the given local public key and returns the updated session. Only sessions
+// that are not yet revoked or expired can be rotated.
+func (db *DB) RotateSession(key *btcec.PublicKey, expiry time.Time,
+	rootKeyID uint64) (*Session, error) {
+
+	return db.updateSession(key, func(session *Session) error {
+		if session.State != StateCreated &&
+			session.State != StateInUse {
+
+			return fmt.Errorf("cannot rotate session in state %d",
+				session.State)
+		}
+
+		if IDFromMacRootKeyID(rootKeyID) != session.ID {
+			return fmt.Errorf("root key ID %d doesn't belong to "+
+				"session %x", rootKeyID, session.ID[:])
+		}
+
+		session.Expiry = expiry
+		session.MacaroonRootKey = rootKeyID
+
+		return nil
+	})
+}
+
+// PauseSession moves the active session with the given local public key into
+// the paused state and returns the updated session.
+func (db *DB) PauseSession(key *btcec.PublicKey) (*Session, error) {
+	return db.updateSession(key, func(session *Session) error {
+		if session.State != StateCreated &&
+			session.State != StateInUse {
+
+			return fmt.Errorf("cannot pause session in state %d",
+				session.State)
+		}
+
+		session.State = StatePaused
+
+		return nil
+	})
+}
+
+// ResumeSession moves the paused session with the given local public key back
+// into its active state and returns the updated session. A session that was
+// already paired with a remote app is in use again, all others are created.
+func (db *DB) ResumeSession(key *btcec.PublicKey) (*Session, error) {
+	return db.updateSession(key, func(session *Session) error {
+		if session.State != StatePaused {
+			return fmt.Errorf("cannot resume session in state %d",
+				session.State)
+		}
+
+		session.State = StateCreated
+		if session.RemotePublicKey != nil {
+			session.State = StateInUse
+		}
+
+		return nil
+	})
+}
+
+// AddSessionDevice adds the given device to the session with the given local
+// public key and returns the updated session. Devices can only be added to
+// sessions that are not yet revoked or expired, and only up to MaxDevices
+// devices of a session can be active at the same time.
+func (db *DB) AddSessionDevice(key *btcec.PublicKey,
+	device *Device) (*Session, error) {
+
+	return db.updateSession(key, func(session *Session) error {
+		if session.State != StateCreated &&
+			session.State != StateInUse &&
+			session.State != StatePaused {
+
+			return fmt.Errorf("cannot add device to session in "+
+				"state %d", session.State)
+		}
+
+		if len(session.ActiveDevices()) >= MaxDevices {
+			return fmt.Errorf("session already has the maximum "+
+				"number of %d active devices", MaxDevices)
+		}
+
+		session.Devices = append(session.Devices, device)
+
+		return nil
+	})
+}
+
+// UpdateRemotePubKey sets the remote public key of the session with the given
+// session key if the local key is the session key, or otherwise of the device
+// of the session with the given local key. It is called once the session or
+// device completed its pairing handshake. Only the remote key is updated, so
+// concurrent changes to the session or its other devices are kept.
+func (db *DB) UpdateRemotePubKey(sessionKey, localKey,
+	remoteKey *btcec.PublicKey) error {
+
+	_, err := db.updateSession(sessionKey, func(session *Session) error {
+		if localKey.IsEqual(sessionKey) {
+			session.RemotePublicKey = remoteKey
+			return nil
+		}
+
+		device, err := session.Device(localKey)
+		if err != nil {
+			return err
+		}
+
+		device.RemotePublicKey = remoteKey
+
+		return nil
+	})
+
+	return err
+}
+
+// RevokeSessionDevice revokes the device with the given local public key of the
+// session with the given local public key and returns the updated session. The
+// session itself and its other devices stay usable.
+func (db *DB) RevokeSessionDevice(sessionKey,
+	deviceKey *btcec.PublicKey) (*Session, error) {
+
+	return db.updateSession(sessionKey, func(session *Session) error {
+		device, err := session.Device(deviceKey)
+		if err != nil {
+			return err
+		}
+
+		if device.Revoked() {
+			return fmt.Errorf("device %x is already revoked",
+				deviceKey.SerializeCompressed())
+		}
+
+		device.RevokedAt = time.Now()
+
+		return nil
+	})
+}
+
+// updateSession applies the given update to the session with the given local
+// public key within a single database transaction and returns the updated
+// session.
+func (db *DB) updateSession(key *btcec.PublicKey,
+	update func(session *Session) error) (*Session, error) {
+
+	var session *Session
+	err := db.Update(func(tx *bbolt.Tx) error {
+		sessionBucket, err := getBucket(tx, sessionBucketKey)
+		if err != nil {
+			return err
+		}
+
+		sessionKey := key.SerializeCompressed()
+		sessionBytes := sessionBucket.Get(sessionKey)
+		if len(sessionBytes) == 0 {
+			return ErrSessionNotFound
+		}
+
+		session, err = DeserializeSession(bytes.NewReader(sessionBytes))
+		if err != nil {
+			return err
+		}
+
+		if err := update(session); err != nil {
+			return err
+		}
+
+		if err := putSession(tx, session); err != nil {
+			return err
+		}
+
+		return fetchUsage(tx, session)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return session, nil
+}
+
+// AddSessionUsage adds the given usage to the usage statistics of the session
+// with the given local public key.
+func (db *DB) AddSessionUsage(key *btcec.PublicKey, usage *Usage) error {
+	return db.Update(func(tx *bbolt.Tx) error {
+		usageBucket, err := getBucket(tx, usageBucketKey)
+		if err != nil {
+			return err
+		}
+
+		var (
+			sessionKey = key.SerializeCompressed()
+			total      Usage
+		)
+		if v := usageBucket.Get(sessionKey); len(v) != 0 {
+			err := deserializeUsage(bytes.NewReader(v), &total)
+			if err != nil {
+				return err
+			}
+		}
+
+		total.add(usage)
+
+		var buf bytes.Buffer
+		if err := serializeUsage(&buf, &total); err != nil {
+			return err
+		}
+
+		return usageBucket.Put(sessionKey, buf.Bytes())
+	})
+}
+
+// fetchUsage populates the usage statistics of the given session.
+func fetchUsage(tx *bbolt.Tx, session *Session) error {
+	usageBucket, err := getBucket(tx, usageBucketKey)
+	if err != nil {
+		return err
+	}
+
+	v := usageBucket.Get(session.LocalPublicKey.SerializeCompressed())
+	if len(v) == 0 {
+		return nil
+	}
+
+	return deserializeUsage(bytes.NewReader(v), &session.Usage)
+}
+
+// CountCall increments the call counters with the given IDs. If any of them has
+// already reached the maximum number of calls that is given for it,
+// ErrMaxCallsReached is returned and none of the counters is changed.
+//
+// NOTE: This is part of the CallCounter interface.
+func (db *DB) CountCall(limits map[[32]byte]uint64) error {
+	return db.Update(func(tx *bbolt.Tx) error {
+		callBucket, err := getBucket(tx, callCountBucketKey)
+		if err != nil {
+			return err
+		}
+
+		counts := make(map[[32]byte]uint64, len(limits))
+		for id, maxCalls := range limits {
+			var count uint64
+			if v := callBucket.Get(id[:]); len(v) == 8 {
+				count = byteOrder.Uint64(v)
+			}
+
+			if count >= maxCalls {
+				return ErrMaxCallsReached
+			}
+			counts[id] = count + 1
+		}
+
+		for id, count := range counts {
+			var v [8]byte
+			byteOrder.PutUint64(v[:], count)
+			if err := callBucket.Put(id[:], v[:]); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+// StoreTemplate stores a session template in the store. If a template with the
+// same name already exists, it is replaced.
+func (db *DB) StoreTemplate(template *Template) error {
+	if template.Name == "" {
+		return fmt.Errorf("template name cannot be empty")
+	}
+
+	var buf bytes.Buffer
+	if err := SerializeTemplate(&buf, template); err != nil {
+		return err
+	}
+
+	return db.Update(func(tx *bbolt.Tx) error {
+		templateBucket, err := getBucket(tx, templateBucketKey)
+		if err != nil {
+			return err
+		}
+
+		return templateBucket.Put([]byte(template.Name), buf.Bytes())
+	})
+}
+
+// GetTemplate fetches the session template with the given name.
+func (db *DB) GetTemplate(name string) (*Template, error) {
+	var template *Template
+	err := db.View(func(tx *bbolt.Tx) error {
+		templateBucket, err := getBucket(tx, templateBucketKey)
+		if err != nil {
+			return err
+		}
+
+		v := templateBucket.Get([]byte(name))
+		if len(v) == 0 {
+			return ErrTemplateNotFound
+		}
+
+		template, err = DeserializeTemplate(bytes.NewReader(v))
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return template, nil
+}
+
+// ListTemplates returns all session templates currently known to the store,
+// ordered by their name.
+func (db *DB) ListTemplates() ([]*Template, error) {
+	var templates []*Template
+	err := db.View(func(tx *bbolt.Tx) error {
+		templateBucket, err := getBucket(tx, templateBucketKey)
+		if err != nil {
+			return err
+		}
+
+		return templateBucket.ForEach(func(_, v []byte) error {
+			template, err := DeserializeTemplate(bytes.NewReader(v))
+			if err != nil {
+				return err
+			}
+
+			templates = append(templates, template)
+
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return templates, nil
+}
@@ -0,0 +1,369 @@
+package session
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.etcd.io/bbolt"
+)
+
+// TestRotateSession makes sure that the expiry and root key of an active
+// session can be changed while its ID and keys are kept, and that revoked
+// sessions can't be rotated.
+func TestRotateSession(t *testing.T) {
+	db, sess := newTestDBWithSession(t, "rotate")
+
+	expiry := time.Now().Add(24 * time.Hour)
+	rootKeyID := NextSuperMacaroonRootKeyID(sess.MacaroonRootKey)
+	rotated, err := db.RotateSession(sess.LocalPublicKey, expiry, rootKeyID)
+	require.NoError(t, err)
+	require.Equal(t, expiry.Unix(), rotated.Expiry.Unix())
+	require.Equal(t, sess.ID, rotated.ID)
+	require.Equal(t, rootKeyID, rotated.MacaroonRootKey)
+
+	stored, err := db.GetSession(sess.LocalPublicKey)
+	require.NoError(t, err)
+	require.Equal(t, expiry.Unix(), stored.Expiry.Unix())
+	require.Equal(t, sess.ID, stored.ID)
+	require.Equal(t, rootKeyID, stored.MacaroonRootKey)
+	require.Equal(
+		t, sess.LocalPublicKey.SerializeCompressed(),
+		stored.LocalPublicKey.SerializeCompressed(),
+	)
+
+	// A root key ID of another session is rejected.
+	_, err = db.RotateSession(sess.LocalPublicKey, expiry, 123)
+	require.Error(t, err)
+
+	err = db.RevokeSession(sess.LocalPublicKey, RevocationReasonManual)
+	require.NoError(t, err)
+	_, err = db.RotateSession(sess.LocalPublicKey, expiry, rootKeyID)
+	require.Error(t, err)
+
+	stored, err = db.GetSession(sess.LocalPublicKey)
+	require.NoError(t, err)
+	require.Equal(t, StateRevoked, stored.State)
+	require.Equal(t, RevocationReasonManual, stored.RevocationReason)
+}
+
+// TestSessionUsage makes sure that the usage of a session is accumulated and
+// returned together with the session.
+func TestSessionUsage(t *testing.T) {
+	db, sess := newTestDBWithSession(t, "usage")
+
+	// A session without any recorded usage has empty statistics.
+	stored, err := db.GetSession(sess.LocalPublicKey)
+	require.NoError(t, err)
+	require.Equal(t, Usage{}, stored.Usage)
+
+	firstConn := time.Unix(1672531200, 0)
+	require.NoError(t, db.AddSessionUsage(sess.LocalPublicKey, &Usage{
+		LastConnected:  firstConn,
+		NumConnections: 1,
+		NumCalls:       3,
+		BytesReceived:  100,
+		BytesSent:      200,
+	}))
+	require.NoError(t, db.AddSessionUsage(sess.LocalPublicKey, &Usage{
+		NumCalls:  2,
+		BytesSent: 50,
+	}))
+
+	expected := Usage{
+		LastConnected:  firstConn,
+		NumConnections: 1,
+		NumCalls:       5,
+		BytesReceived:  100,
+		BytesSent:      250,
+	}
+
+	stored, err = db.GetSession(sess.LocalPublicKey)
+	require.NoError(t, err)
+	require.Equal(t, expected, stored.Usage)
+
+	sessions, err := db.ListSessions(nil)
+	require.NoError(t, err)
+	require.Len(t, sessions, 1)
+	require.Equal(t, expected, sessions[0].Usage)
+
+	// Storing the session again must not reset its usage.
+	require.NoError(t, db.StoreSession(sess))
+	stored, err = db.GetSession(sess.LocalPublicKey)
+	require.NoError(t, err)
+	require.Equal(t, expected, stored.Usage)
+}
+
+// TestPauseResumeSession makes sure that only active sessions can be paused
+// and only paused sessions can be resumed.
+func TestPauseResumeSession(t *testing.T) {
+	db, sess := newTestDBWithSession(t, "pause")
+
+	// A session that is not paused can't be resumed.
+	_, err := db.ResumeSession(sess.LocalPublicKey)
+	require.Error(t, err)
+
+	paused, err := db.PauseSession(sess.LocalPublicKey)
+	require.NoError(t, err)
+	require.Equal(t, StatePaused, paused.State)
+	require.Equal(t, sess.ID, paused.ID)
+
+	_, err = db.PauseSession(sess.LocalPublicKey)
+	require.Error(t, err)
+
+	// A session without a remote public key was never paired, so it is
+	// in the created state again after resuming it.
+	resumed, err := db.ResumeSession(sess.LocalPublicKey)
+	require.NoError(t, err)
+	require.Equal(t, StateCreated, resumed.State)
+
+	// A paired session is in use after resuming it.
+	sess.RemotePublicKey = sess.LocalPublicKey
+	require.NoError(t, db.StoreSession(sess))
+	_, err = db.PauseSession(sess.LocalPublicKey)
+	require.NoError(t, err)
+
+	resumed, err = db.ResumeSession(sess.LocalPublicKey)
+	require.NoError(t, err)
+	require.Equal(t, StateInUse, resumed.State)
+
+	// Revoked sessions can't be paused.
+	err = db.RevokeSession(sess.LocalPublicKey, RevocationReasonManual)
+	require.NoError(t, err)
+	_, err = db.PauseSession(sess.LocalPublicKey)
+	require.Error(t, err)
+}
+
+// TestSessionDevices makes sure that devices can be added to a session up to
+// the maximum number of active devices, that they can be paired and revoked
+// separately and that revoked sessions don't accept new devices.
+func TestSessionDevices(t *testing.T) {
+	db, sess := newTestDBWithSession(t, "devices")
+
+	var devices []*Device
+	for i := 0; i < MaxDevices; i++ {
+		device, err := NewDevice("device")
+		require.NoError(t, err)
+
+		updated, err := db.AddSessionDevice(sess.LocalPublicKey, device)
+		require.NoError(t, err)
+		require.Len(t, updated.Devices, i+1)
+
+		devices = append(devices, device)
+	}
+
+	// The session already has the maximum number of active devices.
+	extra, err := NewDevice("extra")
+	require.NoError(t, err)
+	_, err = db.AddSessionDevice(sess.LocalPublicKey, extra)
+	require.Error(t, err)
+
+	// Pairing a device only sets the remote key of that device.
+	remoteKey := sess.LocalPublicKey
+	err = db.UpdateRemotePubKey(
+		sess.LocalPublicKey, devices[0].LocalPublicKey, remoteKey,
+	)
+	require.NoError(t, err)
+
+	stored, err := db.GetSession(sess.LocalPublicKey)
+	require.NoError(t, err)
+	require.Nil(t, stored.RemotePublicKey)
+	require.True(t, stored.Devices[0].RemotePublicKey.IsEqual(remoteKey))
+	require.Nil(t, stored.Devices[1].RemotePublicKey)
+
+	// Pairing the session itself keeps the paired device.
+	err = db.UpdateRemotePubKey(
+		sess.LocalPublicKey, sess.LocalPublicKey, remoteKey,
+	)
+	require.NoError(t, err)
+
+	stored, err = db.GetSession(sess.LocalPublicKey)
+	require.NoError(t, err)
+	require.True(t, stored.RemotePublicKey.IsEqual(remoteKey))
+	require.True(t, stored.Devices[0].RemotePublicKey.IsEqual(remoteKey))
+
+	// A revoked device makes room for a new one and can't be revoked
+	// twice.
+	revoked, err := db.RevokeSessionDevice(
+		sess.LocalPublicKey, devices[0].LocalPublicKey,
+	)
+	require.NoError(t, err)
+	require.True(t, revoked.Devices[0].Revoked())
+	require.Len(t, revoked.ActiveDevices(), MaxDevices-1)
+
+	_, err = db.RevokeSessionDevice(
+		sess.LocalPublicKey, devices[0].LocalPublicKey,
+	)
+	require.Error(t, err)
+
+	_, err = db.AddSessionDevice(sess.LocalPublicKey, extra)
+	require.NoError(t, err)
+
+	// An unknown device can't be revoked.
+	_, err = db.RevokeSessionDevice(
+		sess.LocalPublicKey, sess.LocalPublicKey,
+	)
+	require.ErrorIs(t, err, ErrDeviceNotFound)
+
+	// Devices can't be added to a revoked session.
+	err = db.RevokeSession(sess.LocalPublicKey, RevocationReasonManual)
+	require.NoError(t, err)
+
+	device, err := NewDevice("device")
+	require.NoError(t, err)
+	_, err = db.AddSessionDevice(sess.LocalPublicKey, device)
+	require.Error(t, err)
+}
+
+// TestQuerySessions makes sure that sessions can be filtered by state, type,
+// label and creation time and that the result can be paginated.
+func TestQuerySessions(t *testing.T) {
+	db, err := NewDB(t.TempDir(), DBFilename)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_ = db.Close()
+	})
+
+	created := time.Unix(1672531200, 0)
+	newSession := func(label string, typ Type, age int) *Session {
+		sess, err := NewSession(
+			label, typ, created.Add(time.Hour), "foo.bar.baz:1234",
+			false, nil, nil, nil, false,
+		)
+		require.NoError(t, err)
+		sess.CreatedAt = created.Add(time.Duration(age) * time.Minute)
+
+		return sess
+	}
+
+	dashboard := newSession("Team dashboard", TypeMacaroonAdmin, 0)
+	phone := newSession("phone", TypeMacaroonReadonly, 1)
+	autopilot := newSession("Autopilot team", TypeAutopilot, 2)
+	laptop := newSession("laptop", TypeMacaroonAdmin, 3)
+
+	// The sessions are stored in a different order than they were
+	// created in.
+	for _, sess := range []*Session{laptop, phone, dashboard, autopilot} {
+		require.NoError(t, db.StoreSession(sess))
+	}
+
+	// The state index must follow state changes.
+	err = db.RevokeSession(phone.LocalPublicKey, RevocationReasonManual)
+	require.NoError(t, err)
+	_, err = db.PauseSession(laptop.LocalPublicKey)
+	require.NoError(t, err)
+
+	query := func(q *Query) []string {
+		sessions, err := db.QuerySessions(q)
+		require.NoError(t, err)
+
+		labels := make([]string, 0, len(sessions))
+		for _, sess := range sessions {
+			labels = append(labels, sess.Label)
+		}
+
+		return labels
+	}
+
+	require.Equal(t, []string{
+		"Team dashboard", "phone", "Autopilot team", "laptop",
+	}, query(&Query{}))
+
+	require.Equal(t, []string{"phone"}, query(&Query{
+		States: []State{StateRevoked},
+	}))
+
+	require.Equal(t, []string{"Team dashboard", "laptop"}, query(&Query{
+		States: []State{StateCreated, StatePaused},
+		Types:  []Type{TypeMacaroonAdmin},
+	}))
+
+	require.Empty(t, query(&Query{
+		States: []State{StateInUse},
+	}))
+
+	require.Equal(t, []string{"Team dashboard", "Autopilot team"}, query(
+		&Query{LabelContains: "team"},
+	))
+
+	require.Equal(t, []string{"phone", "Autopilot team"}, query(&Query{
+		CreatedAfter:  created.Add(time.Minute),
+		CreatedBefore: created.Add(3 * time.Minute),
+	}))
+
+	require.Equal(t, []string{"phone", "Autopilot team"}, query(&Query{
+		Offset: 1,
+		Limit:  2,
+	}))
+
+	require.Equal(t, []string{"laptop"}, query(&Query{
+		Offset: 3,
+		Limit:  2,
+	}))
+}
+
+// TestSessionIndexMigration makes sure that the sessions of a database that
+// was created before the session indexes existed are added to the indexes.
+func TestSessionIndexMigration(t *testing.T) {
+	dir := t.TempDir()
+	db, err := NewDB(dir, DBFilename)
+	require.NoError(t, err)
+
+	sess, err := NewSession(
+		"migrate", TypeMacaroonAdmin, time.Now().Add(time.Hour),
+		"foo.bar.baz:1234", false, nil, nil, nil, false,
+	)
+	require.NoError(t, err)
+	require.NoError(t, db.StoreSession(sess))
+
+	// We remove the indexes and reset the database version to simulate
+	// a database of the previous version.
+	err = db.Update(func(tx *bbolt.Tx) error {
+		err := tx.DeleteBucket(sessionIndexBucketKey)
+		if err != nil {
+			return err
+		}
+
+		metadata, err := getBucket(tx, metadataBucketKey)
+		if err != nil {
+			return err
+		}
+
+		return setDBVersion(metadata, 0)
+	})
+	require.NoError(t, err)
+	require.NoError(t, db.Close())
+
+	db, err = NewDB(dir, DBFilename)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_ = db.Close()
+	})
+
+	sessions, err := db.QuerySessions(&Query{
+		States: []State{StateCreated},
+		Types:  []Type{TypeMacaroonAdmin},
+	})
+	require.NoError(t, err)
+	require.Len(t, sessions, 1)
+	require.Equal(t, sess.ID, sessions[0].ID)
+}
+
+// newTestDBWithSession creates a new session store and stores a new admin
+// session with the given label in it.
+func newTestDBWithSession(t *testing.T, label string) (*DB, *Session) {
+	db, err := NewDB(t.TempDir(), DBFilename)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_ = db.Close()
+	})
+
+	sess, err := NewSession(
+		label, TypeMacaroonAdmin, time.Now().Add(time.Hour),
+		"foo.bar.baz:1234", false, nil, nil, nil, false,
+	)
+	require.NoError(t, err)
+	require.NoError(t, db.StoreSession(sess))
+
+	return db, sess
+}
@@ -28,6 +28,9 @@ const (
 	typeFeaturesConfig  tlv.Type = 14
 	typeWithPrivacy     tlv.Type = 15
 	typeRevokedAt       tlv.Type = 16
+	typeIdleTimeout     tlv.Type = 17
+	typeRevokeReason    tlv.Type = 18
+	typeDevices         tlv.Type = 19
 
 	// typeMacaroon is no longer used, but we leave it defined for backwards
 	// compatibility.
@@ -45,6 +48,28 @@ const (
 
 	typeFeatureName   tlv.Type = 1
 	typeFeatureConfig tlv.Type = 2
+
+	typeDeviceLabel           tlv.Type = 1
+	typeDevicePairingSecret   tlv.Type = 2
+	typeDeviceLocalPrivateKey tlv.Type = 3
+	typeDeviceRemotePublicKey tlv.Type = 4
+	typeDeviceCreatedAt       tlv.Type = 5
+	typeDeviceRevokedAt       tlv.Type = 6
+
+	typeTemplateName           tlv.Type = 1
+	typeTemplateType           tlv.Type = 2
+	typeTemplateExpiry         tlv.Type = 3
+	typeTemplateServerAddr     tlv.Type = 4
+	typeTemplateDevServer      tlv.Type = 5
+	typeTemplateMacaroonRecipe tlv.Type = 6
+	typeTemplateCreatedAt      tlv.Type = 7
+
+	typeUsageLastConnected  tlv.Type = 1
+	typeUsageNumConnections tlv.Type = 2
+	typeUsageNumCalls       tlv.Type = 3
+	typeUsageBytesReceived  tlv.Type = 4
+	typeUsageBytesSent      tlv.Type = 5
+	typeUsageLastActive     tlv.Type = 6
 )
 
 // SerializeSession binary serializes the given session to the writer using the
@@ -66,6 +91,8 @@ func SerializeSession(w io.Writer, session *Session) error {
 		createdAt     = uint64(session.CreatedAt.Unix())
 		revokedAt     uint64
 		withPrivacy   = uint8(0)
+		idleTimeout   = uint64(session.IdleTimeout)
+		revokeReason  = uint8(session.RevocationReason)
 	)
 
 	if !session.RevokedAt.IsZero() {
@@ -140,6 +167,28 @@ func SerializeSession(w io.Writer, session *Session) error {
 		tlv.MakePrimitiveRecord(typeRevokedAt, &revokedAt),
 	)
 
+	if session.IdleTimeout != 0 {
+		tlvRecords = append(tlvRecords, tlv.MakePrimitiveRecord(
+			typeIdleTimeout, &idleTimeout,
+		))
+	}
+
+	if session.RevocationReason != RevocationReasonUnknown {
+		tlvRecords = append(tlvRecords, tlv.MakePrimitiveRecord(
+			typeRevokeReason, &revokeReason,
+		))
+	}
+
+	if len(session.Devices) != 0 {
+		tlvRecords = append(tlvRecords, tlv.MakeDynamicRecord(
+			typeDevices, &session.Devices, func() uint64 {
+				return recordSize(
+					devicesEncoder, &session.Devices,
+				)
+			}, devicesEncoder, devicesDecoder,
+		))
+	}
+
 	tlvStream, err := tlv.NewStream(tlvRecords...)
 	if err != nil {
 		return err
@@ -157,6 +206,8 @@ func DeserializeSession(r io.Reader) (*Session, error) {
 		pairingSecret, privateKey      []byte
 		state, typ, devServer, privacy uint8
 		expiry, createdAt, revokedAt   uint64
+		idleTimeout                    uint64
+		revokeReason                   uint8
 		macRecipe                      MacaroonRecipe
 		featureConfig                  FeaturesConfig
 	)
@@ -186,6 +237,12 @@ func DeserializeSession(r io.Reader) (*Session, error) {
 		),
 		tlv.MakePrimitiveRecord(typeWithPrivacy, &privacy),
 		tlv.MakePrimitiveRecord(typeRevokedAt, &revokedAt),
+		tlv.MakePrimitiveRecord(typeIdleTimeout, &idleTimeout),
+		tlv.MakePrimitiveRecord(typeRevokeReason, &revokeReason),
+		tlv.MakeDynamicRecord(
+			typeDevices, &session.Devices, nil, devicesEncoder,
+			devicesDecoder,
+		),
 	)
 	if err != nil {
 		return nil, err
@@ -205,6 +262,8 @@ func DeserializeSession(r io.Reader) (*Session, error) {
 	session.ServerAddr = string(serverAddr)
 	session.DevServer = devServer == 1
 	session.WithPrivacyMapper = privacy == 1
+	session.IdleTimeout = time.Duration(idleTimeout)
+	session.RevocationReason = RevocationReason(revokeReason)
 
 	if revokedAt != 0 {
 		session.RevokedAt = time.Unix(int64(revokedAt), 0)
@@ -231,6 +290,169 @@ func DeserializeSession(r io.Reader) (*Session, error) {
 	return session, nil
 }
 
+// SerializeTemplate binary serializes the given session template to the writer
+// using the tlv format.
+func SerializeTemplate(w io.Writer, template *Template) error {
+	if template == nil {
+		return fmt.Errorf("template cannot be nil")
+	}
+
+	var (
+		name       = []byte(template.Name)
+		typ        = uint8(template.Type)
+		expiry     = uint64(template.Expiry)
+		serverAddr = []byte(template.ServerAddr)
+		devServer  = uint8(0)
+		createdAt  = uint64(template.CreatedAt.Unix())
+	)
+
+	if template.DevServer {
+		devServer = 1
+	}
+
+	tlvRecords := []tlv.Record{
+		tlv.MakePrimitiveRecord(typeTemplateName, &name),
+		tlv.MakePrimitiveRecord(typeTemplateType, &typ),
+		tlv.MakePrimitiveRecord(typeTemplateExpiry, &expiry),
+		tlv.MakePrimitiveRecord(typeTemplateServerAddr, &serverAddr),
+		tlv.MakePrimitiveRecord(typeTemplateDevServer, &devServer),
+	}
+
+	if template.MacaroonRecipe != nil {
+		tlvRecords = append(tlvRecords, tlv.MakeDynamicRecord(
+			typeTemplateMacaroonRecipe, template.MacaroonRecipe,
+			func() uint64 {
+				return recordSize(
+					macaroonRecipeEncoder,
+					template.MacaroonRecipe,
+				)
+			},
+			macaroonRecipeEncoder, macaroonRecipeDecoder,
+		))
+	}
+
+	tlvRecords = append(
+		tlvRecords,
+		tlv.MakePrimitiveRecord(typeTemplateCreatedAt, &createdAt),
+	)
+
+	tlvStream, err := tlv.NewStream(tlvRecords...)
+	if err != nil {
+		return err
+	}
+
+	return tlvStream.Encode(w)
+}
+
+// DeserializeTemplate deserializes a session template from the given reader,
+// expecting the data to be encoded in the tlv format.
+func DeserializeTemplate(r io.Reader) (*Template, error) {
+	var (
+		template          = &Template{}
+		name, serverAddr  []byte
+		typ, devServer    uint8
+		expiry, createdAt uint64
+		macRecipe         MacaroonRecipe
+	)
+	tlvStream, err := tlv.NewStream(
+		tlv.MakePrimitiveRecord(typeTemplateName, &name),
+		tlv.MakePrimitiveRecord(typeTemplateType, &typ),
+		tlv.MakePrimitiveRecord(typeTemplateExpiry, &expiry),
+		tlv.MakePrimitiveRecord(typeTemplateServerAddr, &serverAddr),
+		tlv.MakePrimitiveRecord(typeTemplateDevServer, &devServer),
+		tlv.MakeDynamicRecord(
+			typeTemplateMacaroonRecipe, &macRecipe, nil,
+			macaroonRecipeEncoder, macaroonRecipeDecoder,
+		),
+		tlv.MakePrimitiveRecord(typeTemplateCreatedAt, &createdAt),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	parsedTypes, err := tlvStream.DecodeWithParsedTypes(r)
+	if err != nil {
+		return nil, err
+	}
+
+	template.Name = string(name)
+	template.Type = Type(typ)
+	template.Expiry = time.Duration(expiry)
+	template.ServerAddr = string(serverAddr)
+	template.DevServer = devServer == 1
+	template.CreatedAt = time.Unix(int64(createdAt), 0)
+
+	if t, ok := parsedTypes[typeTemplateMacaroonRecipe]; ok && t == nil {
+		template.MacaroonRecipe = &macRecipe
+	}
+
+	return template, nil
+}
+
+// serializeUsage binary serializes the given session usage to the writer using
+// the tlv format.
+func serializeUsage(w io.Writer, usage *Usage) error {
+	var lastConnected, lastActive uint64
+	if !usage.LastConnected.IsZero() {
+		lastConnected = uint64(usage.LastConnected.Unix())
+	}
+	if !usage.LastActive.IsZero() {
+		lastActive = uint64(usage.LastActive.Unix())
+	}
+
+	tlvStream, err := tlv.NewStream(
+		tlv.MakePrimitiveRecord(typeUsageLastConnected, &lastConnected),
+		tlv.MakePrimitiveRecord(
+			typeUsageNumConnections, &usage.NumConnections,
+		),
+		tlv.MakePrimitiveRecord(typeUsageNumCalls, &usage.NumCalls),
+		tlv.MakePrimitiveRecord(
+			typeUsageBytesReceived, &usage.BytesReceived,
+		),
+		tlv.MakePrimitiveRecord(typeUsageBytesSent, &usage.BytesSent),
+		tlv.MakePrimitiveRecord(typeUsageLastActive, &lastActive),
+	)
+	if err != nil {
+		return err
+	}
+
+	return tlvStream.Encode(w)
+}
+
+// deserializeUsage deserializes a session usage from the given reader,
+// expecting the data to be encoded in the tlv format.
+func deserializeUsage(r io.Reader, usage *Usage) error {
+	var lastConnected, lastActive uint64
+	tlvStream, err := tlv.NewStream(
+		tlv.MakePrimitiveRecord(typeUsageLastConnected, &lastConnected),
+		tlv.MakePrimitiveRecord(
+			typeUsageNumConnections, &usage.NumConnections,
+		),
+		tlv.MakePrimitiveRecord(typeUsageNumCalls, &usage.NumCalls),
+		tlv.MakePrimitiveRecord(
+			typeUsageBytesReceived, &usage.BytesReceived,
+		),
+		tlv.MakePrimitiveRecord(typeUsageBytesSent, &usage.BytesSent),
+		tlv.MakePrimitiveRecord(typeUsageLastActive, &lastActive),
+	)
+	if err != nil {
+		return err
+	}
+
+	if err := tlvStream.Decode(r); err != nil {
+		return err
+	}
+
+	if lastConnected != 0 {
+		usage.LastConnected = time.Unix(int64(lastConnected), 0)
+	}
+	if lastActive != 0 {
+		usage.LastActive = time.Unix(int64(lastActive), 0)
+	}
+
+	return nil
+}
+
 func featureConfigEncoder(w io.Writer, val interface{}, buf *[8]byte) error {
 	if v, ok := val.(*FeaturesConfig); ok {
 		for n, config := range *v {
@@ -333,6 +555,171 @@ func featureConfigDecoder(r io.Reader, val interface{}, buf *[8]byte,
 	return tlv.NewTypeForEncodingErr(val, "FeaturesConfig")
 }
 
+// devicesEncoder is a custom TLV encoder for the device records of a session.
+func devicesEncoder(w io.Writer, val interface{}, buf *[8]byte) error {
+	if v, ok := val.(*[]*Device); ok {
+		for _, device := range *v {
+			var (
+				privKey       = device.LocalPrivateKey
+				label         = []byte(device.Label)
+				pairingSecret = device.PairingSecret[:]
+				privateKey    = privKey.Serialize()
+				createdAt     = uint64(device.CreatedAt.Unix())
+				revokedAt     uint64
+			)
+
+			if device.Revoked() {
+				revokedAt = uint64(device.RevokedAt.Unix())
+			}
+
+			tlvRecords := []tlv.Record{
+				tlv.MakePrimitiveRecord(
+					typeDeviceLabel, &label,
+				),
+				tlv.MakePrimitiveRecord(
+					typeDevicePairingSecret, &pairingSecret,
+				),
+				tlv.MakePrimitiveRecord(
+					typeDeviceLocalPrivateKey, &privateKey,
+				),
+			}
+
+			if device.RemotePublicKey != nil {
+				tlvRecords = append(tlvRecords,
+					tlv.MakePrimitiveRecord(
+						typeDeviceRemotePublicKey,
+						&device.RemotePublicKey,
+					),
+				)
+			}
+
+			tlvRecords = append(tlvRecords,
+				tlv.MakePrimitiveRecord(
+					typeDeviceCreatedAt, &createdAt,
+				),
+				tlv.MakePrimitiveRecord(
+					typeDeviceRevokedAt, &revokedAt,
+				),
+			)
+
+			tlvStream, err := tlv.NewStream(tlvRecords...)
+			if err != nil {
+				return err
+			}
+
+			var deviceTLVBytes bytes.Buffer
+			err = tlvStream.Encode(&deviceTLVBytes)
+			if err != nil {
+				return err
+			}
+
+			// We encode the record with a varint length followed by
+			// the _raw_ TLV bytes.
+			tlvLen := uint64(len(deviceTLVBytes.Bytes()))
+			if err := tlv.WriteVarInt(w, tlvLen, buf); err != nil {
+				return err
+			}
+
+			_, err = w.Write(deviceTLVBytes.Bytes())
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+
+	return tlv.NewTypeForEncodingErr(val, "Devices")
+}
+
+// devicesDecoder is a custom TLV decoder for the device records of a session.
+func devicesDecoder(r io.Reader, val interface{}, buf *[8]byte,
+	l uint64) error {
+
+	if v, ok := val.(*[]*Device); ok {
+		var devices []*Device
+
+		// Using this information, we'll create a new limited
+		// reader that'll return an EOF once the end has been
+		// reached so the stream stops consuming bytes.
+		innerTlvReader := io.LimitedReader{
+			R: r,
+			N: int64(l),
+		}
+
+		for {
+			// Read out the varint that encodes the size of this
+			// inner TLV record.
+			blobSize, err := tlv.ReadVarInt(&innerTlvReader, buf)
+			if err == io.EOF {
+				break
+			} else if err != nil {
+				return err
+			}
+
+			innerInnerTlvReader := io.LimitedReader{
+				R: &innerTlvReader,
+				N: int64(blobSize),
+			}
+
+			var (
+				device                    = &Device{}
+				label                     []byte
+				pairingSecret, privateKey []byte
+				createdAt, revokedAt      uint64
+			)
+			tlvStream, err := tlv.NewStream(
+				tlv.MakePrimitiveRecord(
+					typeDeviceLabel, &label,
+				),
+				tlv.MakePrimitiveRecord(
+					typeDevicePairingSecret, &pairingSecret,
+				),
+				tlv.MakePrimitiveRecord(
+					typeDeviceLocalPrivateKey, &privateKey,
+				),
+				tlv.MakePrimitiveRecord(
+					typeDeviceRemotePublicKey,
+					&device.RemotePublicKey,
+				),
+				tlv.MakePrimitiveRecord(
+					typeDeviceCreatedAt, &createdAt,
+				),
+				tlv.MakePrimitiveRecord(
+					typeDeviceRevokedAt, &revokedAt,
+				),
+			)
+			if err != nil {
+				return err
+			}
+
+			err = tlvStream.Decode(&innerInnerTlvReader)
+			if err != nil {
+				return err
+			}
+
+			device.Label = string(label)
+			copy(device.PairingSecret[:], pairingSecret)
+			device.LocalPrivateKey, device.LocalPublicKey =
+				btcec.PrivKeyFromBytes(privateKey)
+			device.CreatedAt = time.Unix(int64(createdAt), 0)
+			if revokedAt != 0 {
+				device.RevokedAt = time.Unix(
+					int64(revokedAt), 0,
+				)
+			}
+
+			devices = append(devices, device)
+		}
+
+		*v = devices
+
+		return nil
+	}
+
+	return tlv.NewTypeForDecodingErr(val, "Devices", l, l)
+}
+
 // macaroonRecipeEncoder is a custom TLV encoder for a MacaroonRecipe record.
 func macaroonRecipeEncoder(w io.Writer, val interface{}, buf *[8]byte) error {
 	if v, ok := val.(*MacaroonRecipe); ok {
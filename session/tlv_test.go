@@ -51,12 +51,15 @@ var (
 // and deserialized from and to the tlv binary format successfully.
 func TestSerializeDeserializeSession(t *testing.T) {
 	tests := []struct {
-		name          string
-		sessType      Type
-		revokedAt     time.Time
-		perms         []bakery.Op
-		caveats       []macaroon.Caveat
-		featureConfig map[string][]byte
+		name             string
+		sessType         Type
+		revokedAt        time.Time
+		revocationReason RevocationReason
+		idleTimeout      time.Duration
+		perms            []bakery.Op
+		caveats          []macaroon.Caveat
+		featureConfig    map[string][]byte
+		numDevices       int
 	}{
 		{
 			name:     "session 1",
@@ -64,6 +67,8 @@ func TestSerializeDeserializeSession(t *testing.T) {
 			revokedAt: time.Date(
 				2023, 1, 10, 10, 10, 0, 0, time.UTC,
 			),
+			revocationReason: RevocationReasonIdle,
+			idleTimeout:      time.Hour * 24 * 7,
 		},
 		{
 			name:     "session 2",
@@ -79,6 +84,11 @@ func TestSerializeDeserializeSession(t *testing.T) {
 				"AutoSomething": {4, 3, 4, 5, 6, 6},
 			},
 		},
+		{
+			name:       "session 4",
+			sessType:   TypeMacaroonAdmin,
+			numDevices: 2,
+		},
 	}
 
 	for _, test := range tests {
@@ -92,10 +102,31 @@ func TestSerializeDeserializeSession(t *testing.T) {
 			require.NoError(t, err)
 
 			session.RevokedAt = test.revokedAt
+			session.RevocationReason = test.revocationReason
+			session.IdleTimeout = test.idleTimeout
 
 			_, remotePubKey := btcec.PrivKeyFromBytes(testRootKey)
 			session.RemotePublicKey = remotePubKey
 
+			// Only the first device is paired and revoked, the
+			// others were never used.
+			for i := 0; i < test.numDevices; i++ {
+				device, err := NewDevice("device")
+				require.NoError(t, err)
+
+				device.CreatedAt = time.Unix(1672531200, 0)
+				if i == 0 {
+					device.RemotePublicKey = remotePubKey
+					device.RevokedAt = time.Unix(
+						1672617600, 0,
+					)
+				}
+
+				session.Devices = append(
+					session.Devices, device,
+				)
+			}
+
 			var buf bytes.Buffer
 			require.NoError(t, SerializeSession(&buf, session))
 
@@ -251,3 +282,48 @@ func TestSerializeDeserializeMacaroonRecipe(t *testing.T) {
 	// The two states should match.
 	require.Equal(t, recipe, recipe2)
 }
+
+// TestSerializeDeserializeTemplate makes sure that a session template can be
+// serialized and deserialized from and to the tlv binary format successfully.
+func TestSerializeDeserializeTemplate(t *testing.T) {
+	tests := []struct {
+		name     string
+		sessType Type
+		recipe   *MacaroonRecipe
+	}{
+		{
+			name:     "readonly",
+			sessType: TypeMacaroonReadonly,
+		},
+		{
+			name:     "custom",
+			sessType: TypeMacaroonCustom,
+			recipe: &MacaroonRecipe{
+				Permissions: perms,
+				Caveats:     caveats,
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			template := &Template{
+				Name:           test.name,
+				Type:           test.sessType,
+				Expiry:         time.Hour * 24 * 30,
+				ServerAddr:     "foo.bar.baz:1234",
+				DevServer:      true,
+				MacaroonRecipe: test.recipe,
+				CreatedAt:      time.Unix(1672531200, 0),
+			}
+
+			var buf bytes.Buffer
+			require.NoError(t, SerializeTemplate(&buf, template))
+
+			deserializedTemplate, err := DeserializeTemplate(&buf)
+			require.NoError(t, err)
+
+			require.Equal(t, template, deserializedTemplate)
+		})
+	}
+}
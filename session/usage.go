@@ -0,0 +1,183 @@
+package session
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/stats"
+)
+
+// usageFlushInterval is the interval in which the usage of an active session
+// is persisted.
+var usageFlushInterval = time.Minute
+
+// Usage holds the usage statistics of a session.
+type Usage struct {
+	// LastConnected is the time at which a remote app last connected to
+	// the session.
+	LastConnected time.Time
+
+	// LastActive is the time of the last traffic through the session,
+	// either a new connection or an RPC call.
+	LastActive time.Time
+
+	// NumConnections is the total number of times a remote app connected
+	// to the session.
+	NumConnections uint64
+
+	// NumCalls is the total number of RPC calls made through the session.
+	NumCalls uint64
+
+	// BytesReceived is the total number of RPC payload bytes received
+	// through the session.
+	BytesReceived uint64
+
+	// BytesSent is the total number of RPC payload bytes sent through the
+	// session.
+	BytesSent uint64
+}
+
+// isEmpty returns true if no usage at all was recorded.
+func (u *Usage) isEmpty() bool {
+	return *u == Usage{}
+}
+
+// add adds the given usage to the current usage.
+func (u *Usage) add(other *Usage) {
+	if other.LastConnected.After(u.LastConnected) {
+		u.LastConnected = other.LastConnected
+	}
+	if other.LastActive.After(u.LastActive) {
+		u.LastActive = other.LastActive
+	}
+
+	u.NumConnections += other.NumConnections
+	u.NumCalls += other.NumCalls
+	u.BytesReceived += other.BytesReceived
+	u.BytesSent += other.BytesSent
+}
+
+// usageTracker records the usage of an active session that wasn't persisted
+// yet. Its interceptors are installed on the session's server to count the RPC
+// calls and it is used as the server's gRPC stats handler to count the payload
+// bytes.
+type usageTracker struct {
+	pending Usage
+	mu      sync.Mutex
+
+	onUsage func(usage *Usage) error
+}
+
+// A compile-time check to ensure that usageTracker implements stats.Handler.
+var _ stats.Handler = (*usageTracker)(nil)
+
+// newUsageTracker creates a new usage tracker that passes the recorded usage
+// to the given callback when it is flushed.
+func newUsageTracker(onUsage func(usage *Usage) error) *usageTracker {
+	return &usageTracker{
+		onUsage: onUsage,
+	}
+}
+
+// connected records a new connection of a remote app.
+func (u *usageTracker) connected() {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+
+	now := time.Now()
+	u.pending.LastConnected = now
+	u.pending.LastActive = now
+	u.pending.NumConnections++
+}
+
+// called records a new RPC call made through the session.
+func (u *usageTracker) called() {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+
+	u.pending.LastActive = time.Now()
+	u.pending.NumCalls++
+}
+
+// unaryInterceptor is a gRPC unary server interceptor that records each call
+// made through the session.
+func (u *usageTracker) unaryInterceptor(ctx context.Context, req interface{},
+	_ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{},
+	error) {
+
+	u.called()
+
+	return handler(ctx, req)
+}
+
+// streamInterceptor is a gRPC stream server interceptor that records each call
+// made through the session. Calls that are proxied to lnd or one of the other
+// daemons are handled as streams by the session's server, so they pass through
+// this interceptor as well.
+func (u *usageTracker) streamInterceptor(srv interface{},
+	ss grpc.ServerStream, _ *grpc.StreamServerInfo,
+	handler grpc.StreamHandler) error {
+
+	u.called()
+
+	return handler(srv, ss)
+}
+
+// flush passes the usage recorded since the last flush to the callback.
+func (u *usageTracker) flush() {
+	u.mu.Lock()
+	usage := u.pending
+	u.pending = Usage{}
+	u.mu.Unlock()
+
+	if usage.isEmpty() || u.onUsage == nil {
+		return
+	}
+
+	if err := u.onUsage(&usage); err != nil {
+		log.Errorf("Unable to persist session usage: %v", err)
+	}
+}
+
+// TagRPC can attach some information to the given context.
+//
+// NOTE: This is part of the stats.Handler interface.
+func (u *usageTracker) TagRPC(ctx context.Context,
+	_ *stats.RPCTagInfo) context.Context {
+
+	return ctx
+}
+
+// HandleRPC processes the RPC stats.
+//
+// NOTE: This is part of the stats.Handler interface.
+func (u *usageTracker) HandleRPC(_ context.Context, s stats.RPCStats) {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+
+	u.pending.LastActive = time.Now()
+
+	switch s := s.(type) {
+	case *stats.InPayload:
+		u.pending.BytesReceived += uint64(s.WireLength)
+
+	case *stats.OutPayload:
+		u.pending.BytesSent += uint64(s.WireLength)
+	}
+}
+
+// TagConn can attach some information to the given context.
+//
+// NOTE: This is part of the stats.Handler interface.
+func (u *usageTracker) TagConn(ctx context.Context,
+	_ *stats.ConnTagInfo) context.Context {
+
+	return ctx
+}
+
+// HandleConn processes the Conn stats.
+//
+// NOTE: This is part of the stats.Handler interface.
+func (u *usageTracker) HandleConn(context.Context, stats.ConnStats) {}
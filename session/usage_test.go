@@ -0,0 +1,44 @@
+package session
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+)
+
+// TestUsageTrackerCalls makes sure that the interceptors of the usage tracker
+// count unary and streaming calls and that the flushed usage is reset.
+func TestUsageTrackerCalls(t *testing.T) {
+	var flushed []Usage
+	tracker := newUsageTracker(func(usage *Usage) error {
+		flushed = append(flushed, *usage)
+		return nil
+	})
+
+	_, err := tracker.unaryInterceptor(
+		context.Background(), nil, &grpc.UnaryServerInfo{},
+		func(context.Context, interface{}) (interface{}, error) {
+			return nil, nil
+		},
+	)
+	require.NoError(t, err)
+
+	err = tracker.streamInterceptor(
+		nil, nil, &grpc.StreamServerInfo{},
+		func(interface{}, grpc.ServerStream) error {
+			return nil
+		},
+	)
+	require.NoError(t, err)
+
+	tracker.flush()
+	require.Len(t, flushed, 1)
+	require.EqualValues(t, 2, flushed[0].NumCalls)
+	require.False(t, flushed[0].LastActive.IsZero())
+
+	// Without any new calls, nothing is flushed.
+	tracker.flush()
+	require.Len(t, flushed, 1)
+}
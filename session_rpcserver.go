@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"net"
 	"strings"
 	"sync"
 	"time"
@@ -19,6 +20,7 @@ import (
 	"github.com/lightninglabs/lightning-terminal/perms"
 	"github.com/lightninglabs/lightning-terminal/rules"
 	"github.com/lightninglabs/lightning-terminal/session"
+	"github.com/lightningnetwork/lnd/clock"
 	"github.com/lightningnetwork/lnd/macaroons"
 	"google.golang.org/grpc"
 	"gopkg.in/macaroon-bakery.v2/bakery"
@@ -34,6 +36,11 @@ import (
 // other special cases.
 const readOnlyAction = "***readonly***"
 
+// inactiveCheckInterval is the interval in which in-use sessions are checked
+// for exceeding their idle timeout and paused sessions for reaching their
+// expiry.
+const inactiveCheckInterval = time.Minute
+
 // sessionRpcServer is the gRPC server for the Session RPC interface.
 type sessionRpcServer struct {
 	litrpc.UnimplementedSessionsServer
@@ -44,6 +51,11 @@ type sessionRpcServer struct {
 	db            *session.DB
 	sessionServer *session.Server
 
+	// pausedSessions is the set of IDs of all paused sessions. The
+	// macaroons of these sessions are rejected until they are resumed.
+	pausedSessions map[session.ID]struct{}
+	pausedMu       sync.RWMutex
+
 	quit     chan struct{}
 	wg       sync.WaitGroup
 	stopOnce sync.Once
@@ -57,7 +69,10 @@ type sessionRpcServerConfig struct {
 	grpcOptions             []grpc.ServerOption
 	registerGrpcServers     func(server *grpc.Server)
 	superMacBaker           session.MacaroonBaker
+	superMacRootKeyDeleter  session.MacaroonRootKeyDeleter
 	firstConnectionDeadline time.Duration
+	idleTimeout             time.Duration
+	clock                   clock.Clock
 	permMgr                 *perms.Manager
 	actionsDB               *firewalldb.DB
 	autopilot               autopilotserver.Autopilot
@@ -90,16 +105,20 @@ func newSessionRPCServer(cfg *sessionRpcServerConfig) (*sessionRpcServer,
 	)
 
 	return &sessionRpcServer{
-		cfg:           cfg,
-		db:            db,
-		sessionServer: server,
-		quit:          make(chan struct{}),
+		cfg:            cfg,
+		db:             db,
+		sessionServer:  server,
+		pausedSessions: make(map[session.ID]struct{}),
+		quit:           make(chan struct{}),
 	}, nil
 }
 
 // start all the components necessary for the sessionRpcServer to start serving
 // requests. This includes resuming all non-revoked sessions.
 func (s *sessionRpcServer) start() error {
+	s.wg.Add(1)
+	go s.revokeInactiveSessions()
+
 	// Start up all previously created sessions.
 	sessions, err := s.db.ListSessions(nil)
 	if err != nil {
@@ -109,6 +128,12 @@ func (s *sessionRpcServer) start() error {
 	for _, sess := range sessions {
 		key := sess.LocalPublicKey.SerializeCompressed()
 
+		if sess.State == session.StatePaused {
+			s.setPaused(sess.ID, true)
+
+			continue
+		}
+
 		if sess.Type == session.TypeAutopilot {
 			// We only start the autopilot sessions if the autopilot
 			// client has been enabled.
@@ -151,9 +176,12 @@ func (s *sessionRpcServer) start() error {
 					"session (%x) with the client", key,
 					err)
 
+				// If the autopilot server permanently refused
+				// to activate the session, we revoke it.
+				reason := session.RevocationReasonAutopilot
 				if perm {
 					err := s.db.RevokeSession(
-						sess.LocalPublicKey,
+						sess.LocalPublicKey, reason,
 					)
 					if err != nil {
 						log.Errorf("error revoking "+
@@ -194,16 +222,128 @@ func (s *sessionRpcServer) stop() error {
 func (s *sessionRpcServer) AddSession(_ context.Context,
 	req *litrpc.AddSessionRequest) (*litrpc.AddSessionResponse, error) {
 
-	expiry := time.Unix(int64(req.ExpiryTimestampSeconds), 0)
+	var (
+		expiry     = time.Unix(int64(req.ExpiryTimestampSeconds), 0)
+		serverAddr = req.MailboxServerAddr
+		devServer  = req.DevServer
+		typ        session.Type
+		perms      []bakery.Op
+		caveats    []macaroon.Caveat
+		err        error
+	)
+	if req.Template != "" {
+		// The type and permissions are defined by the template, so
+		// they cannot be overwritten by the request. This includes an
+		// explicitly set read-only type.
+		if req.SessionType != nil ||
+			len(req.MacaroonCustomPermissions) != 0 {
+
+			return nil, fmt.Errorf("session type and custom " +
+				"permissions cannot be set when using a " +
+				"template")
+		}
+
+		template, err := s.db.GetTemplate(req.Template)
+		if err != nil {
+			return nil, fmt.Errorf("error fetching template %s: %w",
+				req.Template, err)
+		}
+
+		typ = template.Type
+		if template.MacaroonRecipe != nil {
+			perms = template.MacaroonRecipe.Permissions
+			caveats = template.MacaroonRecipe.Caveats
+		}
+
+		// Account templates can't be bound to an account, so the
+		// account caveat is added for the account of the request.
+		if typ == session.TypeMacaroonAccount {
+			perms, caveats, err = s.macaroonRecipe(
+				typ, nil, req.AccountId,
+			)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		if req.ExpiryTimestampSeconds == 0 && template.Expiry > 0 {
+			expiry = time.Now().Add(template.Expiry)
+		}
+		if serverAddr == "" {
+			serverAddr = template.ServerAddr
+		}
+		devServer = devServer || template.DevServer
+	} else {
+		// A session without an explicit type is read-only.
+		typ, err = unmarshalRPCType(req.GetSessionType())
+		if err != nil {
+			return nil, err
+		}
+
+		perms, caveats, err = s.macaroonRecipe(
+			typ, req.MacaroonCustomPermissions, req.AccountId,
+		)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	if time.Now().After(expiry) {
 		return nil, fmt.Errorf("expiry must be in the future")
 	}
 
-	typ, err := unmarshalRPCType(req.SessionType)
+	restrictions, err := unmarshalRPCRestrictions(
+		req.MacaroonRestrictions,
+	)
 	if err != nil {
 		return nil, err
 	}
 
+	// The caveats might belong to a template, so they are copied before
+	// the restrictions are added.
+	if len(restrictions) != 0 {
+		caveats = append(
+			append([]macaroon.Caveat{}, caveats...),
+			restrictions...,
+		)
+	}
+
+	sess, err := session.NewSession(
+		req.Label, typ, expiry, serverAddr, devServer, perms, caveats,
+		nil, false,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("error creating new session: %v", err)
+	}
+
+	// A session specific idle timeout overrides the global one.
+	sess.IdleTimeout = time.Duration(req.IdleTimeoutSeconds) * time.Second
+
+	if err := s.db.StoreSession(sess); err != nil {
+		return nil, fmt.Errorf("error storing session: %v", err)
+	}
+
+	if err := s.resumeSession(sess); err != nil {
+		return nil, fmt.Errorf("error starting session: %v", err)
+	}
+
+	rpcSession, err := s.marshalRPCSession(sess)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling session: %v", err)
+	}
+
+	return &litrpc.AddSessionResponse{
+		Session: rpcSession,
+	}, nil
+}
+
+// macaroonRecipe returns the permissions and caveats of the macaroon of a
+// session with the given type. The custom permissions are only used for custom
+// sessions and the account ID only for account sessions.
+func (s *sessionRpcServer) macaroonRecipe(typ session.Type,
+	customPerms []*litrpc.MacaroonPermission, accountID string) ([]bakery.Op,
+	[]macaroon.Caveat, error) {
+
 	// Store the entity-action permission pairs in a map in order to
 	// de-dup any repeat perms.
 	permissions := make(map[string]map[string]struct{})
@@ -228,9 +368,10 @@ func (s *sessionRpcServer) AddSession(_ context.Context,
 	// For account based sessions we just add the account ID caveat, the
 	// permissions are added dynamically when creating the session.
 	case session.TypeMacaroonAccount:
-		id, err := accounts.ParseAccountID(req.AccountId)
+		id, err := accounts.ParseAccountID(accountID)
 		if err != nil {
-			return nil, fmt.Errorf("invalid account ID: %v", err)
+			return nil, nil, fmt.Errorf("invalid account ID: %v",
+				err)
 		}
 
 		cav := checkers.Condition(macaroons.CondLndCustom, fmt.Sprintf(
@@ -244,13 +385,13 @@ func (s *sessionRpcServer) AddSession(_ context.Context,
 	// in the request. For the time being, the caveats list will be empty
 	// for this type.
 	case session.TypeMacaroonCustom:
-		if len(req.MacaroonCustomPermissions) == 0 {
-			return nil, fmt.Errorf("custom macaroon " +
+		if len(customPerms) == 0 {
+			return nil, nil, fmt.Errorf("custom macaroon " +
 				"permissions must be specified for the " +
 				"custom macaroon session type")
 		}
 
-		for _, op := range req.MacaroonCustomPermissions {
+		for _, op := range customPerms {
 			if op.Entity != macaroons.PermissionEntityCustomURI {
 				addPerm(op.Entity, op.Action)
 
@@ -289,8 +430,8 @@ func (s *sessionRpcServer) AddSession(_ context.Context,
 			// permissions' manager is aware of this URI.
 			_, ok := s.cfg.permMgr.URIPermissions(op.Action)
 			if !ok {
-				return nil, fmt.Errorf("URI %s is unknown to "+
-					"LiT", op.Action)
+				return nil, nil, fmt.Errorf("URI %s is "+
+					"unknown to LiT", op.Action)
 			}
 
 			addPerm(op.Entity, op.Action)
@@ -298,10 +439,10 @@ func (s *sessionRpcServer) AddSession(_ context.Context,
 
 	// No other types are currently supported.
 	default:
-		return nil, fmt.Errorf("invalid session type, only admin, " +
-			"readonly, custom and account macaroon types supported in " +
-			"LiT. Autopilot sessions must be added using " +
-			"AddAutoPilotSession method")
+		return nil, nil, fmt.Errorf("invalid session type, only " +
+			"admin, readonly, custom and account macaroon types " +
+			"supported in LiT. Autopilot sessions must be added " +
+			"using AddAutoPilotSession method")
 	}
 
 	// Collect the de-duped permissions.
@@ -315,30 +456,7 @@ func (s *sessionRpcServer) AddSession(_ context.Context,
 		}
 	}
 
-	sess, err := session.NewSession(
-		req.Label, typ, expiry, req.MailboxServerAddr, req.DevServer,
-		uniquePermissions, caveats, nil, false,
-	)
-	if err != nil {
-		return nil, fmt.Errorf("error creating new session: %v", err)
-	}
-
-	if err := s.db.StoreSession(sess); err != nil {
-		return nil, fmt.Errorf("error storing session: %v", err)
-	}
-
-	if err := s.resumeSession(sess); err != nil {
-		return nil, fmt.Errorf("error starting session: %v", err)
-	}
-
-	rpcSession, err := s.marshalRPCSession(sess)
-	if err != nil {
-		return nil, fmt.Errorf("error marshaling session: %v", err)
-	}
-
-	return &litrpc.AddSessionResponse{
-		Session: rpcSession,
-	}, nil
+	return uniquePermissions, caveats, nil
 }
 
 // resumeSession tries to start an existing session if it is not expired, not
@@ -362,13 +480,263 @@ func (s *sessionRpcServer) resumeSession(sess *session.Session) error {
 		log.Debugf("Not resuming session %x with expiry %s",
 			pubKeyBytes, sess.Expiry)
 
-		if err := s.db.RevokeSession(pubKey); err != nil {
+		err := s.db.RevokeSession(
+			pubKey, session.RevocationReasonExpired,
+		)
+		if err != nil {
 			return fmt.Errorf("error revoking session: %v", err)
 		}
 
 		return nil
 	}
 
+	authData, ok, err := s.sessionAuthData(sess)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		log.Debugf("Not resuming session %x with type %d", pubKeyBytes,
+			sess.Type)
+		return nil
+	}
+
+	var (
+		onNewStatus     func(s mailbox.ServerStatus)
+		firstConnTimout = make(chan struct{})
+	)
+
+	// If this is the first time the session is being spun up then we will
+	// kick off a timer to revoke the session after a timeout unless an
+	// initial connection is made. We identify such a session as one that
+	// we do not yet have a static remote pub key for.
+	if sess.RemotePublicKey == nil {
+		deadline := sess.CreatedAt.Add(s.cfg.firstConnectionDeadline)
+		if deadline.Before(time.Now()) {
+			log.Debugf("Deadline for session %x has already "+
+				"passed. Revoking session", pubKeyBytes)
+
+			reason := session.RevocationReasonFirstConnDeadline
+			return s.db.RevokeSession(pubKey, reason)
+		}
+
+		// Start the deadline timer.
+		deadlineDuration := time.Until(deadline)
+		deadlineTimer := time.AfterFunc(deadlineDuration, func() {
+			close(firstConnTimout)
+		})
+
+		log.Warnf("Kicking off deadline timer for first connection "+
+			"for session %x. A successful connection must be "+
+			"made in the next %s", pubKeyBytes, deadlineDuration)
+
+		var stopTimerOnce sync.Once
+		onNewStatus = func(s mailbox.ServerStatus) {
+			// We will only stop the timer if the server status
+			// indicates that the client has successfully connected.
+			if s != mailbox.ServerStatusInUse {
+				return
+			}
+
+			// Stop the deadline timer.
+			stopTimerOnce.Do(func() {
+				log.Debugf("First connection for session %x "+
+					"made in a timely manner",
+					sess.LocalPublicKey.
+						SerializeCompressed())
+
+				deadlineTimer.Stop()
+			})
+		}
+	}
+
+	onUpdate := func(sess *session.Session) error {
+		return s.db.UpdateRemotePubKey(
+			pubKey, pubKey, sess.RemotePublicKey,
+		)
+	}
+	onUsage := func(usage *session.Usage) error {
+		return s.db.AddSessionUsage(pubKey, usage)
+	}
+
+	sessionClosedSub, err := s.sessionServer.StartSession(
+		sess, authData, onUpdate, onNewStatus, onUsage,
+	)
+	if err != nil {
+		return err
+	}
+
+	s.wg.Add(1)
+	go func() {
+		defer s.wg.Done()
+
+		ticker := time.NewTimer(time.Until(sess.Expiry))
+		defer ticker.Stop()
+
+		var reason session.RevocationReason
+		select {
+		case <-s.quit:
+			return
+
+		case <-sessionClosedSub:
+			return
+
+		case <-ticker.C:
+			log.Debugf("Stopping expired session %x with "+
+				"type %d", pubKeyBytes, sess.Type)
+
+			reason = session.RevocationReasonExpired
+
+		case <-firstConnTimout:
+			log.Debugf("Deadline exceeded for first connection "+
+				"for session %x. Stopping and revoking.",
+				pubKeyBytes)
+
+			reason = session.RevocationReasonFirstConnDeadline
+		}
+
+		s.stopAndRevokeSession(pubKey, reason)
+	}()
+
+	// The devices of the session pair and connect through their own
+	// mailboxes, but they use the same macaroon as the session.
+	for _, device := range sess.ActiveDevices() {
+		err := s.startDevice(sess, device, authData)
+		if err != nil {
+			s.stopSession(pubKey)
+
+			return fmt.Errorf("error starting device %x: %w",
+				device.LocalPublicKey.SerializeCompressed(),
+				err)
+		}
+	}
+
+	return nil
+}
+
+// startDevice starts the mailbox server of the given device of a running
+// session. A device that isn't paired before the first connection deadline is
+// revoked, while the session and its other devices keep running.
+func (s *sessionRpcServer) startDevice(sess *session.Session,
+	device *session.Device, authData []byte) error {
+
+	sessionKey := sess.LocalPublicKey
+	deviceKey := device.LocalPublicKey
+	deviceKeyBytes := deviceKey.SerializeCompressed()
+
+	var (
+		onNewStatus     func(s mailbox.ServerStatus)
+		firstConnTimout = make(chan struct{})
+	)
+	if device.RemotePublicKey == nil {
+		deadline := device.CreatedAt.Add(s.cfg.firstConnectionDeadline)
+		if deadline.Before(time.Now()) {
+			log.Debugf("Deadline for device %x has already "+
+				"passed. Revoking device", deviceKeyBytes)
+
+			_, err := s.db.RevokeSessionDevice(
+				sessionKey, deviceKey,
+			)
+			return err
+		}
+
+		deadlineTimer := time.AfterFunc(time.Until(deadline), func() {
+			close(firstConnTimout)
+		})
+
+		var stopTimerOnce sync.Once
+		onNewStatus = func(s mailbox.ServerStatus) {
+			if s != mailbox.ServerStatusInUse {
+				return
+			}
+
+			stopTimerOnce.Do(func() {
+				deadlineTimer.Stop()
+			})
+		}
+	}
+
+	onUpdate := func(deviceSess *session.Session) error {
+		return s.db.UpdateRemotePubKey(
+			sessionKey, deviceKey, deviceSess.RemotePublicKey,
+		)
+	}
+	onUsage := func(usage *session.Usage) error {
+		return s.db.AddSessionUsage(sessionKey, usage)
+	}
+
+	deviceClosedSub, err := s.sessionServer.StartSession(
+		sess.DeviceSession(device), authData, onUpdate, onNewStatus,
+		onUsage,
+	)
+	if err != nil {
+		return err
+	}
+
+	s.wg.Add(1)
+	go func() {
+		defer s.wg.Done()
+
+		select {
+		case <-s.quit:
+		case <-deviceClosedSub:
+
+		case <-firstConnTimout:
+			log.Debugf("Deadline exceeded for first connection "+
+				"for device %x. Stopping and revoking.",
+				deviceKeyBytes)
+
+			s.stopAndRevokeDevice(sessionKey, deviceKey)
+		}
+	}()
+
+	return nil
+}
+
+// stopSession stops the mailbox servers of the session with the given local
+// public key and of all its active devices. The session might not be running
+// anymore, so errors are only logged.
+func (s *sessionRpcServer) stopSession(pubKey *btcec.PublicKey) {
+	keys := []*btcec.PublicKey{pubKey}
+
+	sess, err := s.db.GetSession(pubKey)
+	if err != nil {
+		log.Debugf("Error fetching devices of session: %v", err)
+	} else {
+		for _, device := range sess.ActiveDevices() {
+			keys = append(keys, device.LocalPublicKey)
+		}
+	}
+
+	for _, key := range keys {
+		if err := s.sessionServer.StopSession(key); err != nil {
+			log.Debugf("Error stopping session: %v", err)
+		}
+	}
+}
+
+// stopAndRevokeDevice stops the device with the given local public key of the
+// session with the given local public key and revokes it. Any errors are only
+// logged, as the device might not be running anymore.
+func (s *sessionRpcServer) stopAndRevokeDevice(sessionKey,
+	deviceKey *btcec.PublicKey) {
+
+	err := s.sessionServer.StopSession(deviceKey)
+	if err != nil {
+		log.Debugf("Error stopping device: %v", err)
+	}
+
+	_, err = s.db.RevokeSessionDevice(sessionKey, deviceKey)
+	if err != nil {
+		log.Debugf("Error revoking device: %v", err)
+	}
+}
+
+// sessionAuthData bakes the super macaroon of the given session and returns
+// the auth data that is sent to its remote apps. False is returned if sessions
+// of the type of the given session can't be started.
+func (s *sessionRpcServer) sessionAuthData(sess *session.Session) ([]byte,
+	bool, error) {
+
 	var (
 		caveats     []macaroon.Caveat
 		permissions []bakery.Op
@@ -384,8 +752,8 @@ func (s *sessionRpcServer) resumeSession(sess *session.Session) error {
 	// permissions are added dynamically when creating the session.
 	case session.TypeMacaroonAccount:
 		if sess.MacaroonRecipe == nil {
-			return fmt.Errorf("invalid account session, expected " +
-				"recipe to be set")
+			return nil, false, fmt.Errorf("invalid account " +
+				"session, expected recipe to be set")
 		}
 
 		caveats = sess.MacaroonRecipe.Caveats
@@ -403,9 +771,7 @@ func (s *sessionRpcServer) resumeSession(sess *session.Session) error {
 
 	// No other types are currently supported.
 	default:
-		log.Debugf("Not resuming session %x with type %d", pubKeyBytes,
-			sess.Type)
-		return nil
+		return nil, false, nil
 	}
 
 	// Add the session expiry as a macaroon caveat.
@@ -422,163 +788,594 @@ func (s *sessionRpcServer) resumeSession(sess *session.Session) error {
 		},
 	)
 	if err != nil {
-		log.Debugf("Not resuming session %x. Could not bake "+
-			"the necessary macaroon: %w", pubKeyBytes, err)
-		return nil
+		return nil, false, fmt.Errorf("could not bake the necessary "+
+			"macaroon: %w", err)
 	}
 
-	var (
-		onNewStatus     func(s mailbox.ServerStatus)
-		firstConnTimout = make(chan struct{})
-	)
+	return []byte(fmt.Sprintf("%s: %s", HeaderMacaroon, mac)), true, nil
+}
+
+// stopAndRevokeSession stops the session with the given local public key and
+// revokes it for the given reason. Any errors are only logged, as the session
+// might not be running anymore.
+func (s *sessionRpcServer) stopAndRevokeSession(pubKey *btcec.PublicKey,
+	reason session.RevocationReason) {
+
+	if s.cfg.autopilot != nil {
+		ctx := context.Background()
+		ctxc, cancel := context.WithTimeout(ctx, defaultConnectTimeout)
+
+		s.cfg.autopilot.SessionRevoked(ctxc, pubKey)
+		cancel()
+	}
+
+	s.stopSession(pubKey)
+
+	err := s.db.RevokeSession(pubKey, reason)
+	if err != nil {
+		log.Debugf("error revoking session: %v", err)
+	}
+}
+
+// revokeInactiveSessions periodically revokes all in-use sessions that didn't
+// have any traffic for longer than their idle timeout and all paused sessions
+// that expired. Paused sessions aren't running, so they aren't revoked by the
+// expiry timer of a running session.
+func (s *sessionRpcServer) revokeInactiveSessions() {
+	defer s.wg.Done()
+
+	// Sessions that were in use before LiT was started might not have
+	// recorded any traffic yet, so we give them the full idle timeout
+	// starting from now.
+	startTime := s.cfg.clock.Now()
+
+	ticker := time.NewTicker(inactiveCheckInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+		case <-s.quit:
+			return
+		}
+
+		if err := s.checkIdleSessions(startTime); err != nil {
+			log.Errorf("Error checking idle sessions: %v", err)
+		}
+
+		if err := s.checkPausedSessions(); err != nil {
+			log.Errorf("Error checking paused sessions: %v", err)
+		}
+	}
+}
+
+// checkPausedSessions revokes all paused sessions that reached their expiry.
+func (s *sessionRpcServer) checkPausedSessions() error {
+	now := s.cfg.clock.Now()
+	expired := func(sess *session.Session) bool {
+		return sess.State == session.StatePaused &&
+			!sess.Expiry.After(now)
+	}
+	sessions, err := s.db.ListSessions(expired)
+	if err != nil {
+		return fmt.Errorf("error listing sessions: %v", err)
+	}
+
+	for _, sess := range sessions {
+		log.Infof("Revoking paused session %x that expired at %v",
+			sess.LocalPublicKey.SerializeCompressed(), sess.Expiry)
+
+		reason := session.RevocationReasonExpired
+		s.stopAndRevokeSession(sess.LocalPublicKey, reason)
+		s.setPaused(sess.ID, false)
+	}
+
+	return nil
+}
+
+// checkIdleSessions revokes all in-use sessions that didn't have any traffic
+// for longer than their idle timeout. Traffic before the given start time is
+// treated as if it happened at the start time.
+func (s *sessionRpcServer) checkIdleSessions(startTime time.Time) error {
+	// Sessions stay in the created state after a remote app paired with
+	// them, so we consider all paired sessions that are not revoked or
+	// expired to be in use.
+	inUse := func(sess *session.Session) bool {
+		if sess.State == session.StateInUse {
+			return true
+		}
+
+		return sess.State == session.StateCreated &&
+			sess.RemotePublicKey != nil
+	}
+	sessions, err := s.db.ListSessions(inUse)
+	if err != nil {
+		return fmt.Errorf("error listing sessions: %v", err)
+	}
+
+	now := s.cfg.clock.Now()
+	for _, sess := range sessions {
+		idleTimeout := sess.IdleTimeout
+		if idleTimeout == 0 {
+			idleTimeout = s.cfg.idleTimeout
+		}
+		if idleTimeout == 0 {
+			continue
+		}
+
+		lastActive := sess.Usage.LastActive
+		if lastActive.Before(startTime) {
+			lastActive = startTime
+		}
+
+		if now.Sub(lastActive) < idleTimeout {
+			continue
+		}
+
+		log.Infof("Revoking session %x that was idle for more than %v",
+			sess.LocalPublicKey.SerializeCompressed(), idleTimeout)
+
+		reason := session.RevocationReasonIdle
+		s.stopAndRevokeSession(sess.LocalPublicKey, reason)
+	}
+
+	return nil
+}
+
+// ListSessions returns the sessions known to the session store that match the
+// filters of the request, in the order they were created.
+func (s *sessionRpcServer) ListSessions(_ context.Context,
+	req *litrpc.ListSessionsRequest) (*litrpc.ListSessionsResponse, error) {
+
+	query := &session.Query{
+		LabelContains: req.LabelContains,
+		Offset:        req.Offset,
+		Limit:         req.Limit,
+	}
+	for _, rpcState := range req.States {
+		state, err := unmarshalRPCState(rpcState)
+		if err != nil {
+			return nil, err
+		}
+		query.States = append(query.States, state)
+	}
+	for _, rpcType := range req.Types {
+		typ, err := unmarshalRPCType(rpcType)
+		if err != nil {
+			return nil, err
+		}
+		query.Types = append(query.Types, typ)
+	}
+	if req.CreatedAfter != 0 {
+		query.CreatedAfter = time.Unix(int64(req.CreatedAfter), 0)
+	}
+	if req.CreatedBefore != 0 {
+		query.CreatedBefore = time.Unix(int64(req.CreatedBefore), 0)
+	}
+
+	sessions, err := s.db.QuerySessions(query)
+	if err != nil {
+		return nil, fmt.Errorf("error fetching sessions: %v", err)
+	}
+
+	response := &litrpc.ListSessionsResponse{
+		Sessions: make([]*litrpc.Session, len(sessions)),
+	}
+	for idx, sess := range sessions {
+		response.Sessions[idx], err = s.marshalRPCSession(sess)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling session: %v",
+				err)
+		}
+	}
+
+	return response, nil
+}
+
+// RevokeSession revokes a single session and also stops it if it is currently
+// active.
+func (s *sessionRpcServer) RevokeSession(ctx context.Context,
+	req *litrpc.RevokeSessionRequest) (*litrpc.RevokeSessionResponse, error) {
+
+	pubKey, err := btcec.ParsePubKey(req.LocalPublicKey)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing public key: %v", err)
+	}
+
+	sess, err := s.db.GetSession(pubKey)
+	if err != nil {
+		return nil, fmt.Errorf("error fetching session: %v", err)
+	}
+
+	err = s.db.RevokeSession(pubKey, session.RevocationReasonManual)
+	if err != nil {
+		return nil, fmt.Errorf("error revoking session: %v", err)
+	}
+
+	if s.cfg.autopilot != nil {
+		s.cfg.autopilot.SessionRevoked(ctx, pubKey)
+	}
+
+	// If the session expired already it might not be running anymore, so
+	// possible errors are only logged.
+	s.stopSession(pubKey)
+
+	// A revoked session can't be resumed anymore, so we don't need to
+	// keep track of it if it was paused.
+	if sess.State == session.StatePaused {
+		s.setPaused(sess.ID, false)
+	}
+
+	return &litrpc.RevokeSessionResponse{}, nil
+}
+
+// PauseSession pauses an active session by stopping its mailbox connection.
+// The macaroon of a paused session is rejected until the session is resumed.
+// The session keeps its keys and ID, so it can be resumed without pairing
+// again and its firewall history is kept.
+func (s *sessionRpcServer) PauseSession(_ context.Context,
+	req *litrpc.PauseSessionRequest) (*litrpc.PauseSessionResponse, error) {
+
+	pubKey, err := btcec.ParsePubKey(req.LocalPublicKey)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing public key: %v", err)
+	}
+
+	sess, err := s.db.PauseSession(pubKey)
+	if err != nil {
+		return nil, fmt.Errorf("error pausing session: %v", err)
+	}
+	s.setPaused(sess.ID, true)
+
+	// The session might not be running if it was never resumed, so
+	// possible errors are only logged.
+	s.stopSession(pubKey)
+
+	rpcSession, err := s.marshalRPCSession(sess)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling session: %v", err)
+	}
+
+	return &litrpc.PauseSessionResponse{
+		Session: rpcSession,
+	}, nil
+}
+
+// ResumeSession resumes a paused session by starting its mailbox connection
+// again.
+func (s *sessionRpcServer) ResumeSession(_ context.Context,
+	req *litrpc.ResumeSessionRequest) (*litrpc.ResumeSessionResponse,
+	error) {
+
+	pubKey, err := btcec.ParsePubKey(req.LocalPublicKey)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing public key: %v", err)
+	}
+
+	sess, err := s.db.ResumeSession(pubKey)
+	if err != nil {
+		return nil, fmt.Errorf("error resuming session: %v", err)
+	}
+	s.setPaused(sess.ID, false)
+
+	// A session that expired while it was paused is revoked instead.
+	if !sess.Expiry.After(s.cfg.clock.Now()) {
+		s.stopAndRevokeSession(pubKey, session.RevocationReasonExpired)
+
+		return nil, fmt.Errorf("session expired at %v", sess.Expiry)
+	}
+
+	if err := s.resumeSession(sess); err != nil {
+		return nil, fmt.Errorf("error starting session: %v", err)
+	}
+
+	rpcSession, err := s.marshalRPCSession(sess)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling session: %v", err)
+	}
+
+	return &litrpc.ResumeSessionResponse{
+		Session: rpcSession,
+	}, nil
+}
+
+// AddSessionDevice adds a device to an existing session and starts it if the
+// session is running. The device gets its own pairing secret and local key pair
+// but shares the macaroon and firewall rules of the session.
+func (s *sessionRpcServer) AddSessionDevice(_ context.Context,
+	req *litrpc.AddSessionDeviceRequest) (*litrpc.AddSessionDeviceResponse,
+	error) {
+
+	pubKey, err := btcec.ParsePubKey(req.LocalPublicKey)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing public key: %v", err)
+	}
+
+	sess, err := s.db.GetSession(pubKey)
+	if err != nil {
+		return nil, fmt.Errorf("error fetching session: %v", err)
+	}
+
+	// Autopilot sessions are paired with the autopilot server and UI
+	// password sessions don't use a mailbox, so neither can have devices.
+	if sess.Type == session.TypeAutopilot ||
+		sess.Type == session.TypeUIPassword {
+
+		return nil, fmt.Errorf("cannot add devices to sessions of "+
+			"type %d", sess.Type)
+	}
+
+	device, err := session.NewDevice(req.Label)
+	if err != nil {
+		return nil, fmt.Errorf("error creating device: %v", err)
+	}
+
+	sess, err = s.db.AddSessionDevice(pubKey, device)
+	if err != nil {
+		return nil, fmt.Errorf("error adding device: %v", err)
+	}
+
+	// The devices of a paused session are started once it is resumed.
+	if sess.State == session.StateCreated ||
+		sess.State == session.StateInUse {
+
+		authData, ok, err := s.sessionAuthData(sess)
+		if err != nil {
+			return nil, err
+		}
+
+		if ok {
+			err := s.startDevice(sess, device, authData)
+			if err != nil {
+				return nil, fmt.Errorf("error starting "+
+					"device: %v", err)
+			}
+		}
+	}
+
+	rpcSession, err := s.marshalRPCSession(sess)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling session: %v", err)
+	}
+
+	return &litrpc.AddSessionDeviceResponse{
+		Session: rpcSession,
+	}, nil
+}
+
+// RevokeSessionDevice revokes a single device of a session and stops it if it
+// is currently active. The session and its other devices stay usable.
+func (s *sessionRpcServer) RevokeSessionDevice(_ context.Context,
+	req *litrpc.RevokeSessionDeviceRequest) (
+	*litrpc.RevokeSessionDeviceResponse, error) {
+
+	pubKey, err := btcec.ParsePubKey(req.LocalPublicKey)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing public key: %v", err)
+	}
+
+	deviceKey, err := btcec.ParsePubKey(req.DevicePublicKey)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing device public key: %v",
+			err)
+	}
+
+	sess, err := s.db.RevokeSessionDevice(pubKey, deviceKey)
+	if err != nil {
+		return nil, fmt.Errorf("error revoking device: %v", err)
+	}
+
+	// The device might not be running if its session is paused, so
+	// possible errors are only logged.
+	if err := s.sessionServer.StopSession(deviceKey); err != nil {
+		log.Debugf("Error stopping device: %v", err)
+	}
+
+	rpcSession, err := s.marshalRPCSession(sess)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling session: %v", err)
+	}
+
+	return &litrpc.RevokeSessionDeviceResponse{
+		Session: rpcSession,
+	}, nil
+}
+
+// setPaused adds the session with the given ID to or removes it from the set
+// of paused sessions.
+func (s *sessionRpcServer) setPaused(id session.ID, paused bool) {
+	s.pausedMu.Lock()
+	defer s.pausedMu.Unlock()
 
-	// If this is the first time the session is being spun up then we will
-	// kick off a timer to revoke the session after a timeout unless an
-	// initial connection is made. We identify such a session as one that
-	// we do not yet have a static remote pub key for.
-	if sess.RemotePublicKey == nil {
-		deadline := sess.CreatedAt.Add(s.cfg.firstConnectionDeadline)
-		if deadline.Before(time.Now()) {
-			log.Debugf("Deadline for session %x has already "+
-				"passed. Revoking session", pubKeyBytes)
+	if paused {
+		s.pausedSessions[id] = struct{}{}
+	} else {
+		delete(s.pausedSessions, id)
+	}
+}
 
-			return s.db.RevokeSession(pubKey)
-		}
+// isPaused returns true if the session with the given ID is paused.
+func (s *sessionRpcServer) isPaused(id session.ID) bool {
+	s.pausedMu.RLock()
+	defer s.pausedMu.RUnlock()
 
-		// Start the deadline timer.
-		deadlineDuration := time.Until(deadline)
-		deadlineTimer := time.AfterFunc(deadlineDuration, func() {
-			close(firstConnTimout)
-		})
+	_, ok := s.pausedSessions[id]
+	return ok
+}
 
-		log.Warnf("Kicking off deadline timer for first connection "+
-			"for session %x. A successful connection must be "+
-			"made in the next %s", pubKeyBytes, deadlineDuration)
+// RotateSession issues a new macaroon for an active session and sets its new
+// expiry. The old macaroon of the session is invalidated while the paired
+// remote public key is kept, so the connected app doesn't need to pair again
+// and receives the new macaroon when it reconnects.
+func (s *sessionRpcServer) RotateSession(ctx context.Context,
+	req *litrpc.RotateSessionRequest) (*litrpc.RotateSessionResponse,
+	error) {
 
-		var stopTimerOnce sync.Once
-		onNewStatus = func(s mailbox.ServerStatus) {
-			// We will only stop the timer if the server status
-			// indicates that the client has successfully connected.
-			if s != mailbox.ServerStatusInUse {
-				return
-			}
+	pubKey, err := btcec.ParsePubKey(req.LocalPublicKey)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing public key: %v", err)
+	}
 
-			// Stop the deadline timer.
-			stopTimerOnce.Do(func() {
-				log.Debugf("First connection for session %x "+
-					"made in a timely manner",
-					sess.LocalPublicKey.
-						SerializeCompressed())
+	expiry := time.Unix(int64(req.ExpiryTimestampSeconds), 0)
+	if time.Now().After(expiry) {
+		return nil, fmt.Errorf("expiry must be in the future")
+	}
 
-				deadlineTimer.Stop()
-			})
-		}
+	oldSess, err := s.db.GetSession(pubKey)
+	if err != nil {
+		return nil, fmt.Errorf("error fetching session: %v", err)
 	}
 
-	authData := []byte(fmt.Sprintf("%s: %s", HeaderMacaroon, mac))
-	sessionClosedSub, err := s.sessionServer.StartSession(
-		sess, authData, s.db.StoreSession, onNewStatus,
-	)
+	// The new macaroon is baked with a new root key ID that still maps to
+	// the same session ID, so the session keeps its firewall history.
+	rootKeyID := session.NextSuperMacaroonRootKeyID(oldSess.MacaroonRootKey)
+	sess, err := s.db.RotateSession(pubKey, expiry, rootKeyID)
 	if err != nil {
-		return err
+		return nil, fmt.Errorf("error rotating session: %v", err)
 	}
 
-	s.wg.Add(1)
-	go func() {
-		defer s.wg.Done()
+	// The session might not be running if it was never resumed, so
+	// possible errors are only logged.
+	s.stopSession(pubKey)
 
-		ticker := time.NewTimer(time.Until(sess.Expiry))
-		defer ticker.Stop()
+	if err := s.resumeSession(sess); err != nil {
+		s.rollbackRotation(ctx, oldSess, rootKeyID)
 
-		select {
-		case <-s.quit:
-			return
+		return nil, fmt.Errorf("error starting session: %v", err)
+	}
 
-		case <-sessionClosedSub:
-			return
+	// Deleting the old root key invalidates all macaroons that were baked
+	// with it. We only do this once the session runs with its new
+	// macaroon, so a failed rotation leaves the old macaroon usable.
+	err = s.cfg.superMacRootKeyDeleter(ctx, oldSess.MacaroonRootKey)
+	if err != nil {
+		return nil, fmt.Errorf("session rotated but error deleting old "+
+			"macaroon root key: %v", err)
+	}
 
-		case <-ticker.C:
-			log.Debugf("Stopping expired session %x with "+
-				"type %d", pubKeyBytes, sess.Type)
+	rpcSession, err := s.marshalRPCSession(sess)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling session: %v", err)
+	}
 
-		case <-firstConnTimout:
-			log.Debugf("Deadline exceeded for first connection "+
-				"for session %x. Stopping and revoking.",
-				pubKeyBytes)
-		}
+	return &litrpc.RotateSessionResponse{
+		Session: rpcSession,
+	}, nil
+}
 
-		if s.cfg.autopilot != nil {
-			ctx := context.Background()
-			ctxc, cancel := context.WithTimeout(
-				ctx, defaultConnectTimeout,
-			)
+// rollbackRotation restores the expiry and macaroon root key ID the given
+// session had before a failed rotation and starts it again with its old
+// macaroon. Errors are only logged, as the caller returns the rotation error.
+func (s *sessionRpcServer) rollbackRotation(ctx context.Context,
+	oldSess *session.Session, rootKeyID uint64) {
 
-			s.cfg.autopilot.SessionRevoked(ctxc, pubKey)
-			cancel()
-		}
+	pubKey := oldSess.LocalPublicKey
 
-		err = s.sessionServer.StopSession(pubKey)
-		if err != nil {
-			log.Debugf("Error stopping session: %v", err)
-		}
+	// lnd might already have created the new root key when baking the
+	// new macaroon.
+	if err := s.cfg.superMacRootKeyDeleter(ctx, rootKeyID); err != nil {
+		log.Errorf("Error deleting new macaroon root key of session "+
+			"%x: %v", oldSess.ID[:], err)
+	}
 
-		err = s.db.RevokeSession(pubKey)
-		if err != nil {
-			log.Debugf("error revoking session: %v", err)
-		}
-	}()
+	sess, err := s.db.RotateSession(
+		pubKey, oldSess.Expiry, oldSess.MacaroonRootKey,
+	)
+	if err != nil {
+		log.Errorf("Error restoring session %x after failed rotation: "+
+			"%v", oldSess.ID[:], err)
 
-	return nil
+		return
+	}
+
+	if err := s.resumeSession(sess); err != nil {
+		log.Errorf("Error restarting session %x after failed "+
+			"rotation: %v", oldSess.ID[:], err)
+	}
 }
 
-// ListSessions returns all sessions known to the session store.
-func (s *sessionRpcServer) ListSessions(_ context.Context,
-	_ *litrpc.ListSessionsRequest) (*litrpc.ListSessionsResponse, error) {
+// AddSessionTemplate adds a named session template that can be used to create
+// new sessions. An existing template with the same name is replaced.
+func (s *sessionRpcServer) AddSessionTemplate(_ context.Context,
+	req *litrpc.AddSessionTemplateRequest) (
+	*litrpc.AddSessionTemplateResponse, error) {
 
-	sessions, err := s.db.ListSessions(nil)
+	if req.Name == "" {
+		return nil, fmt.Errorf("template name must be set")
+	}
+
+	typ, err := unmarshalRPCType(req.SessionType)
 	if err != nil {
-		return nil, fmt.Errorf("error fetching sessions: %v", err)
+		return nil, err
 	}
 
-	response := &litrpc.ListSessionsResponse{
-		Sessions: make([]*litrpc.Session, len(sessions)),
+	template := &session.Template{
+		Name:       req.Name,
+		Type:       typ,
+		Expiry:     time.Duration(req.ExpirySeconds) * time.Second,
+		ServerAddr: req.MailboxServerAddr,
+		DevServer:  req.DevServer,
+		CreatedAt:  time.Now(),
 	}
-	for idx, sess := range sessions {
-		response.Sessions[idx], err = s.marshalRPCSession(sess)
+
+	// The account of an account session is only known when the session
+	// is created, so the template doesn't contain a recipe for them.
+	if typ != session.TypeMacaroonAccount {
+		perms, caveats, err := s.macaroonRecipe(
+			typ, req.MacaroonCustomPermissions, "",
+		)
 		if err != nil {
-			return nil, fmt.Errorf("error marshaling session: %v",
-				err)
+			return nil, err
 		}
-	}
 
-	return response, nil
-}
+		if perms != nil || caveats != nil {
+			template.MacaroonRecipe = &session.MacaroonRecipe{
+				Permissions: perms,
+				Caveats:     caveats,
+			}
+		}
+	} else if len(req.MacaroonCustomPermissions) != 0 {
+		return nil, fmt.Errorf("custom permissions can only be set " +
+			"for the custom macaroon session type")
+	}
 
-// RevokeSession revokes a single session and also stops it if it is currently
-// active.
-func (s *sessionRpcServer) RevokeSession(ctx context.Context,
-	req *litrpc.RevokeSessionRequest) (*litrpc.RevokeSessionResponse, error) {
+	if err := s.db.StoreTemplate(template); err != nil {
+		return nil, fmt.Errorf("error storing template: %v", err)
+	}
 
-	pubKey, err := btcec.ParsePubKey(req.LocalPublicKey)
+	rpcTemplate, err := marshalRPCTemplate(template)
 	if err != nil {
-		return nil, fmt.Errorf("error parsing public key: %v", err)
+		return nil, fmt.Errorf("error marshaling template: %v", err)
 	}
 
-	if err := s.db.RevokeSession(pubKey); err != nil {
-		return nil, fmt.Errorf("error revoking session: %v", err)
-	}
+	return &litrpc.AddSessionTemplateResponse{
+		Template: rpcTemplate,
+	}, nil
+}
 
-	if s.cfg.autopilot != nil {
-		s.cfg.autopilot.SessionRevoked(ctx, pubKey)
+// ListSessionTemplates returns all session templates known to the session
+// store.
+func (s *sessionRpcServer) ListSessionTemplates(_ context.Context,
+	_ *litrpc.ListSessionTemplatesRequest) (
+	*litrpc.ListSessionTemplatesResponse, error) {
+
+	templates, err := s.db.ListTemplates()
+	if err != nil {
+		return nil, fmt.Errorf("error fetching templates: %v", err)
 	}
 
-	// If the session expired already it might not be running anymore. So we
-	// only log possible errors here.
-	if err := s.sessionServer.StopSession(pubKey); err != nil {
-		log.Debugf("Error stopping session: %v", err)
+	response := &litrpc.ListSessionTemplatesResponse{
+		Templates: make([]*litrpc.SessionTemplate, len(templates)),
+	}
+	for idx, template := range templates {
+		response.Templates[idx], err = marshalRPCTemplate(template)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling template: %v",
+				err)
+		}
 	}
 
-	return &litrpc.RevokeSessionResponse{}, nil
+	return response, nil
 }
 
 // PrivacyMapConversion can be used map real values to their pseudo counterpart
@@ -757,6 +1554,72 @@ func (s *sessionRpcServer) ListActions(_ context.Context,
 	}, nil
 }
 
+// ListPendingApprovals returns the requests that were parked by an
+// approval-required rule and are waiting for the operator to approve or deny
+// them.
+func (s *sessionRpcServer) ListPendingApprovals(_ context.Context,
+	req *litrpc.ListPendingApprovalsRequest) (
+	*litrpc.ListPendingApprovalsResponse, error) {
+
+	var (
+		filterSession bool
+		sessionID     session.ID
+		err           error
+	)
+	if req.SessionId != nil {
+		sessionID, err = session.IDFromBytes(req.SessionId)
+		if err != nil {
+			return nil, err
+		}
+		filterSession = true
+	}
+
+	approvals, err := s.cfg.actionsDB.ListApprovals(
+		firewalldb.ApprovalStatePending,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := make([]*litrpc.PendingApproval, 0, len(approvals))
+	for _, a := range approvals {
+		if filterSession && a.SessionID != sessionID {
+			continue
+		}
+
+		resp = append(resp, &litrpc.PendingApproval{
+			Id:            a.ID,
+			SessionId:     a.SessionID[:],
+			FeatureName:   a.FeatureName,
+			RpcMethod:     a.RPCMethod,
+			RpcParamsJson: string(a.RPCParamsJson),
+			Reason:        a.Reason,
+			CreatedAt:     uint64(a.CreatedAt.Unix()),
+		})
+	}
+
+	return &litrpc.ListPendingApprovalsResponse{
+		Approvals: resp,
+	}, nil
+}
+
+// ResolveApproval approves or denies a pending request. An approved request is
+// let through once it is retried, a denied one is rejected.
+func (s *sessionRpcServer) ResolveApproval(_ context.Context,
+	req *litrpc.ResolveApprovalRequest) (*litrpc.ResolveApprovalResponse,
+	error) {
+
+	err := s.cfg.actionsDB.ResolveApproval(req.Id, req.Approve)
+	if errors.Is(err, firewalldb.ErrNoSuchKeyFound) {
+		return nil, fmt.Errorf("no pending approval with ID %d",
+			req.Id)
+	} else if err != nil {
+		return nil, err
+	}
+
+	return &litrpc.ResolveApprovalResponse{}, nil
+}
+
 // ListAutopilotFeatures fetches all the features supported by the autopilot
 // server along with the rules that we need to support in order to subscribe
 // to those features.
@@ -987,6 +1850,9 @@ func (s *sessionRpcServer) AddAutopilotSession(ctx context.Context,
 		return nil, fmt.Errorf("error creating new session: %v", err)
 	}
 
+	// A session specific idle timeout overrides the global one.
+	sess.IdleTimeout = time.Duration(req.IdleTimeoutSeconds) * time.Second
+
 	// Register all the privacy map pairs for this session ID.
 	privDB := s.cfg.privMap(sess.ID)
 	err = privDB.Update(func(tx firewalldb.PrivacyMapTx) error {
@@ -1206,6 +2072,31 @@ func (s *sessionRpcServer) marshalRPCSession(sess *session.Session) (
 		revokedAt = uint64(sess.RevokedAt.Unix())
 	}
 
+	var lastConnectedAt uint64
+	if !sess.Usage.LastConnected.IsZero() {
+		lastConnectedAt = uint64(sess.Usage.LastConnected.Unix())
+	}
+
+	var lastActiveAt uint64
+	if !sess.Usage.LastActive.IsZero() {
+		lastActiveAt = uint64(sess.Usage.LastActive.Unix())
+	}
+
+	revocationReason, err := marshalRPCRevocationReason(
+		sess.RevocationReason,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	devices := make([]*litrpc.SessionDevice, len(sess.Devices))
+	for i, device := range sess.Devices {
+		devices[i], err = marshalRPCDevice(device)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	featureInfo := make(map[string]*litrpc.RulesMap)
 	if sess.MacaroonRecipe != nil {
 		for _, cav := range sess.MacaroonRecipe.Caveats {
@@ -1256,6 +2147,47 @@ func (s *sessionRpcServer) marshalRPCSession(sess *session.Session) (
 		RevokedAt:              revokedAt,
 		MacaroonRecipe:         macRecipe,
 		AutopilotFeatureInfo:   featureInfo,
+		LastConnectedAt:        lastConnectedAt,
+		NumConnections:         sess.Usage.NumConnections,
+		NumCalls:               sess.Usage.NumCalls,
+		BytesReceived:          sess.Usage.BytesReceived,
+		BytesSent:              sess.Usage.BytesSent,
+		LastActiveAt:           lastActiveAt,
+		IdleTimeoutSeconds:     uint64(sess.IdleTimeout.Seconds()),
+		RevocationReason:       revocationReason,
+		Devices:                devices,
+	}, nil
+}
+
+// marshalRPCDevice converts a session device into its RPC counterpart.
+func marshalRPCDevice(device *session.Device) (*litrpc.SessionDevice, error) {
+	mnemonic, err := mailbox.PassphraseEntropyToMnemonic(
+		device.PairingSecret,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	var remotePubKey []byte
+	if device.RemotePublicKey != nil {
+		remotePubKey = device.RemotePublicKey.SerializeCompressed()
+	}
+
+	var revokedAt uint64
+	if device.Revoked() {
+		revokedAt = uint64(device.RevokedAt.Unix())
+	}
+
+	localPubKey := device.LocalPublicKey.SerializeCompressed()
+
+	return &litrpc.SessionDevice{
+		Label:                 device.Label,
+		PairingSecret:         device.PairingSecret[:],
+		PairingSecretMnemonic: strings.Join(mnemonic[:], " "),
+		LocalPublicKey:        localPubKey,
+		RemotePublicKey:       remotePubKey,
+		CreatedAt:             uint64(device.CreatedAt.Unix()),
+		RevokedAt:             revokedAt,
 	}, nil
 }
 
@@ -1287,6 +2219,28 @@ func marshalRPCMacaroonRecipe(
 	}
 }
 
+// marshalRPCTemplate converts a session template to its RPC counterpart.
+func marshalRPCTemplate(template *session.Template) (*litrpc.SessionTemplate,
+	error) {
+
+	typ, err := marshalRPCType(template.Type)
+	if err != nil {
+		return nil, err
+	}
+
+	return &litrpc.SessionTemplate{
+		Name:              template.Name,
+		SessionType:       typ,
+		ExpirySeconds:     uint64(template.Expiry.Seconds()),
+		MailboxServerAddr: template.ServerAddr,
+		DevServer:         template.DevServer,
+		MacaroonRecipe: marshalRPCMacaroonRecipe(
+			template.MacaroonRecipe,
+		),
+		CreatedAt: uint64(template.CreatedAt.Unix()),
+	}, nil
+}
+
 // marshalRPCState converts a session state to its RPC counterpart.
 func marshalRPCState(state session.State) (litrpc.SessionState, error) {
 	switch state {
@@ -1302,11 +2256,67 @@ func marshalRPCState(state session.State) (litrpc.SessionState, error) {
 	case session.StateExpired:
 		return litrpc.SessionState_STATE_EXPIRED, nil
 
+	case session.StatePaused:
+		return litrpc.SessionState_STATE_PAUSED, nil
+
+	default:
+		return 0, fmt.Errorf("unknown state <%d>", state)
+	}
+}
+
+// unmarshalRPCState converts an RPC session state to its session counterpart.
+func unmarshalRPCState(state litrpc.SessionState) (session.State, error) {
+	switch state {
+	case litrpc.SessionState_STATE_CREATED:
+		return session.StateCreated, nil
+
+	case litrpc.SessionState_STATE_IN_USE:
+		return session.StateInUse, nil
+
+	case litrpc.SessionState_STATE_REVOKED:
+		return session.StateRevoked, nil
+
+	case litrpc.SessionState_STATE_EXPIRED:
+		return session.StateExpired, nil
+
+	case litrpc.SessionState_STATE_PAUSED:
+		return session.StatePaused, nil
+
 	default:
 		return 0, fmt.Errorf("unknown state <%d>", state)
 	}
 }
 
+// marshalRPCRevocationReason converts a session revocation reason to its RPC
+// counterpart.
+func marshalRPCRevocationReason(reason session.RevocationReason) (
+	litrpc.RevocationReason, error) {
+
+	switch reason {
+	case session.RevocationReasonUnknown:
+		return litrpc.RevocationReason_REVOCATION_REASON_UNKNOWN, nil
+
+	case session.RevocationReasonManual:
+		return litrpc.RevocationReason_REVOCATION_REASON_MANUAL, nil
+
+	case session.RevocationReasonExpired:
+		return litrpc.RevocationReason_REVOCATION_REASON_EXPIRED, nil
+
+	case session.RevocationReasonFirstConnDeadline:
+		return litrpc.RevocationReason_REVOCATION_REASON_FIRST_CONN_DEADLINE,
+			nil
+
+	case session.RevocationReasonIdle:
+		return litrpc.RevocationReason_REVOCATION_REASON_IDLE, nil
+
+	case session.RevocationReasonAutopilot:
+		return litrpc.RevocationReason_REVOCATION_REASON_AUTOPILOT, nil
+
+	default:
+		return 0, fmt.Errorf("unknown revocation reason <%d>", reason)
+	}
+}
+
 // marshalRPCType converts a session type to its RPC counterpart.
 func marshalRPCType(typ session.Type) (litrpc.SessionType, error) {
 	switch typ {
@@ -1359,6 +2369,53 @@ func unmarshalRPCType(typ litrpc.SessionType) (session.Type, error) {
 	}
 }
 
+// unmarshalRPCRestrictions converts RPC macaroon restrictions into the caveats
+// that enforce them.
+func unmarshalRPCRestrictions(
+	restrictions *litrpc.MacaroonRestrictions) ([]macaroon.Caveat, error) {
+
+	if restrictions == nil {
+		return nil, nil
+	}
+
+	builder := session.NewCaveatBuilder()
+	if len(restrictions.TimeWindows) != 0 {
+		windows := make(
+			[]session.TimeWindow, len(restrictions.TimeWindows),
+		)
+		for idx, w := range restrictions.TimeWindows {
+			window, err := session.ParseTimeWindow(
+				w.Start, w.End, w.Timezone,
+			)
+			if err != nil {
+				return nil, fmt.Errorf("invalid time "+
+					"window: %w", err)
+			}
+			windows[idx] = window
+		}
+		builder.TimeWindows(windows...)
+	}
+
+	if len(restrictions.AllowedIps) != 0 {
+		ipNets := make([]*net.IPNet, len(restrictions.AllowedIps))
+		for idx, ip := range restrictions.AllowedIps {
+			ipNet, err := session.ParseIPNet(ip)
+			if err != nil {
+				return nil, fmt.Errorf("invalid allowed IP: %w",
+					err)
+			}
+			ipNets[idx] = ipNet
+		}
+		builder.AllowedIPs(ipNets...)
+	}
+
+	if restrictions.MaxCalls != 0 {
+		builder.MaxCalls(restrictions.MaxCalls)
+	}
+
+	return builder.Caveats()
+}
+
 // marshalActionState converts an Action state into its RPC counterpart.
 func marshalActionState(state firewalldb.ActionState) (litrpc.ActionState,
 	error) {
@@ -0,0 +1,388 @@
+package terminal
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/lightninglabs/lightning-terminal/litrpc"
+	"github.com/lightninglabs/lightning-terminal/session"
+	"github.com/lightningnetwork/lnd/clock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"gopkg.in/macaroon-bakery.v2/bakery"
+)
+
+// mockRootKeyStore is a mock of lnd's macaroon root key store that records
+// the root key IDs that super macaroons are baked with and the root key IDs
+// that are deleted.
+type mockRootKeyStore struct {
+	baked   []uint64
+	deleted []uint64
+	bakeErr error
+}
+
+// bake is a session.MacaroonBaker that records the root key ID.
+func (m *mockRootKeyStore) bake(_ context.Context, rootKeyID uint64,
+	_ *session.MacaroonRecipe) (string, error) {
+
+	if m.bakeErr != nil {
+		return "", m.bakeErr
+	}
+
+	m.baked = append(m.baked, rootKeyID)
+
+	return "macaroon", nil
+}
+
+// delete is a session.MacaroonRootKeyDeleter that records the root key ID.
+func (m *mockRootKeyStore) delete(_ context.Context, rootKeyID uint64) error {
+	m.deleted = append(m.deleted, rootKeyID)
+
+	return nil
+}
+
+// newTestSessionRPCServer creates a session RPC server with an empty session
+// store that uses the given root key store for its macaroons.
+func newTestSessionRPCServer(t *testing.T,
+	rootKeys *mockRootKeyStore) *sessionRpcServer {
+
+	server, err := newSessionRPCServer(&sessionRpcServerConfig{
+		dbDir:                   t.TempDir(),
+		registerGrpcServers:     func(*grpc.Server) {},
+		superMacBaker:           rootKeys.bake,
+		superMacRootKeyDeleter:  rootKeys.delete,
+		firstConnectionDeadline: time.Hour,
+		clock:                   clock.NewDefaultClock(),
+	})
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_ = server.stop()
+	})
+
+	return server
+}
+
+// TestRotateSession makes sure that a rotated session is started with a
+// macaroon that is baked with a new root key, that the old root key is only
+// deleted once the session runs with its new macaroon and that a failed
+// rotation is rolled back.
+func TestRotateSession(t *testing.T) {
+	ctx := context.Background()
+	rootKeys := &mockRootKeyStore{}
+	server := newTestSessionRPCServer(t, rootKeys)
+
+	remoteKey, err := btcec.NewPrivateKey()
+	require.NoError(t, err)
+
+	sess, err := session.NewSession(
+		"rotate", session.TypeMacaroonCustom, time.Now().Add(time.Hour),
+		"localhost:10011", true, []bakery.Op{{
+			Entity: "info",
+			Action: "read",
+		}}, nil, nil, false,
+	)
+	require.NoError(t, err)
+	sess.RemotePublicKey = remoteKey.PubKey()
+	require.NoError(t, server.db.StoreSession(sess))
+
+	pubKey := sess.LocalPublicKey
+	expiry := time.Now().Add(24 * time.Hour)
+	req := &litrpc.RotateSessionRequest{
+		LocalPublicKey:         pubKey.SerializeCompressed(),
+		ExpiryTimestampSeconds: uint64(expiry.Unix()),
+	}
+	newRootKey := session.NextSuperMacaroonRootKeyID(sess.MacaroonRootKey)
+
+	// If the new macaroon can't be baked, the rotation is rolled back. The
+	// session keeps its old expiry and root key and only the new root key
+	// is deleted.
+	rootKeys.bakeErr = errors.New("bake failed")
+	_, err = server.RotateSession(ctx, req)
+	require.ErrorContains(t, err, "bake failed")
+	require.Equal(t, []uint64{newRootKey}, rootKeys.deleted)
+
+	stored, err := server.db.GetSession(sess.LocalPublicKey)
+	require.NoError(t, err)
+	require.Equal(t, sess.MacaroonRootKey, stored.MacaroonRootKey)
+	require.Equal(t, sess.Expiry.Unix(), stored.Expiry.Unix())
+
+	// A successful rotation starts the session with a macaroon baked with
+	// the new root key and deletes the old root key afterwards.
+	rootKeys.bakeErr = nil
+	rootKeys.deleted = nil
+	resp, err := server.RotateSession(ctx, req)
+	require.NoError(t, err)
+	require.Equal(t, []uint64{newRootKey}, rootKeys.baked)
+	require.Equal(t, []uint64{sess.MacaroonRootKey}, rootKeys.deleted)
+	require.EqualValues(
+		t, expiry.Unix(), resp.Session.ExpiryTimestampSeconds,
+	)
+
+	stored, err = server.db.GetSession(sess.LocalPublicKey)
+	require.NoError(t, err)
+	require.Equal(t, sess.ID, stored.ID)
+	require.Equal(t, newRootKey, stored.MacaroonRootKey)
+	require.Equal(t, expiry.Unix(), stored.Expiry.Unix())
+	require.True(t, stored.RemotePublicKey.IsEqual(sess.RemotePublicKey))
+}
+
+// TestCheckIdleSessions makes sure that only paired sessions that didn't have
+// any traffic for longer than their idle timeout are revoked.
+func TestCheckIdleSessions(t *testing.T) {
+	startTime := time.Unix(1672531200, 0)
+	now := startTime.Add(2 * time.Hour)
+
+	tests := []struct {
+		name        string
+		state       session.State
+		paired      bool
+		idleTimeout time.Duration
+		lastActive  time.Time
+		revoked     bool
+	}{{
+		name:       "idle in-use session",
+		state:      session.StateInUse,
+		paired:     true,
+		lastActive: now.Add(-90 * time.Minute),
+		revoked:    true,
+	}, {
+		name:       "active in-use session",
+		state:      session.StateInUse,
+		paired:     true,
+		lastActive: now.Add(-30 * time.Minute),
+	}, {
+		name:    "idle paired created session",
+		state:   session.StateCreated,
+		paired:  true,
+		revoked: true,
+	}, {
+		name:  "unpaired created session",
+		state: session.StateCreated,
+	}, {
+		name:        "session specific idle timeout",
+		state:       session.StateInUse,
+		paired:      true,
+		idleTimeout: 3 * time.Hour,
+		lastActive:  now.Add(-100 * time.Minute),
+	}, {
+		name:        "traffic before start",
+		state:       session.StateInUse,
+		paired:      true,
+		idleTimeout: 3 * time.Hour,
+		lastActive:  startTime.Add(-5 * time.Hour),
+	}, {
+		name:       "paused session",
+		state:      session.StatePaused,
+		paired:     true,
+		lastActive: now.Add(-90 * time.Minute),
+	}}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			server := newTestSessionRPCServer(
+				t, &mockRootKeyStore{},
+			)
+			server.cfg.idleTimeout = time.Hour
+			server.cfg.clock = clock.NewTestClock(now)
+
+			sess, err := session.NewSession(
+				"idle", session.TypeMacaroonAdmin,
+				now.Add(time.Hour), "localhost:10011", true,
+				nil, nil, nil, false,
+			)
+			require.NoError(t, err)
+
+			sess.State = test.state
+			sess.IdleTimeout = test.idleTimeout
+			if test.paired {
+				remoteKey, err := btcec.NewPrivateKey()
+				require.NoError(t, err)
+
+				sess.RemotePublicKey = remoteKey.PubKey()
+			}
+
+			pubKey := sess.LocalPublicKey
+			require.NoError(t, server.db.StoreSession(sess))
+			if !test.lastActive.IsZero() {
+				err := server.db.AddSessionUsage(
+					pubKey, &session.Usage{
+						LastActive: test.lastActive,
+					},
+				)
+				require.NoError(t, err)
+			}
+
+			require.NoError(t, server.checkIdleSessions(startTime))
+
+			stored, err := server.db.GetSession(pubKey)
+			require.NoError(t, err)
+
+			if !test.revoked {
+				require.Equal(t, test.state, stored.State)
+				return
+			}
+
+			require.Equal(t, session.StateRevoked, stored.State)
+			require.Equal(
+				t, session.RevocationReasonIdle,
+				stored.RevocationReason,
+			)
+		})
+	}
+}
+
+// TestPausedSessionExpiry makes sure that paused sessions are revoked once
+// they expire, that an expired paused session can't be resumed and that a
+// revoked paused session isn't tracked as paused anymore.
+func TestPausedSessionExpiry(t *testing.T) {
+	ctx := context.Background()
+	server := newTestSessionRPCServer(t, &mockRootKeyStore{})
+
+	// addPausedSession adds a session that expires after the given
+	// duration and pauses it.
+	addPausedSession := func(expiry time.Duration) *session.Session {
+		sess, err := session.NewSession(
+			"paused", session.TypeMacaroonAdmin,
+			time.Now().Add(expiry), "localhost:10011", true, nil,
+			nil, nil, false,
+		)
+		require.NoError(t, err)
+		require.NoError(t, server.db.StoreSession(sess))
+
+		pubKey := sess.LocalPublicKey.SerializeCompressed()
+		_, err = server.PauseSession(ctx, &litrpc.PauseSessionRequest{
+			LocalPublicKey: pubKey,
+		})
+		require.NoError(t, err)
+		require.True(t, server.isPaused(sess.ID))
+
+		return sess
+	}
+
+	// requireState asserts the state and revocation reason of the given
+	// session.
+	requireState := func(sess *session.Session, state session.State,
+		reason session.RevocationReason) {
+
+		stored, err := server.db.GetSession(sess.LocalPublicKey)
+		require.NoError(t, err)
+		require.Equal(t, state, stored.State)
+		require.Equal(t, reason, stored.RevocationReason)
+	}
+
+	expiring := addPausedSession(time.Hour)
+	active := addPausedSession(3 * time.Hour)
+	revoked := addPausedSession(3 * time.Hour)
+
+	// A paused session is revoked manually without being resumed first.
+	_, err := server.RevokeSession(ctx, &litrpc.RevokeSessionRequest{
+		LocalPublicKey: revoked.LocalPublicKey.SerializeCompressed(),
+	})
+	require.NoError(t, err)
+	require.False(t, server.isPaused(revoked.ID))
+	requireState(
+		revoked, session.StateRevoked, session.RevocationReasonManual,
+	)
+
+	// Only the paused session that reached its expiry is revoked.
+	server.cfg.clock = clock.NewTestClock(time.Now().Add(2 * time.Hour))
+	require.NoError(t, server.checkPausedSessions())
+
+	require.False(t, server.isPaused(expiring.ID))
+	requireState(
+		expiring, session.StateRevoked, session.RevocationReasonExpired,
+	)
+
+	require.True(t, server.isPaused(active.ID))
+	requireState(
+		active, session.StatePaused, session.RevocationReasonUnknown,
+	)
+
+	// A paused session that expired before the next check is revoked when
+	// it is resumed.
+	server.cfg.clock = clock.NewTestClock(time.Now().Add(4 * time.Hour))
+	_, err = server.ResumeSession(ctx, &litrpc.ResumeSessionRequest{
+		LocalPublicKey: active.LocalPublicKey.SerializeCompressed(),
+	})
+	require.ErrorContains(t, err, "session expired")
+	require.False(t, server.isPaused(active.ID))
+	requireState(
+		active, session.StateRevoked, session.RevocationReasonExpired,
+	)
+}
+
+// TestSessionDevices makes sure that devices can be added to and revoked from
+// a running session and that autopilot sessions don't accept devices.
+func TestSessionDevices(t *testing.T) {
+	ctx := context.Background()
+	server := newTestSessionRPCServer(t, &mockRootKeyStore{})
+
+	sess, err := session.NewSession(
+		"devices", session.TypeMacaroonCustom,
+		time.Now().Add(time.Hour), "localhost:10011", true,
+		[]bakery.Op{{
+			Entity: "info",
+			Action: "read",
+		}}, nil, nil, false,
+	)
+	require.NoError(t, err)
+	require.NoError(t, server.db.StoreSession(sess))
+	require.NoError(t, server.resumeSession(sess))
+
+	pubKey := sess.LocalPublicKey.SerializeCompressed()
+	resp, err := server.AddSessionDevice(
+		ctx, &litrpc.AddSessionDeviceRequest{
+			LocalPublicKey: pubKey,
+			Label:          "laptop",
+		},
+	)
+	require.NoError(t, err)
+	require.Len(t, resp.Session.Devices, 1)
+
+	device := resp.Session.Devices[0]
+	require.Equal(t, "laptop", device.Label)
+	require.NotEqual(t, pubKey, device.LocalPublicKey)
+	require.NotEqual(t, resp.Session.PairingSecret, device.PairingSecret)
+
+	// The device runs its own mailbox server, so starting it again fails.
+	stored, err := server.db.GetSession(sess.LocalPublicKey)
+	require.NoError(t, err)
+	_, err = server.sessionServer.StartSession(
+		stored.DeviceSession(stored.Devices[0]), nil, nil, nil, nil,
+	)
+	require.ErrorContains(t, err, "already active")
+
+	revokeResp, err := server.RevokeSessionDevice(
+		ctx, &litrpc.RevokeSessionDeviceRequest{
+			LocalPublicKey:  pubKey,
+			DevicePublicKey: device.LocalPublicKey,
+		},
+	)
+	require.NoError(t, err)
+	require.NotZero(t, revokeResp.Session.Devices[0].RevokedAt)
+	require.Equal(
+		t, litrpc.SessionState_STATE_CREATED,
+		revokeResp.Session.SessionState,
+	)
+
+	// Autopilot sessions are paired with the autopilot server and can't
+	// have any devices.
+	autopilotSess, err := session.NewSession(
+		"autopilot", session.TypeAutopilot, time.Now().Add(time.Hour),
+		"localhost:10011", true, nil, nil, nil, false,
+	)
+	require.NoError(t, err)
+	require.NoError(t, server.db.StoreSession(autopilotSess))
+
+	_, err = server.AddSessionDevice(
+		ctx, &litrpc.AddSessionDeviceRequest{
+			LocalPublicKey: autopilotSess.LocalPublicKey.
+				SerializeCompressed(),
+		},
+	)
+	require.Error(t, err)
+}
@@ -35,6 +35,7 @@ import (
 	"github.com/lightningnetwork/lnd"
 	"github.com/lightningnetwork/lnd/build"
 	"github.com/lightningnetwork/lnd/chainreg"
+	"github.com/lightningnetwork/lnd/clock"
 	"github.com/lightningnetwork/lnd/kvdb"
 	"github.com/lightningnetwork/lnd/lncfg"
 	"github.com/lightningnetwork/lnd/lnrpc"
@@ -171,6 +172,8 @@ type LightningTerminal struct {
 	sessionRpcServer        *sessionRpcServer
 	sessionRpcServerStarted bool
 
+	caveatValidator *session.CaveatValidator
+
 	macaroonService        *lndclient.MacaroonService
 	macaroonServiceStarted bool
 	macaroonDB             kvdb.Backend
@@ -297,6 +300,7 @@ func (g *LightningTerminal) start() error {
 
 	g.accountService, err = accounts.NewService(
 		filepath.Dir(g.cfg.MacaroonPath), g.errQueue.ChanIn(),
+		g.cfg.Accounts,
 	)
 	if err != nil {
 		return fmt.Errorf("error creating account service: %v", err)
@@ -311,6 +315,21 @@ func (g *LightningTerminal) start() error {
 		)
 	}
 
+	superMacRootKeyDeleter := func(ctx context.Context,
+		rootKeyID uint64) error {
+
+		if g.basicClient == nil {
+			return errors.New("lnd not yet connected")
+		}
+
+		_, err := g.basicClient.DeleteMacaroonID(
+			ctx, &lnrpc.DeleteMacaroonIDRequest{
+				RootKeyId: rootKeyID,
+			},
+		)
+		return err
+	}
+
 	g.accountRpcServer = accounts.NewRPCServer(
 		g.accountService, superMacBaker,
 	)
@@ -374,7 +393,10 @@ func (g *LightningTerminal) start() error {
 			g.registerSubDaemonGrpcServers(server, false)
 		},
 		superMacBaker:           superMacBaker,
+		superMacRootKeyDeleter:  superMacRootKeyDeleter,
 		firstConnectionDeadline: g.cfg.FirstLNCConnDeadline,
+		idleTimeout:             g.cfg.Session.IdleTimeout,
+		clock:                   clock.NewDefaultClock(),
 		permMgr:                 g.permsMgr,
 		actionsDB:               g.firewallDB,
 		autopilot:               g.autopilotClient,
@@ -386,6 +408,12 @@ func (g *LightningTerminal) start() error {
 			"server: %v", err)
 	}
 
+	// The calls made with macaroons that have max-call-count caveats are
+	// counted in the session DB.
+	g.caveatValidator = session.NewCaveatValidator(
+		g.sessionRpcServer.db, clock.NewDefaultClock(),
+	)
+
 	// Call the "real" main in a nested manner so the defers will properly
 	// be executed in the case of a graceful shutdown.
 	var (
@@ -499,8 +527,8 @@ func (g *LightningTerminal) start() error {
 
 	// bakeSuperMac is a closure that can be used to bake a new super
 	// macaroon that contains all active permissions.
-	bakeSuperMac := func(ctx context.Context, rootKeyIDSuffix uint32) (
-		string, error) {
+	bakeSuperMac := func(ctx context.Context, rootKeyIDSuffix uint32,
+		caveats []macaroon.Caveat) (string, error) {
 
 		var suffixBytes [4]byte
 		binary.BigEndian.PutUint32(suffixBytes[:], rootKeyIDSuffix)
@@ -509,13 +537,14 @@ func (g *LightningTerminal) start() error {
 
 		return BakeSuperMacaroon(
 			ctx, g.basicClient, rootKeyID,
-			g.permsMgr.ActivePermissions(false), nil,
+			g.permsMgr.ActivePermissions(false), caveats,
 		)
 	}
 
 	// Now start the RPC proxy that will handle all incoming gRPC, grpc-web
 	// and REST requests.
-	if err := g.rpcProxy.Start(g.lndConn, bakeSuperMac); err != nil {
+	err = g.rpcProxy.Start(g.lndConn, bakeSuperMac, g.caveatValidator)
+	if err != nil {
 		return fmt.Errorf("error starting lnd gRPC proxy server: %v",
 			err)
 	}
@@ -807,10 +836,19 @@ func (g *LightningTerminal) startInternalSubServers(
 		g.firewallDB.PrivacyDB, firewall.CryptoRandIntn,
 	)
 
+	// The restriction interceptor makes lnd accept macaroons with
+	// restriction caveats and checks the ones used for lnd requests.
+	restrictionInterceptor := session.NewRestrictionInterceptor(
+		g.caveatValidator, func(uri string) bool {
+			return g.permsMgr.IsSubServerURI(subservers.LND, uri)
+		},
+	)
+
 	mw := []mid.RequestInterceptor{
 		privacyMapper,
 		g.accountService,
 		requestLogger,
+		restrictionInterceptor,
 	}
 
 	if !g.cfg.Autopilot.Disable {
@@ -825,7 +863,8 @@ func (g *LightningTerminal) startInternalSubServers(
 					reqID, firewalldb.ActionStateError,
 					reason,
 				)
-			}, g.firewallDB.PrivacyDB,
+			}, g.firewallDB.PrivacyDB, g.firewallDB,
+			g.cfg.RPCMiddleware.InterceptTimeout,
 		)
 
 		mw = append(mw, ruleEnforcer)
@@ -973,9 +1012,31 @@ func (g *LightningTerminal) ValidateMacaroon(ctx context.Context,
 			return err
 		}
 
-		return g.validateSuperMacaroon(
+		err = g.validateSuperMacaroon(
 			ctx, macBytes, requiredPermissions, fullMethod,
 		)
+		if err != nil {
+			return err
+		}
+
+		// Calls to lnd never land here, so this is the only place
+		// where the restrictions of the macaroon are checked and the
+		// call is counted. If the request was forwarded by our proxy,
+		// the source IP was already checked there.
+		if err := g.checkRestrictions(ctx, macBytes); err != nil {
+			return err
+		}
+
+		// The macaroon of a parent account has the permission to
+		// create accounts, but it must not be used for anything else
+		// in LiT.
+		if g.permsMgr.IsSubServerURI(subservers.LIT, fullMethod) {
+			return accounts.CheckAccountMacaroon(
+				macBytes, fullMethod,
+			)
+		}
+
+		return nil
 	}
 
 	// Validate all macaroons for services that are running in the local
@@ -1420,9 +1481,51 @@ func (g *LightningTerminal) validateSuperMacaroon(ctx context.Context,
 		return fmt.Errorf("macaroon is not valid")
 	}
 
+	// The macaroon of a paused session must not be used until the
+	// session is resumed.
+	if g.sessionRpcServer != nil {
+		mac := &macaroon.Macaroon{}
+		if err := mac.UnmarshalBinary(superMacaroon); err != nil {
+			return fmt.Errorf("unable to unmarshal macaroon: %v",
+				err)
+		}
+
+		id, err := session.IDFromMacaroon(mac)
+		if err != nil {
+			return fmt.Errorf("unable to get session ID from "+
+				"macaroon: %v", err)
+		}
+
+		if g.sessionRpcServer.isPaused(id) {
+			return fmt.Errorf("session %x is paused", id[:])
+		}
+	}
+
 	return nil
 }
 
+// checkRestrictions makes sure a request with the given super macaroon
+// satisfies the restriction caveats of the macaroon and counts the call.
+func (g *LightningTerminal) checkRestrictions(ctx context.Context,
+	superMacaroon []byte) error {
+
+	if g.caveatValidator == nil {
+		return fmt.Errorf("cannot check macaroon restrictions, " +
+			"session DB not yet opened")
+	}
+
+	mac := &macaroon.Macaroon{}
+	if err := mac.UnmarshalBinary(superMacaroon); err != nil {
+		return fmt.Errorf("unable to unmarshal macaroon: %v", err)
+	}
+
+	if err := g.caveatValidator.CheckRestrictions(ctx, mac); err != nil {
+		return err
+	}
+
+	return g.caveatValidator.CountCall(mac)
+}
+
 // initSubServers registers the faraday and loop sub-servers with the
 // subServerMgr.
 func (g *LightningTerminal) initSubServers() {